@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"io"
 	"runtime/debug"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -42,13 +44,19 @@ const (
 // BaseApp reflects the ABCI application implementation.
 type BaseApp struct {
 	// initialized on creation
-	Logger     log.Logger
-	name       string               // application name from abci.Info
-	cdc        *wire.Codec          // Amino codec
-	db         dbm.DB               // common DB backend
-	cms        sdk.CommitMultiStore // Main (uncached) state
-	router     Router               // handle any kind of message
-	codespacer *sdk.Codespacer      // handle module codespacing
+	Logger      log.Logger
+	name        string               // application name from abci.Info
+	cdc         *wire.Codec          // Amino codec
+	db          dbm.DB               // common DB backend
+	cms         sdk.CommitMultiStore // Main (uncached) state
+	router      Router               // handle any kind of message
+	queryRouter QueryRouter          // router for redirecting custom queries
+	codespacer  *sdk.Codespacer      // handle module codespacing
+	metrics     *Metrics             // tx and mempool metrics
+
+	// minimum gas prices for a tx to enter the local mempool, not used by
+	// DeliverTx
+	minimumGasPrices sdk.GasPrices
 
 	// must be set
 	txDecoder   sdk.TxDecoder   // unmarshal []byte into sdk.Tx
@@ -83,14 +91,16 @@ var _ abci.Application = (*BaseApp)(nil)
 // Accepts variable number of option functions, which act on the BaseApp to set configuration choices
 func NewBaseApp(name string, cdc *wire.Codec, logger log.Logger, db dbm.DB, options ...func(*BaseApp)) *BaseApp {
 	app := &BaseApp{
-		Logger:     logger,
-		name:       name,
-		cdc:        cdc,
-		db:         db,
-		cms:        store.NewCommitMultiStore(db),
-		router:     NewRouter(),
-		codespacer: sdk.NewCodespacer(),
-		txDecoder:  defaultTxDecoder(cdc),
+		Logger:      logger,
+		name:        name,
+		cdc:         cdc,
+		db:          db,
+		cms:         store.NewCommitMultiStore(db),
+		router:      NewRouter(),
+		queryRouter: NewQueryRouter(),
+		codespacer:  sdk.NewCodespacer(),
+		txDecoder:   defaultTxDecoder(cdc),
+		metrics:     NopMetrics(),
 	}
 
 	// Register the undefined & root codespaces, which should not be used by
@@ -183,7 +193,8 @@ func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 func (app *BaseApp) SetPubKeyPeerFilter(pf sdk.PeerFilter) {
 	app.pubkeyPeerFilter = pf
 }
-func (app *BaseApp) Router() Router { return app.router }
+func (app *BaseApp) Router() Router           { return app.router }
+func (app *BaseApp) QueryRouter() QueryRouter { return app.queryRouter }
 
 // load latest application version
 func (app *BaseApp) LoadLatestVersion(mainKey sdk.StoreKey) error {
@@ -247,7 +258,7 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.Logger),
+		ctx: sdk.NewContext(ms, header, true, app.blockLogger(header)),
 	}
 }
 
@@ -255,10 +266,16 @@ func (app *BaseApp) setDeliverState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.deliverState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, false, app.Logger),
+		ctx: sdk.NewContext(ms, header, false, app.blockLogger(header)),
 	}
 }
 
+// blockLogger returns the application logger annotated with the block height,
+// it is the base logger of every context created for the block.
+func (app *BaseApp) blockLogger(header abci.Header) log.Logger {
+	return app.Logger.With("height", header.Height)
+}
+
 //______________________________________________________________________________
 
 // ABCI
@@ -338,6 +355,8 @@ func (app *BaseApp) Query(req abci.RequestQuery) (res abci.ResponseQuery) {
 		return handleQueryStore(app, path, req)
 	case "p2p":
 		return handleQueryP2P(app, path, req)
+	case "custom":
+		return handleQueryCustom(app, path, req)
 	}
 
 	msg := "unknown query path"
@@ -356,6 +375,8 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 			} else {
 				result = app.Simulate(tx)
 			}
+		case "simulation":
+			return handleQuerySimulation(app, path, req)
 		case "version":
 			return abci.ResponseQuery{
 				Code:  uint32(sdk.ABCICodeOK),
@@ -374,6 +395,56 @@ func handleQueryApp(app *BaseApp, path []string, req abci.RequestQuery) (res abc
 	return sdk.ErrUnknownRequest(msg).QueryResult()
 }
 
+// handleQuerySimulation simulates the tx, the gas adjustment used to
+// recommend a gas limit is the optional third parameter of the path,
+// e.g. "/app/simulation/1.5"
+func handleQuerySimulation(app *BaseApp, path []string, req abci.RequestQuery) (res abci.ResponseQuery) {
+	adjustment := sdk.DefaultGasAdjustment
+	if len(path) >= 3 {
+		var err error
+		adjustment, err = strconv.ParseFloat(path[2], 64)
+		if err != nil || adjustment <= 0 {
+			msg := fmt.Sprintf("invalid gas adjustment %s", path[2])
+			return sdk.ErrUnknownRequest(msg).QueryResult()
+		}
+	}
+	tx, err := app.txDecoder(req.Data)
+	if err != nil {
+		return err.QueryResult()
+	}
+	simulation := app.SimulateWithDiffs(tx, adjustment)
+	return abci.ResponseQuery{
+		Code:  uint32(sdk.ABCICodeOK),
+		Value: app.cdc.MustMarshalBinary(simulation),
+	}
+}
+
+// handleQueryCustom routes "/custom/<route>/..." queries to the querier of
+// the route, the queries are served from the latest committed state
+func handleQueryCustom(app *BaseApp, path []string, req abci.RequestQuery) (res abci.ResponseQuery) {
+	if len(path) < 2 || path[1] == "" {
+		return sdk.ErrUnknownRequest("no route for custom query specified").QueryResult()
+	}
+	querier := app.queryRouter.Route(path[1])
+	if querier == nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("no custom querier found for route %s", path[1])).QueryResult()
+	}
+
+	// a cache of the committed state, the writes of the querier are discarded
+	ctx := sdk.NewContext(app.cms.CacheMultiStore(), app.checkState.ctx.BlockHeader(), true, app.Logger)
+	resBytes, err := querier(ctx, path[2:], req)
+	if err != nil {
+		return abci.ResponseQuery{
+			Code: uint32(err.ABCICode()),
+			Log:  err.ABCILog(),
+		}
+	}
+	return abci.ResponseQuery{
+		Code:  uint32(sdk.ABCICodeOK),
+		Value: resBytes,
+	}
+}
+
 func handleQueryStore(app *BaseApp, path []string, req abci.RequestQuery) (res abci.ResponseQuery) {
 	// "/store" prefix for store queries
 	queryable, ok := app.cms.(sdk.Queryable)
@@ -424,11 +495,14 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 	} else {
 		// In the first block, app.deliverState.ctx will already be initialized
 		// by InitChain. Context is now updated with Header information.
-		app.deliverState.ctx = app.deliverState.ctx.WithBlockHeader(req.Header)
+		app.deliverState.ctx = app.deliverState.ctx.WithBlockHeader(req.Header).
+			WithLogger(app.blockLogger(req.Header))
 	}
 
 	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
+		tc := sdk.NewTagCollector()
+		res = app.beginBlocker(app.deliverState.ctx.WithTagCollector(tc), req)
+		res.Tags = append(res.Tags, tc.Tags()...)
 	}
 
 	// set the signed validators for addition to context in deliverTx
@@ -448,8 +522,9 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 	if err != nil {
 		result = err.Result()
 	} else {
-		result = app.runTx(runTxModeCheck, txBytes, tx)
+		result = app.runTx(runTxModeCheck, app.checkState, txBytes, tx)
 	}
+	app.metrics.recordCheckTx(result)
 
 	return abci.ResponseCheckTx{
 		Code:      uint32(result.Code),
@@ -467,14 +542,17 @@ func (app *BaseApp) CheckTx(txBytes []byte) (res abci.ResponseCheckTx) {
 
 // Implements ABCI
 func (app *BaseApp) DeliverTx(txBytes []byte) (res abci.ResponseDeliverTx) {
+	start := time.Now()
+
 	// Decode the Tx.
 	var result sdk.Result
 	var tx, err = app.txDecoder(txBytes)
 	if err != nil {
 		result = err.Result()
 	} else {
-		result = app.runTx(runTxModeDeliver, txBytes, tx)
+		result = app.runTx(runTxModeDeliver, app.deliverState, txBytes, tx)
 	}
+	app.metrics.recordDeliverTx(tx, result, time.Since(start))
 
 	// Even though the Result.Code is not OK, there are still effects,
 	// namely fee deductions and sequence incrementing.
@@ -509,12 +587,15 @@ func validateBasicTxMsgs(msgs []sdk.Msg) sdk.Error {
 	return nil
 }
 
-func (app *BaseApp) getContextForAnte(mode runTxMode, txBytes []byte) (ctx sdk.Context) {
+func (app *BaseApp) getContextForAnte(mode runTxMode, st *state, txBytes []byte) (ctx sdk.Context) {
 	// Get the context
-	if mode == runTxModeCheck || mode == runTxModeSimulate {
-		ctx = app.checkState.ctx.WithTxBytes(txBytes)
-	} else {
-		ctx = app.deliverState.ctx.WithTxBytes(txBytes)
+	ctx = st.ctx.WithTxBytes(txBytes)
+	switch mode {
+	case runTxModeCheck:
+		ctx = ctx.WithMinimumGasPrices(app.minimumGasPrices)
+	case runTxModeSimulate:
+		ctx = ctx.WithSimulate(true)
+	case runTxModeDeliver:
 		ctx = ctx.WithSigningValidators(app.signedValidators)
 	}
 
@@ -539,7 +620,14 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 		var msgResult sdk.Result
 		// Skip actual execution for CheckTx
 		if mode != runTxModeCheck {
-			msgResult = handler(ctx, msg)
+			// scope the logger to the message and the module handling it, the
+			// tags emitted by keepers are added to the result of the message
+			tc := sdk.NewTagCollector()
+			msgCtx := ctx.WithLogger(ctx.Logger().With("module", "x/"+msgType, "msgIndex", msgIdx)).
+				WithMsgIndex(msgIdx).
+				WithTagCollector(tc)
+			msgResult = handler(msgCtx, msg)
+			msgResult.Tags = msgResult.Tags.AppendTags(tc.Tags())
 		}
 
 		// NOTE: GasWanted is determined by ante handler and
@@ -566,32 +654,27 @@ func (app *BaseApp) runMsgs(ctx sdk.Context, msgs []sdk.Msg, mode runTxMode) (re
 		Data:    data,
 		Log:     strings.Join(logs, "\n"),
 		GasUsed: ctx.GasMeter().GasConsumed(),
-		// TODO: FeeAmount/FeeDenom
-		Tags: tags,
+		Tags:    tags,
 	}
 
 	return result
 }
 
-// Returns the applicantion's deliverState if app is in runTxModeDeliver,
-// otherwise it returns the application's checkstate.
-func getState(app *BaseApp, mode runTxMode) *state {
-	if mode == runTxModeCheck || mode == runTxModeSimulate {
-		return app.checkState
-	}
-
-	return app.deliverState
-}
-
 // runTx processes a transaction. The transactions is proccessed via an
 // anteHandler. txBytes may be nil in some cases, eg. in tests. Also, in the
-// future we may support "internal" transactions.
-func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
+// future we may support "internal" transactions. The tx runs on the state st,
+// the check state or deliver state, or a simulation state.
+func (app *BaseApp) runTx(mode runTxMode, st *state, txBytes []byte, tx sdk.Tx) (result sdk.Result) {
 	// NOTE: GasWanted should be returned by the AnteHandler. GasUsed is
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted int64
-	ctx := app.getContextForAnte(mode, txBytes)
+	// anteResult holds the fee charged by the AnteHandler. The fee is charged
+	// even if a message fails, so it is reported on every result after it.
+	var anteResult sdk.Result
+	txHash := cmn.HexBytes(tmhash.Sum(txBytes)).String()
+	ctx := app.getContextForAnte(mode, st, txBytes)
+	ctx = ctx.WithLogger(ctx.Logger().With("txHash", txHash))
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -599,6 +682,9 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 			case sdk.ErrorOutOfGas:
 				log := fmt.Sprintf("out of gas in location: %v", rType.Descriptor)
 				result = sdk.ErrOutOfGas(log).Result()
+			case sdk.ErrorGasOverflow:
+				log := fmt.Sprintf("gas overflow in location: %v", rType.Descriptor)
+				result = sdk.ErrOutOfGas(log).Result()
 			default:
 				log := fmt.Sprintf("recovered: %v\nstack:\n%v", r, string(debug.Stack()))
 				result = sdk.ErrInternal(log).Result()
@@ -607,6 +693,14 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 
 		result.GasWanted = gasWanted
 		result.GasUsed = ctx.GasMeter().GasConsumed()
+		result.FeeAmount = anteResult.FeeAmount
+		result.FeeDenom = anteResult.FeeDenom
+
+		// report the fee required at the minimum gas prices for the gas used
+		if mode == runTxModeSimulate && len(app.minimumGasPrices) > 0 {
+			requiredFee := app.minimumGasPrices.Fee(result.GasUsed)
+			result.Tags = result.Tags.AppendTag(sdk.TagRequiredFee, []byte(requiredFee.String()))
+		}
 	}()
 
 	var msgs = tx.GetMsgs()
@@ -616,9 +710,11 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		return err.Result()
 	}
 
-	// run the ante handler
+	// run the ante handler, the tags it emits are reported like its own
 	if app.anteHandler != nil {
-		newCtx, result, abort := app.anteHandler(ctx, tx)
+		tc := sdk.NewTagCollector()
+		newCtx, result, abort := app.anteHandler(ctx.WithTagCollector(tc), tx)
+		result.Tags = result.Tags.AppendTags(tc.Tags())
 		if abort {
 			return result
 		}
@@ -627,23 +723,29 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		}
 
 		gasWanted = result.GasWanted
+		anteResult = result
 	}
 
 	// Keep the state in a transient CacheWrap in case processing the messages
 	// fails.
-	msCache := getState(app, mode).CacheMultiStore()
+	msCache := st.CacheMultiStore()
 	if msCache.TracingEnabled() {
 		msCache = msCache.WithTracingContext(sdk.TraceContext(
-			map[string]interface{}{"txHash": cmn.HexBytes(tmhash.Sum(txBytes)).String()},
+			map[string]interface{}{"txHash": txHash},
 		)).(sdk.CacheMultiStore)
 	}
 
 	ctx = ctx.WithMultiStore(msCache)
 	result = app.runMsgs(ctx, msgs, mode)
 	result.GasWanted = gasWanted
-
-	// only update state if all messages pass and we're not in a simulation
-	if result.IsOK() && mode != runTxModeSimulate {
+	// copy the tags so that those of the ante handler aren't overwritten
+	tags := make(sdk.Tags, 0, len(anteResult.Tags)+len(result.Tags))
+	tags = append(tags, anteResult.Tags...)
+	result.Tags = append(tags, result.Tags...)
+
+	// only update state if all messages pass, simulations write to the
+	// simulation state which is then discarded
+	if result.IsOK() {
 		msCache.Write()
 	}
 
@@ -657,7 +759,9 @@ func (app *BaseApp) EndBlock(req abci.RequestEndBlock) (res abci.ResponseEndBloc
 	}
 
 	if app.endBlocker != nil {
-		res = app.endBlocker(app.deliverState.ctx, req)
+		tc := sdk.NewTagCollector()
+		res = app.endBlocker(app.deliverState.ctx.WithTagCollector(tc), req)
+		res.Tags = append(res.Tags, tc.Tags()...)
 	}
 
 	return
@@ -678,9 +782,10 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	// Write the Deliver state and commit the MultiStore
 	app.deliverState.ms.Write()
 	commitID := app.cms.Commit()
-	// TODO: this is missing a module identifier and dumps byte array
 	app.Logger.Debug("Commit synced",
-		"commit", commitID,
+		"module", "baseapp",
+		"height", commitID.Version,
+		"hash", fmt.Sprintf("%X", commitID.Hash),
 	)
 
 	// Reset the Check state to the latest committed
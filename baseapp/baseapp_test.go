@@ -564,6 +564,98 @@ func TestMultiMsgDeliverTx(t *testing.T) {
 	}
 }
 
+// Test that the fee reported by the AnteHandler is returned on
+// CheckTx and DeliverTx, along with its tags.
+func TestTxFeeReported(t *testing.T) {
+	app, _, _ := setupBaseApp(t)
+
+	feeTags := sdk.NewTags(sdk.TagFee, []byte("10atom,5btc"))
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		return ctx, sdk.Result{FeeAmount: 10, FeeDenom: "atom", Tags: feeTags}, false
+	})
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	app.InitChain(abci.RequestInitChain{})
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+
+	checkRes := app.CheckTx(txBytes)
+	require.True(t, checkRes.IsOK(), checkRes.Log)
+	require.Equal(t, []byte("atom"), checkRes.Fee.Key)
+	require.Equal(t, int64(10), checkRes.Fee.Value)
+	require.Equal(t, feeTags.ToKVPairs(), checkRes.Tags)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	deliverRes := app.DeliverTx(txBytes)
+	require.True(t, deliverRes.IsOK(), deliverRes.Log)
+	require.Equal(t, feeTags.ToKVPairs(), deliverRes.Tags)
+}
+
+// Handlers should log with a logger scoped to the block, tx, msg and module.
+func TestHandlerLogger(t *testing.T) {
+	app, _, _ := setupBaseApp(t)
+
+	var buf bytes.Buffer
+	app.Logger = log.NewTMLogger(log.NewSyncWriter(&buf))
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx.Logger().Info("handled")
+		return sdk.Result{}
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	tx := newTxCounter(0, 0, 1)
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 1}})
+	res := app.DeliverTx(txBytes)
+	require.True(t, res.IsOK(), res.Log)
+
+	logs := buf.String()
+	require.Contains(t, logs, "height=1")
+	require.Contains(t, logs, "txHash=")
+	require.Contains(t, logs, "module=x/"+typeMsgCounter)
+	require.Contains(t, logs, "msgIndex=0")
+	require.Contains(t, logs, "msgIndex=1")
+}
+
+// Minimum gas prices are only set for CheckTx, simulations report the fee
+// required at those prices.
+func TestMinimumGasPrices(t *testing.T) {
+	app, _, _ := setupBaseApp(t)
+	SetMinimumGasPrices("0.5atom")(app)
+
+	var anteGasPrices sdk.GasPrices
+	app.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		anteGasPrices = ctx.MinimumGasPrices()
+		newCtx = ctx.WithGasMeter(sdk.NewGasMeter(100))
+		newCtx.GasMeter().ConsumeGas(9, "test")
+		return
+	})
+	app.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result { return sdk.Result{} })
+	app.InitChain(abci.RequestInitChain{})
+
+	tx := newTxCounter(0, 0)
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.NoError(t, err)
+
+	checkRes := app.CheckTx(txBytes)
+	require.True(t, checkRes.IsOK(), checkRes.Log)
+	require.Equal(t, app.minimumGasPrices, anteGasPrices)
+
+	// 9 gas at 0.5atom is rounded up to 5atom
+	simRes := app.Simulate(tx)
+	require.True(t, simRes.IsOK(), simRes.Log)
+	require.Nil(t, anteGasPrices)
+	require.Equal(t, sdk.NewTags(sdk.TagRequiredFee, []byte("5atom")), simRes.Tags)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	deliverRes := app.DeliverTx(txBytes)
+	require.True(t, deliverRes.IsOK(), deliverRes.Log)
+	require.Nil(t, anteGasPrices)
+}
+
 // Interleave calls to Check and Deliver and ensure
 // that there is no cross-talk. Check sees results of the previous Check calls
 // and Deliver sees that of the previous Deliver calls, but they don't see eachother.
@@ -625,6 +717,67 @@ func TestSimulateTx(t *testing.T) {
 	}
 }
 
+// Simulations discard their writes, including those of the AnteHandler,
+// and report them as diffs with a recommended gas limit.
+func TestSimulateWithDiffs(t *testing.T) {
+	app, capKey1, capKey2 := setupBaseApp(t)
+
+	anteKey := []byte("ante-key")
+	deliverKey := []byte("deliver-key")
+	app.SetAnteHandler(anteHandlerTxTest(t, capKey1, anteKey))
+	app.Router().AddRoute(typeMsgCounter, handlerMsgCounter(t, capKey2, deliverKey))
+	app.InitChain(abci.RequestInitChain{})
+
+	tx := newTxCounter(0, 0)
+
+	// the counters are still 0 after each simulation
+	for i := 0; i < 2; i++ {
+		simulation := app.SimulateWithDiffs(*tx, 1.5)
+		require.True(t, simulation.IsOK(), simulation.Log)
+		require.True(t, simulation.GasUsed > 0)
+		require.Equal(t, sdk.RecommendedGas(simulation.GasUsed, 1.5), simulation.GasRecommended)
+
+		require.Equal(t, 2, len(simulation.Diffs))
+		require.Equal(t, "key1", simulation.Diffs[0].Store)
+		require.Equal(t, anteKey, simulation.Diffs[0].Key)
+		require.Nil(t, simulation.Diffs[0].Before)
+		require.Equal(t, "key2", simulation.Diffs[1].Store)
+		require.Equal(t, deliverKey, simulation.Diffs[1].Key)
+		require.Nil(t, simulation.Diffs[1].Before)
+	}
+	require.Equal(t, int64(0), getIntFromStore(app.checkState.ctx.KVStore(capKey1), anteKey))
+
+	// CheckTx is unaffected by the simulations
+	txBytes, err := app.cdc.MarshalBinary(tx)
+	require.Nil(t, err)
+	checkRes := app.CheckTx(txBytes)
+	require.True(t, checkRes.IsOK(), checkRes.Log)
+
+	// simulate by calling Query with encoded tx, the check state counter is 1
+	tx = newTxCounter(1, 0)
+	txBytes, err = app.cdc.MarshalBinary(tx)
+	require.Nil(t, err)
+	queryResult := app.Query(abci.RequestQuery{
+		Path: "/app/simulation/2",
+		Data: txBytes,
+	})
+	require.True(t, queryResult.IsOK(), queryResult.Log)
+
+	var simulation sdk.SimulationResult
+	app.cdc.MustUnmarshalBinary(queryResult.Value, &simulation)
+	require.True(t, simulation.IsOK(), simulation.Log)
+	require.Equal(t, 2*simulation.GasUsed, simulation.GasRecommended)
+	require.Equal(t, anteKey, simulation.Diffs[0].Key)
+	require.Equal(t, int64(1), getIntFromStore(app.checkState.ctx.KVStore(capKey1), anteKey))
+
+	// the gas adjustment must be positive
+	queryResult = app.Query(abci.RequestQuery{
+		Path: "/app/simulation/-1",
+		Data: txBytes,
+	})
+	require.False(t, queryResult.IsOK())
+}
+
 //-------------------------------------------------------------------------------------------
 // Tx failure cases
 // TODO: add more
@@ -833,6 +986,41 @@ func TestQuery(t *testing.T) {
 	require.Equal(t, value, res.Value)
 }
 
+// Test custom queries are routed to the querier of their route
+func TestCustomQuery(t *testing.T) {
+	app, capKey, _ := setupBaseApp(t)
+
+	key, value := []byte("hello"), []byte("goodbye")
+	app.QueryRouter().AddRoute("custom1", func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		require.Equal(t, []string{"key"}, path)
+		store := ctx.KVStore(capKey)
+		res := store.Get(req.Data)
+		// the writes of queriers are discarded
+		store.Set(req.Data, []byte("overwritten"))
+		return res, nil
+	})
+	require.Panics(t, func() {
+		app.QueryRouter().AddRoute("custom1", nil)
+	})
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+	app.deliverState.ctx.KVStore(capKey).Set(key, value)
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	query := abci.RequestQuery{Path: "/custom/custom1/key", Data: key}
+	res := app.Query(query)
+	require.Equal(t, uint32(sdk.ABCICodeOK), res.Code)
+	require.Equal(t, value, res.Value)
+	res = app.Query(query)
+	require.Equal(t, value, res.Value)
+
+	// unknown routes are rejected
+	res = app.Query(abci.RequestQuery{Path: "/custom/custom2/key", Data: key})
+	require.Equal(t, uint32(sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnknownRequest)), res.Code)
+}
+
 // Test p2p filter queries
 func TestP2PQuery(t *testing.T) {
 	app, _, _ := setupBaseApp(t)
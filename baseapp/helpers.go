@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/abci/server"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -9,17 +10,41 @@ import (
 
 // nolint - Mostly for testing
 func (app *BaseApp) Check(tx sdk.Tx) (result sdk.Result) {
-	return app.runTx(runTxModeCheck, nil, tx)
+	return app.runTx(runTxModeCheck, app.checkState, nil, tx)
 }
 
 // nolint - full tx execution
 func (app *BaseApp) Simulate(tx sdk.Tx) (result sdk.Result) {
-	return app.runTx(runTxModeSimulate, nil, tx)
+	result, _ = app.simulate(tx)
+	return
+}
+
+// SimulateWithDiffs simulates the tx and returns its result with a gas limit
+// recommended for the tx, the gas used times the gas adjustment, and the
+// keys it wrote
+func (app *BaseApp) SimulateWithDiffs(tx sdk.Tx, gasAdjustment float64) sdk.SimulationResult {
+	result, ms := app.simulate(tx)
+	return sdk.SimulationResult{
+		Result:         result,
+		GasRecommended: sdk.RecommendedGas(result.GasUsed, gasAdjustment),
+		Diffs:          ms.Diffs(),
+	}
+}
+
+// simulate runs the tx on a cache of the check state recording its writes,
+// so that not even the writes of the AnteHandler persist
+func (app *BaseApp) simulate(tx sdk.Tx) (sdk.Result, store.DiffMultiStore) {
+	ms := store.NewDiffMultiStore(app.checkState.ms)
+	st := &state{
+		ms:  ms,
+		ctx: app.checkState.ctx.WithMultiStore(ms),
+	}
+	return app.runTx(runTxModeSimulate, st, nil, tx), ms
 }
 
 // nolint
 func (app *BaseApp) Deliver(tx sdk.Tx) (result sdk.Result) {
-	return app.runTx(runTxModeDeliver, nil, tx)
+	return app.runTx(runTxModeDeliver, app.deliverState, nil, tx)
 }
 
 // RunForever - BasecoinApp execution and cleanup
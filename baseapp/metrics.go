@@ -0,0 +1,101 @@
+package baseapp
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const metricsSubsystem = "baseapp"
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of delivered txs, labeled by msg route and result code.
+	Txs metrics.Counter
+	// Time to deliver a tx in seconds, labeled by msg route and result code.
+	TxLatency metrics.Histogram
+	// Gas used by delivered txs, labeled by msg route.
+	GasUsed metrics.Histogram
+	// Gas wanted by delivered txs, labeled by msg route.
+	GasWanted metrics.Histogram
+	// Number of txs rejected from the mempool by CheckTx, labeled by result code.
+	CheckTxFailures metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics() *Metrics {
+	gasBuckets := stdprometheus.ExponentialBuckets(1000, 2, 12)
+	return &Metrics{
+		Txs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "txs",
+			Help:      "Number of delivered txs.",
+		}, []string{"route", "code"}),
+		TxLatency: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "tx_latency_seconds",
+			Help:      "Time to deliver a tx in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, []string{"route", "code"}),
+		GasUsed: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "gas_used",
+			Help:      "Gas used by delivered txs.",
+			Buckets:   gasBuckets,
+		}, []string{"route"}),
+		GasWanted: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "gas_wanted",
+			Help:      "Gas wanted by delivered txs.",
+			Buckets:   gasBuckets,
+		}, []string{"route"}),
+		CheckTxFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "check_tx_failures",
+			Help:      "Number of txs rejected from the mempool by CheckTx.",
+		}, []string{"code"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Txs:             discard.NewCounter(),
+		TxLatency:       discard.NewHistogram(),
+		GasUsed:         discard.NewHistogram(),
+		GasWanted:       discard.NewHistogram(),
+		CheckTxFailures: discard.NewCounter(),
+	}
+}
+
+// txRoute returns the route of the first msg of a tx, txs which failed to
+// decode are labeled as "unknown".
+func txRoute(tx sdk.Tx) string {
+	if tx == nil || len(tx.GetMsgs()) == 0 {
+		return "unknown"
+	}
+	return tx.GetMsgs()[0].Type()
+}
+
+func (m *Metrics) recordCheckTx(result sdk.Result) {
+	if result.IsOK() {
+		return
+	}
+	m.CheckTxFailures.With("code", strconv.FormatUint(uint64(result.Code), 10)).Add(1)
+}
+
+func (m *Metrics) recordDeliverTx(tx sdk.Tx, result sdk.Result, latency time.Duration) {
+	route := txRoute(tx)
+	code := strconv.FormatUint(uint64(result.Code), 10)
+	m.Txs.With("route", route, "code", code).Add(1)
+	m.TxLatency.With("route", route, "code", code).Observe(latency.Seconds())
+	m.GasUsed.With("route", route).Observe(float64(result.GasUsed))
+	m.GasWanted.With("route", route).Observe(float64(result.GasWanted))
+}
@@ -3,6 +3,7 @@ package baseapp
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,3 +27,28 @@ func SetPruning(pruning string) func(*BaseApp) {
 		bap.cms.SetPruning(pruningEnum)
 	}
 }
+
+// SetMinimumGasPrices sets the minimum gas prices, e.g. "0.025steak", a tx
+// must pay for its gas at one of them to pass CheckTx
+func SetMinimumGasPrices(gasPrices string) func(*BaseApp) {
+	prices, err := sdk.ParseGasPrices(gasPrices)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid minimum gas prices: %v", err))
+	}
+	return func(bap *BaseApp) {
+		bap.minimumGasPrices = prices
+	}
+}
+
+// SetMetrics sets the metrics recorded by the BaseApp and by the IAVL stores
+// of its multistore
+func SetMetrics(metrics *Metrics, storeMetrics *store.Metrics) func(*BaseApp) {
+	return func(bap *BaseApp) {
+		bap.metrics = metrics
+		if cms, ok := bap.cms.(interface {
+			SetMetrics(*store.Metrics)
+		}); ok {
+			cms.SetMetrics(storeMetrics)
+		}
+	}
+}
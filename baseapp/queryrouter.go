@@ -0,0 +1,41 @@
+package baseapp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// QueryRouter provides queriers for each query path.
+type QueryRouter interface {
+	AddRoute(r string, h sdk.Querier) (rtr QueryRouter)
+	Route(path string) (h sdk.Querier)
+}
+
+type queryRouter struct {
+	routes map[string]sdk.Querier
+}
+
+// nolint
+// NewQueryRouter - create new QueryRouter
+func NewQueryRouter() *queryRouter {
+	return &queryRouter{
+		routes: map[string]sdk.Querier{},
+	}
+}
+
+// AddRoute adds a querier for the custom queries of the path
+// "/custom/<r>/...", the route must be alphanumeric and unique
+func (rtr *queryRouter) AddRoute(r string, q sdk.Querier) QueryRouter {
+	if !isAlphaNumeric(r) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+	if rtr.routes[r] != nil {
+		panic("route " + r + " has already been initialized")
+	}
+	rtr.routes[r] = q
+	return rtr
+}
+
+// Route returns the querier of the route, nil if there is none
+func (rtr *queryRouter) Route(path string) sdk.Querier {
+	return rtr.routes[path]
+}
@@ -2,6 +2,8 @@ package context
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 
 	"github.com/tendermint/tendermint/libs/common"
 
@@ -65,6 +67,12 @@ func (ctx CoreContext) Query(path string) (res []byte, err error) {
 	return ctx.query(path, nil)
 }
 
+// QueryWithData queries Tendermint at the path with the data, e.g. the
+// params of a custom query
+func (ctx CoreContext) QueryWithData(path string, data []byte) (res []byte, err error) {
+	return ctx.query(path, data)
+}
+
 // QueryStore from Tendermint with the provided key and storename
 func (ctx CoreContext) QueryStore(key cmn.HexBytes, storeName string) (res []byte, err error) {
 	return ctx.queryStore(key, storeName, "key")
@@ -129,61 +137,213 @@ func (ctx CoreContext) GetFromAddress() (from sdk.AccAddress, err error) {
 	return sdk.AccAddress(info.GetPubKey().Address()), nil
 }
 
-// sign and build the transaction from the msg
-func (ctx CoreContext) SignAndBuild(name, passphrase string, msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
-
-	// build the Sign Messsage from the Standard Message
+// BuildSignMsg builds the message to sign for the msgs from the context's
+// chain ID, account number, sequence, fee, memo and timeout height and nonce
+func (ctx CoreContext) BuildSignMsg(msgs []sdk.Msg) (auth.StdSignMsg, error) {
 	chainID := ctx.ChainID
 	if chainID == "" {
-		return nil, errors.Errorf("chain ID required but not specified")
+		return auth.StdSignMsg{}, errors.Errorf("chain ID required but not specified")
+	}
+
+	fee, err := ctx.parseFee()
+	if err != nil {
+		return auth.StdSignMsg{}, err
 	}
-	accnum := ctx.AccountNumber
-	sequence := ctx.Sequence
-	memo := ctx.Memo
 
+	return auth.StdSignMsg{
+		ChainID:       chainID,
+		AccountNumber: ctx.AccountNumber,
+		Sequence:      ctx.Sequence,
+		Msgs:          msgs,
+		Memo:          ctx.Memo,
+		Fee:           fee,
+		TimeoutHeight: ctx.TimeoutHeight,
+		Nonce:         ctx.Nonce,
+	}, nil
+}
+
+// ParseCoin parses a coin in the base or display units of the denominations
+// of the context, see EnsureDenoms
+func (ctx CoreContext) ParseCoin(coinStr string) (sdk.Coin, error) {
+	return sdk.ParseCoinWithFormat(coinStr, ctx.denomFormat(), ctx.DenomMetadata...)
+}
+
+// ParseCoins parses coins in the base or display units of the denominations
+// of the context, see EnsureDenoms
+func (ctx CoreContext) ParseCoins(coinsStr string) (sdk.Coins, error) {
+	return sdk.ParseCoinsWithFormat(coinsStr, ctx.denomFormat(), ctx.DenomMetadata...)
+}
+
+// the denomination format of the context, the default one if not set
+func (ctx CoreContext) denomFormat() string {
+	if ctx.DenomFormat == "" {
+		return sdk.DefaultDenomRegex
+	}
+	return ctx.DenomFormat
+}
+
+// parse the fee of the context, using the gas limit of the context
+func (ctx CoreContext) parseFee() (auth.StdFee, error) {
 	fee := sdk.Coin{}
 	if ctx.Fee != "" {
-		parsedFee, err := sdk.ParseCoin(ctx.Fee)
+		parsedFee, err := ctx.ParseCoin(ctx.Fee)
 		if err != nil {
-			return nil, err
+			return auth.StdFee{}, err
 		}
 		fee = parsedFee
 	}
+	stdFee := auth.NewStdFee(ctx.Gas, fee)
+	if ctx.FeeGranter != "" {
+		granter, err := sdk.AccAddressFromBech32(ctx.FeeGranter)
+		if err != nil {
+			return auth.StdFee{}, err
+		}
+		stdFee.Granter = granter
+	}
+	return stdFee, nil
+}
 
-	signMsg := auth.StdSignMsg{
-		ChainID:       chainID,
-		AccountNumber: accnum,
-		Sequence:      sequence,
-		Msgs:          msgs,
-		Memo:          memo,
-		Fee:           auth.NewStdFee(ctx.Gas, fee), // TODO run simulate to estimate gas?
+// MakeSignature signs the message rendered in the sign mode with the named
+// key, the codec encodes the msgs in binary sign mode
+func MakeSignature(name, passphrase string, msg auth.StdSignMsg, mode sdk.SignMode, cdc *wire.Codec) (sig auth.StdSignature, err error) {
+	keybase, err := keys.GetKeyBase()
+	if err != nil {
+		return
 	}
+	signBytes, err := msg.SignBytes(cdc, mode)
+	if err != nil {
+		return
+	}
+	sigBytes, pubkey, err := keybase.Sign(name, passphrase, signBytes, mode)
+	if err != nil {
+		return
+	}
+	return auth.StdSignature{
+		PubKey:        pubkey,
+		Signature:     sigBytes,
+		AccountNumber: msg.AccountNumber,
+		Sequence:      msg.Sequence,
+		SignMode:      mode,
+	}, nil
+}
 
+// Simulate simulates the msgs as a transaction signed by the named key,
+// without signing it. The simulation recommends a gas limit for the msgs.
+func (ctx CoreContext) Simulate(name string, msgs []sdk.Msg, cdc *wire.Codec) (sdk.SimulationResult, error) {
+	var simulation sdk.SimulationResult
 	keybase, err := keys.GetKeyBase()
+	if err != nil {
+		return simulation, err
+	}
+	info, err := keybase.Get(name)
+	if err != nil {
+		return simulation, err
+	}
+	signMsg, err := ctx.BuildSignMsg(msgs)
+	if err != nil {
+		return simulation, err
+	}
+
+	// the signature is left out, only its pubkey is needed to simulate
+	sig := auth.StdSignature{
+		PubKey:        info.GetPubKey(),
+		AccountNumber: signMsg.AccountNumber,
+		Sequence:      signMsg.Sequence,
+	}
+	tx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, []auth.StdSignature{sig}, signMsg.Memo)
+	tx.TimeoutHeight = signMsg.TimeoutHeight
+	tx.Nonce = signMsg.Nonce
+	txBytes, err := cdc.MarshalBinary(tx)
+	if err != nil {
+		return simulation, err
+	}
+
+	adjustment := ctx.GasAdjustment
+	if adjustment <= 0 {
+		adjustment = sdk.DefaultGasAdjustment
+	}
+	path := fmt.Sprintf("/app/simulation/%s", strconv.FormatFloat(adjustment, 'f', -1, 64))
+	res, err := ctx.query(path, txBytes)
+	if err != nil {
+		return simulation, err
+	}
+	err = cdc.UnmarshalBinary(res, &simulation)
+	if err != nil {
+		return simulation, err
+	}
+	if !simulation.IsOK() {
+		return simulation, errors.Errorf("simulation failed: (%d) %s", simulation.Code, simulation.Log)
+	}
+	return simulation, nil
+}
+
+// EnsureGas sets the gas limit recommended by the simulation of the msgs if
+// the context simulates the gas
+func (ctx CoreContext) EnsureGas(name string, msgs []sdk.Msg, cdc *wire.Codec) (CoreContext, error) {
+	if !ctx.SimulateGas {
+		return ctx, nil
+	}
+	simulation, err := ctx.Simulate(name, msgs, cdc)
+	if err != nil {
+		return ctx, err
+	}
+	fmt.Fprintf(os.Stderr, "Estimated gas: %d, gas used by the simulation: %d\n", simulation.GasRecommended, simulation.GasUsed)
+	ctx = ctx.WithGas(simulation.GasRecommended)
+	ctx.SimulateGas = false
+	return ctx, nil
+}
+
+// sign and build the transaction from the msg
+func (ctx CoreContext) SignAndBuild(name, passphrase string, msgs []sdk.Msg, cdc *wire.Codec) ([]byte, error) {
+	ctx, err := ctx.EnsureGas(name, msgs, cdc)
+	if err != nil {
+		return nil, err
+	}
+	signMsg, err := ctx.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+	mode, err := sdk.ParseSignMode(ctx.SignMode)
 	if err != nil {
 		return nil, err
 	}
 
 	// sign and build
-	bz := signMsg.Bytes()
-
-	sig, pubkey, err := keybase.Sign(name, passphrase, bz)
+	sig, err := MakeSignature(name, passphrase, signMsg, mode, cdc)
 	if err != nil {
 		return nil, err
 	}
-	sigs := []auth.StdSignature{{
-		PubKey:        pubkey,
-		Signature:     sig,
-		AccountNumber: accnum,
-		Sequence:      sequence,
-	}}
 
 	// marshal bytes
-	tx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, sigs, memo)
+	tx := auth.NewStdTx(signMsg.Msgs, signMsg.Fee, []auth.StdSignature{sig}, signMsg.Memo)
+	tx.TimeoutHeight = signMsg.TimeoutHeight
+	tx.Nonce = signMsg.Nonce
 
 	return cdc.MarshalBinary(tx)
 }
 
+// PrintUnsignedStdTx prints the msgs as an unsigned StdTx in JSON, it may
+// then be signed offline and broadcasted.
+func (ctx CoreContext) PrintUnsignedStdTx(msgs []sdk.Msg, cdc *wire.Codec) error {
+	ctx, err := EnsureDenoms(ctx, cdc)
+	if err != nil {
+		return err
+	}
+	fee, err := ctx.parseFee()
+	if err != nil {
+		return err
+	}
+	tx := auth.NewStdTx(msgs, fee, nil, ctx.Memo)
+	tx.TimeoutHeight = ctx.TimeoutHeight
+	tx.Nonce = ctx.Nonce
+	json, err := wire.MarshalJSONIndent(cdc, tx)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(json))
+	return nil
+}
+
 // sign and build the transaction from the msg
 func (ctx CoreContext) ensureSignBuild(name string, msgs []sdk.Msg, cdc *wire.Codec) (tyBytes []byte, err error) {
 	err = EnsureAccountExists(ctx, name)
@@ -195,8 +355,17 @@ func (ctx CoreContext) ensureSignBuild(name string, msgs []sdk.Msg, cdc *wire.Co
 	if err != nil {
 		return nil, err
 	}
-	// default to next sequence number if none provided
-	ctx, err = EnsureSequence(ctx)
+	// default to next sequence number if none provided, unordered txs
+	// don't use it
+	if ctx.TimeoutHeight == 0 {
+		ctx, err = EnsureSequence(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the fee may be given in display units
+	ctx, err = EnsureDenoms(ctx, cdc)
 	if err != nil {
 		return nil, err
 	}
@@ -230,12 +399,24 @@ func (ctx CoreContext) ensureSignBuild(name string, msgs []sdk.Msg, cdc *wire.Co
 
 // sign and build the transaction from the msg
 func (ctx CoreContext) EnsureSignBuildBroadcast(name string, msgs []sdk.Msg, cdc *wire.Codec) (err error) {
+	if ctx.GenerateOnly {
+		// the simulation needs the account number and sequence of the signer
+		if ctx.SimulateGas {
+			return errors.Errorf("--gas=%s can't be used with --generate-only", client.GasFlagAuto)
+		}
+		return ctx.PrintUnsignedStdTx(msgs, cdc)
+	}
 
 	txBytes, err := ctx.ensureSignBuild(name, msgs, cdc)
 	if err != nil {
 		return err
 	}
 
+	return ctx.BroadcastAndPrint(txBytes, cdc)
+}
+
+// BroadcastAndPrint broadcasts the transaction bytes and prints the response
+func (ctx CoreContext) BroadcastAndPrint(txBytes []byte, cdc *wire.Codec) error {
 	if ctx.Async {
 		res, err := ctx.BroadcastTxAsync(txBytes)
 		if err != nil {
@@ -3,6 +3,8 @@ package context
 import (
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
@@ -11,6 +13,8 @@ type CoreContext struct {
 	ChainID         string
 	Height          int64
 	Gas             int64
+	SimulateGas     bool
+	GasAdjustment   float64
 	Fee             string
 	TrustNode       bool
 	NodeURI         string
@@ -21,10 +25,18 @@ type CoreContext struct {
 	Client          rpcclient.Client
 	Decoder         auth.AccountDecoder
 	AccountStore    string
+	BankStore       string
+	DenomFormat     string
+	DenomMetadata   []sdk.DenomMetadata
 	UseLedger       bool
 	Async           bool
 	JSON            bool
 	PrintResponse   bool
+	GenerateOnly    bool
+	FeeGranter      string
+	TimeoutHeight   int64
+	Nonce           int64
+	SignMode        string
 }
 
 // WithChainID - return a copy of the context with an updated chainID
@@ -45,6 +57,19 @@ func (c CoreContext) WithGas(gas int64) CoreContext {
 	return c
 }
 
+// WithGasSetting - return a copy of the context with the gas limit of the
+// setting, a number or "auto" to simulate the txs and use the recommended
+// gas limit
+func (c CoreContext) WithGasSetting(setting string) (CoreContext, error) {
+	simulate, gas, err := client.ParseGas(setting)
+	if err != nil {
+		return c, err
+	}
+	c.SimulateGas = simulate
+	c.Gas = gas
+	return c, nil
+}
+
 // WithFee - return a copy of the context with an updated fee
 func (c CoreContext) WithFee(fee string) CoreContext {
 	c.Fee = fee
@@ -106,8 +131,36 @@ func (c CoreContext) WithAccountStore(accountStore string) CoreContext {
 	return c
 }
 
+// WithBankStore - return a copy of the context with an updated BankStore
+func (c CoreContext) WithBankStore(bankStore string) CoreContext {
+	c.BankStore = bankStore
+	return c
+}
+
+// WithDenoms - return a copy of the context with an updated denomination
+// format and metadata of the registered denominations
+func (c CoreContext) WithDenoms(format string, metadata []sdk.DenomMetadata) CoreContext {
+	c.DenomFormat = format
+	c.DenomMetadata = metadata
+	return c
+}
+
 // WithUseLedger - return a copy of the context with an updated UseLedger
 func (c CoreContext) WithUseLedger(useLedger bool) CoreContext {
 	c.UseLedger = useLedger
 	return c
 }
+
+// WithFeeGranter - return a copy of the context with an updated fee granter
+func (c CoreContext) WithFeeGranter(feeGranter string) CoreContext {
+	c.FeeGranter = feeGranter
+	return c
+}
+
+// WithTimeoutHeight - return a copy of the context with an updated timeout
+// height and nonce, making its txs unordered
+func (c CoreContext) WithTimeoutHeight(timeoutHeight, nonce int64) CoreContext {
+	c.TimeoutHeight = timeoutHeight
+	c.Nonce = nonce
+	return c
+}
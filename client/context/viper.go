@@ -7,13 +7,16 @@ import (
 	"github.com/spf13/viper"
 
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	cmn "github.com/tendermint/tendermint/libs/common"
 	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
 // NewCoreContextFromViper - return a new context with parameters from the command line
@@ -38,10 +41,20 @@ func NewCoreContextFromViper() CoreContext {
 	} else {
 		keyName = viper.GetString(client.FlagFrom)
 	}
+	// unordered txs need a unique nonce
+	timeoutHeight := viper.GetInt64(client.FlagTimeoutHeight)
+	nonce := viper.GetInt64(client.FlagNonce)
+	if timeoutHeight != 0 && nonce == 0 {
+		nonce = cmn.RandInt63()
+	}
+	// the gas flag is validated when set
+	simulateGas, gas, _ := client.ParseGas(viper.GetString(client.FlagGas))
 	return CoreContext{
 		ChainID:         chainID,
 		Height:          viper.GetInt64(client.FlagHeight),
-		Gas:             viper.GetInt64(client.FlagGas),
+		Gas:             gas,
+		SimulateGas:     simulateGas,
+		GasAdjustment:   viper.GetFloat64(client.FlagGasAdjustment),
 		Fee:             viper.GetString(client.FlagFee),
 		TrustNode:       viper.GetBool(client.FlagTrustNode),
 		FromAddressName: keyName,
@@ -52,10 +65,16 @@ func NewCoreContextFromViper() CoreContext {
 		Client:          rpc,
 		Decoder:         nil,
 		AccountStore:    "acc",
+		BankStore:       "bank",
 		UseLedger:       viper.GetBool(client.FlagUseLedger),
 		Async:           viper.GetBool(client.FlagAsync),
 		JSON:            viper.GetBool(client.FlagJson),
 		PrintResponse:   viper.GetBool(client.FlagPrintResponse),
+		GenerateOnly:    viper.GetBool(client.FlagGenerateOnly),
+		FeeGranter:      viper.GetString(client.FlagFeeGranter),
+		TimeoutHeight:   timeoutHeight,
+		Nonce:           nonce,
+		SignMode:        viper.GetString(client.FlagSignMode),
 	}
 }
 
@@ -139,3 +158,48 @@ func EnsureSequence(ctx CoreContext) (CoreContext, error) {
 	ctx = ctx.WithSequence(seq)
 	return ctx, nil
 }
+
+// EnsureDenoms - query the denomination format and the registered
+// denominations of the chain if not already set, txs generated offline
+// are given in base units of the default format
+func EnsureDenoms(ctx CoreContext, cdc *wire.Codec) (CoreContext, error) {
+	if ctx.DenomFormat != "" {
+		return ctx, nil
+	}
+	format, metadata, err := queryDenoms(ctx, cdc)
+	if err != nil {
+		if ctx.GenerateOnly {
+			return ctx.WithDenoms(sdk.DefaultDenomRegex, nil), nil
+		}
+		return ctx, err
+	}
+	return ctx.WithDenoms(format, metadata), nil
+}
+
+// query the denomination format and the metadata of the bank store
+func queryDenoms(ctx CoreContext, cdc *wire.Codec) (format string, metadata []sdk.DenomMetadata, err error) {
+	format = sdk.DefaultDenomRegex
+	res, err := ctx.QueryStore(bank.DenomFormatKey, ctx.BankStore)
+	if err != nil {
+		return
+	}
+	if len(res) > 0 {
+		err = cdc.UnmarshalBinary(res, &format)
+		if err != nil {
+			return
+		}
+	}
+
+	resKVs, err := ctx.QuerySubspace(cdc, bank.DenomMetadataKeyPrefix, ctx.BankStore)
+	if err != nil {
+		return
+	}
+	metadata = make([]sdk.DenomMetadata, len(resKVs))
+	for i, kv := range resKVs {
+		err = cdc.UnmarshalBinary(kv.Value, &metadata[i])
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -1,6 +1,13 @@
 package client
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // nolint
 const (
@@ -9,6 +16,7 @@ const (
 	FlagNode          = "node"
 	FlagHeight        = "height"
 	FlagGas           = "gas"
+	FlagGasAdjustment = "gas-adjustment"
 	FlagTrustNode     = "trust-node"
 	FlagFrom          = "from"
 	FlagName          = "name"
@@ -19,6 +27,11 @@ const (
 	FlagAsync         = "async"
 	FlagJson          = "json"
 	FlagPrintResponse = "print-response"
+	FlagGenerateOnly  = "generate-only"
+	FlagFeeGranter    = "fee-granter"
+	FlagTimeoutHeight = "timeout-height"
+	FlagNonce         = "nonce"
+	FlagSignMode      = "sign-mode"
 )
 
 // LineBreak can be included in a command list to provide a blank line
@@ -47,13 +60,54 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Int64(FlagSequence, 0, "Sequence number to sign the tx")
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFee, "", "Fee to pay along with transaction")
+		c.Flags().String(FlagFeeGranter, "", "Address of the account paying the fee from its fee allowance")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
-		c.Flags().Int64(FlagGas, 200000, "gas limit to set per-transaction")
+		gas := gasValue("200000")
+		c.Flags().Var(&gas, FlagGas, fmt.Sprintf("gas limit to set per-transaction, or %q to simulate the transaction and use the recommended gas limit", GasFlagAuto))
+		c.Flags().Float64(FlagGasAdjustment, sdk.DefaultGasAdjustment, "multiplier of the gas used by the simulation, giving the gas limit with --gas=auto")
 		c.Flags().Bool(FlagAsync, false, "broadcast transactions asynchronously")
 		c.Flags().Bool(FlagJson, false, "return output in json format")
 		c.Flags().Bool(FlagPrintResponse, false, "return tx response (only works with async = false)")
+		c.Flags().Bool(FlagGenerateOnly, false, "build an unsigned transaction and write it to STDOUT")
+		c.Flags().Int64(FlagTimeoutHeight, 0, "Build an unordered transaction valid until this height, for accounts accepting them")
+		c.Flags().Int64(FlagNonce, 0, "Unique nonce of an unordered transaction, random if not set")
+		c.Flags().String(FlagSignMode, "json", "Encoding of the signed transaction: json, binary or textual")
 	}
 	return cmds
 }
+
+// GasFlagAuto is the value of the gas flag simulating the tx to recommend
+// its gas limit
+const GasFlagAuto = "auto"
+
+// ParseGas parses the value of the gas flag, a gas limit or "auto". An empty
+// value is a gas limit of 0.
+func ParseGas(s string) (simulate bool, gas int64, err error) {
+	switch s {
+	case GasFlagAuto:
+		return true, 0, nil
+	case "":
+		return false, 0, nil
+	}
+	gas, err = strconv.ParseInt(s, 10, 64)
+	if err != nil || gas < 0 {
+		return false, 0, fmt.Errorf("gas must be a non-negative integer or %q, got %q", GasFlagAuto, s)
+	}
+	return false, gas, nil
+}
+
+// gasValue is the value of the gas flag, it is validated when set
+type gasValue string
+
+// nolint
+func (v *gasValue) String() string { return string(*v) }
+func (v *gasValue) Type() string   { return "string" }
+func (v *gasValue) Set(s string) error {
+	if _, _, err := ParseGas(s); err != nil {
+		return err
+	}
+	*v = gasValue(s)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
@@ -14,17 +15,21 @@ import (
 
 	ccrypto "github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 
+	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/cli"
 )
 
 const (
-	flagType     = "type"
-	flagRecover  = "recover"
-	flagNoBackup = "no-backup"
-	flagDryRun   = "dry-run"
-	flagAccount  = "account"
-	flagIndex    = "index"
+	flagType              = "type"
+	flagRecover           = "recover"
+	flagNoBackup          = "no-backup"
+	flagDryRun            = "dry-run"
+	flagAccount           = "account"
+	flagIndex             = "index"
+	flagMultisig          = "multisig"
+	flagMultisigThreshold = "multisig-threshold"
 )
 
 func addKeyCommand() *cobra.Command {
@@ -33,7 +38,9 @@ func addKeyCommand() *cobra.Command {
 		Short: "Create a new key, or import from seed",
 		Long: `Add a public/private key pair to the key store.
 If you select --seed/-s you can recover a key from the seed
-phrase, otherwise, a new key will be generated.`,
+phrase, otherwise, a new key will be generated.
+If you select --multisig, a reference to a K-of-N threshold multisig key
+made of the given stored keys is saved instead.`,
 		RunE: runAddCmd,
 	}
 	cmd.Flags().StringP(flagType, "t", "secp256k1", "Type of private key (secp256k1|ed25519)")
@@ -43,6 +50,8 @@ phrase, otherwise, a new key will be generated.`,
 	cmd.Flags().Bool(flagDryRun, false, "Perform action, but don't add key to local keystore")
 	cmd.Flags().Uint32(flagAccount, 0, "Account number for HD derivation")
 	cmd.Flags().Uint32(flagIndex, 0, "Index number for HD derivation")
+	cmd.Flags().String(flagMultisig, "", "Comma separated names of the keys which make up a multisig key")
+	cmd.Flags().Int(flagMultisigThreshold, 1, "Number of signatures required to sign for the multisig key")
 	return cmd
 }
 
@@ -79,6 +88,12 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 			}
 		}
 
+		multisigKeys := viper.GetString(flagMultisig)
+		if len(multisigKeys) != 0 {
+			return createMultisigKey(kb, name, strings.Split(multisigKeys, ","),
+				viper.GetInt(flagMultisigThreshold))
+		}
+
 		// ask for a password when generating a local key
 		if !viper.GetBool(client.FlagUseLedger) {
 			pass, err = client.GetCheckPassword(
@@ -124,6 +139,30 @@ func runAddCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// store a reference to a threshold multisig key made of the named keys
+func createMultisigKey(kb keys.Keybase, name string, keyNames []string, threshold int) error {
+	if threshold <= 0 || threshold > len(keyNames) {
+		return fmt.Errorf("threshold must be between 1 and %d", len(keyNames))
+	}
+	pks := make([]crypto.PubKey, len(keyNames))
+	for i, keyName := range keyNames {
+		k, err := kb.Get(strings.TrimSpace(keyName))
+		if err != nil {
+			return err
+		}
+		pks[i] = k.GetPubKey()
+	}
+
+	info, err := kb.CreateOffline(name, multisig.NewPubKeyMultisigThreshold(threshold, pks))
+	if err != nil {
+		return err
+	}
+	// there is no seed phrase for a multisig key
+	viper.Set(flagNoBackup, true)
+	printCreate(info, "")
+	return nil
+}
+
 func printCreate(info keys.Info, seed string) {
 	output := viper.Get(cli.OutputFlag)
 	switch output {
@@ -6,6 +6,7 @@ import (
 
 	keybase "github.com/cosmos/cosmos-sdk/client/keys"
 	keys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // REST request body
@@ -37,7 +38,7 @@ func SignTxRequstHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	//TODO check if account exists
-	sig, _, err := kb.Sign(m.Name, m.Password, []byte(m.TxBytes))
+	sig, _, err := kb.Sign(m.Name, m.Password, []byte(m.TxBytes), sdk.SignModeJSON)
 	if err != nil {
 		w.WriteHeader(403)
 		w.Write([]byte(err.Error()))
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"io"
 	"os"
+	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -15,9 +16,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -33,6 +38,24 @@ var (
 	DefaultNodeHome = os.ExpandEnv("$HOME/.gaiad")
 )
 
+// permissions of the module accounts by module name
+var moduleAccountPermissions = map[string][]string{
+	auth.FeeCollectorName:   nil,
+	gov.ModuleName:          {auth.Burner},
+	distribution.ModuleName: nil,
+	mint.ModuleName:         {auth.Minter},
+	stake.BondedPoolName:    {auth.Staking, auth.Burner},
+	stake.NotBondedPoolName: {auth.Staking, auth.Burner},
+}
+
+// addresses of the module accounts, which can't receive sends
+func moduleAccountAddrs() (addrs []sdk.AccAddress) {
+	for name := range moduleAccountPermissions {
+		addrs = append(addrs, auth.NewModuleAddress(name))
+	}
+	return addrs
+}
+
 // Extended ABCI application
 type GaiaApp struct {
 	*bam.BaseApp
@@ -41,22 +64,42 @@ type GaiaApp struct {
 	// keys to access the substores
 	keyMain          *sdk.KVStoreKey
 	keyAccount       *sdk.KVStoreKey
+	keyBank          *sdk.KVStoreKey
 	keyIBC           *sdk.KVStoreKey
 	keyStake         *sdk.KVStoreKey
 	keySlashing      *sdk.KVStoreKey
 	keyGov           *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
+	keyFeeGrant      *sdk.KVStoreKey
+	keyAuthz         *sdk.KVStoreKey
+	keyReplay        *sdk.KVStoreKey
+	keyDistr         *sdk.KVStoreKey
+	keyMint          *sdk.KVStoreKey
 
 	// Manage getting and setting accounts
 	accountMapper       auth.AccountMapper
 	feeCollectionKeeper auth.FeeCollectionKeeper
+	replayKeeper        auth.ReplayKeeper
 	coinKeeper          bank.Keeper
+	denomKeeper         bank.DenomKeeper
+	issuanceKeeper      bank.IssuanceKeeper
 	ibcMapper           ibc.Mapper
 	stakeKeeper         stake.Keeper
 	slashingKeeper      slashing.Keeper
 	govKeeper           gov.Keeper
 	paramsKeeper        params.Keeper
+	feeGrantKeeper      feegrant.Keeper
+	authzKeeper         authz.Keeper
+	distrKeeper         distribution.Keeper
+	mintKeeper          mint.Keeper
+
+	// module gauges recorded at the end of every block
+	stakeMetrics *stake.Metrics
+	govMetrics   *gov.Metrics
+
+	// indexes the transfers of the blocks by address if set
+	historyIndexer *bank.HistoryIndexer
 }
 
 // NewGaiaApp returns a reference to an initialized GaiaApp.
@@ -71,44 +114,85 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		cdc:              cdc,
 		keyMain:          sdk.NewKVStoreKey("main"),
 		keyAccount:       sdk.NewKVStoreKey("acc"),
+		keyBank:          sdk.NewKVStoreKey("bank"),
 		keyIBC:           sdk.NewKVStoreKey("ibc"),
 		keyStake:         sdk.NewKVStoreKey("stake"),
 		keySlashing:      sdk.NewKVStoreKey("slashing"),
 		keyGov:           sdk.NewKVStoreKey("gov"),
 		keyFeeCollection: sdk.NewKVStoreKey("fee"),
 		keyParams:        sdk.NewKVStoreKey("params"),
+		keyFeeGrant:      sdk.NewKVStoreKey("feegrant"),
+		keyAuthz:         sdk.NewKVStoreKey("authz"),
+		keyReplay:        sdk.NewKVStoreKey("replay"),
+		keyDistr:         sdk.NewKVStoreKey("distr"),
+		keyMint:          sdk.NewKVStoreKey("mint"),
+		stakeMetrics:     stake.NopMetrics(),
+		govMetrics:       gov.NopMetrics(),
 	}
 
-	// define the accountMapper
+	// define the accountMapper, indexed for the account queries
 	app.accountMapper = auth.NewAccountMapper(
 		app.cdc,
 		app.keyAccount,        // target store
 		auth.ProtoBaseAccount, // prototype
-	)
+	).WithIndexes()
 
-	// add handlers
-	app.coinKeeper = bank.NewKeeper(app.accountMapper)
-	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
+	// add handlers, the coinKeeper records the supply in the bank store,
+	// moves the coins of the module accounts, which can't receive sends, and
+	// reads whether sends are enabled from the params
 	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams)
+	app.coinKeeper = bank.NewKeeper(app.accountMapper).
+		WithSupply(bank.NewSupplyKeeper(app.cdc, app.keyBank)).
+		WithModuleAccounts(moduleAccountPermissions).
+		WithBlockedAddrs(moduleAccountAddrs()...).
+		WithParams(app.paramsKeeper.Setter())
+	app.denomKeeper = bank.NewDenomKeeper(app.cdc, app.keyBank, app.RegisterCodespace(bank.DefaultCodespace))
+	app.issuanceKeeper = bank.NewIssuanceKeeper(app.cdc, app.keyBank, app.coinKeeper, app.denomKeeper, app.RegisterCodespace(bank.DefaultCodespace))
+	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
+
+	// the distrKeeper keeps the rewards of the validators and of the
+	// delegations through the hooks of the stakeKeeper
 	app.stakeKeeper = stake.NewKeeper(app.cdc, app.keyStake, app.coinKeeper, app.RegisterCodespace(stake.DefaultCodespace))
-	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.coinKeeper, app.stakeKeeper, app.RegisterCodespace(gov.DefaultCodespace))
-	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.cdc, app.keyFeeCollection)
-	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, app.stakeKeeper, app.paramsKeeper.Getter(), app.RegisterCodespace(slashing.DefaultCodespace))
+	app.distrKeeper = distribution.NewKeeper(app.cdc, app.keyDistr, app.paramsKeeper.Setter(), app.coinKeeper, app.stakeKeeper, app.RegisterCodespace(distribution.DefaultCodespace))
+	app.stakeKeeper = app.stakeKeeper.WithHooks(app.distrKeeper.Hooks())
+	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, app.coinKeeper, app.stakeKeeper)
+
+	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.coinKeeper, app.stakeKeeper, app.RegisterCodespace(gov.DefaultCodespace)).
+		WithParamChanges(params.NewChangeRouter().
+			AddRoute("bank", bank.NewParamChangeHandler(app.coinKeeper)).
+			AddRoute("distribution", distribution.NewParamChangeHandler(app.distrKeeper))).
+		WithCommunityPool(app.distrKeeper)
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.cdc, app.keyFeeCollection).WithModuleAccount(app.accountMapper)
+	app.replayKeeper = auth.NewReplayKeeper(app.cdc, app.keyReplay)
+	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, app.stakeKeeper, app.paramsKeeper.Setter(), app.RegisterCodespace(slashing.DefaultCodespace))
+	app.feeGrantKeeper = feegrant.NewKeeper(app.cdc, app.keyFeeGrant, app.RegisterCodespace(feegrant.DefaultCodespace))
+	app.authzKeeper = authz.NewKeeper(app.cdc, app.keyAuthz, app.Router(), app.RegisterCodespace(authz.DefaultCodespace))
 
 	// register message routes
 	app.Router().
-		AddRoute("bank", bank.NewHandler(app.coinKeeper)).
+		AddRoute("auth", auth.NewHandler(app.accountMapper, app.replayKeeper)).
+		AddRoute("bank", bank.NewIssuanceHandler(app.issuanceKeeper)).
 		AddRoute("ibc", ibc.NewHandler(app.ibcMapper, app.coinKeeper)).
 		AddRoute("stake", stake.NewHandler(app.stakeKeeper)).
 		AddRoute("slashing", slashing.NewHandler(app.slashingKeeper)).
-		AddRoute("gov", gov.NewHandler(app.govKeeper))
+		AddRoute("gov", gov.NewHandler(app.govKeeper)).
+		AddRoute("feegrant", feegrant.NewHandler(app.feeGrantKeeper)).
+		AddRoute("authz", authz.NewHandler(app.authzKeeper)).
+		AddRoute("distribution", distribution.NewHandler(app.distrKeeper))
+
+	// register query routes
+	app.QueryRouter().
+		AddRoute("auth", auth.NewQuerier(app.accountMapper)).
+		AddRoute("bank", bank.NewQuerier(app.cdc, app.coinKeeper)).
+		AddRoute("distribution", distribution.NewQuerier(app.distrKeeper)).
+		AddRoute("mint", mint.NewQuerier(app.mintKeeper))
 
 	// initialize BaseApp
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountMapper, app.feeCollectionKeeper))
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyIBC, app.keyStake, app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams)
+	app.SetAnteHandler(auth.NewReplayAnteHandler(app.accountMapper, app.feeCollectionKeeper, app.feeGrantKeeper, app.replayKeeper, app.denomKeeper))
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyBank, app.keyIBC, app.keyStake, app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams, app.keyFeeGrant, app.keyAuthz, app.keyReplay, app.keyDistr, app.keyMint)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
 		cmn.Exit(err.Error())
@@ -117,6 +201,12 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	return app
 }
 
+// SetModuleMetrics sets the module gauges recorded at the end of every block
+func (app *GaiaApp) SetModuleMetrics(stakeMetrics *stake.Metrics, govMetrics *gov.Metrics) {
+	app.stakeMetrics = stakeMetrics
+	app.govMetrics = govMetrics
+}
+
 // custom tx codec
 func MakeCodec() *wire.Codec {
 	var cdc = wire.NewCodec()
@@ -125,6 +215,9 @@ func MakeCodec() *wire.Codec {
 	stake.RegisterWire(cdc)
 	slashing.RegisterWire(cdc)
 	gov.RegisterWire(cdc)
+	feegrant.RegisterWire(cdc)
+	authz.RegisterWire(cdc)
+	distribution.RegisterWire(cdc)
 	auth.RegisterWire(cdc)
 	sdk.RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
@@ -133,8 +226,13 @@ func MakeCodec() *wire.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	distribution.BeginBlocker(ctx, req, app.distrKeeper)
+	mint.BeginBlocker(ctx, app.mintKeeper)
+
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
 
+	app.replayKeeper.PruneExpiredTxs(ctx)
+
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
 	}
@@ -147,6 +245,9 @@ func (app *GaiaApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.R
 
 	tags, _ := gov.EndBlocker(ctx, app.govKeeper)
 
+	app.stakeMetrics.Record(ctx, app.stakeKeeper)
+	app.govMetrics.Record(ctx, app.govKeeper)
+
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
 		Tags:             tags,
@@ -165,13 +266,46 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		// return sdk.ErrGenesisParse("").TraceCause(err, "")
 	}
 
-	// load the accounts
+	err = GaiaValidateGenesisState(genesisState)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// load the accounts, skipping the account numbers before the one of
+	// the account
 	for _, gacc := range genesisState.Accounts {
 		acc := gacc.ToAccount()
-		acc.AccountNumber = app.accountMapper.GetNextAccountNumber(ctx)
+		accNumber := app.accountMapper.GetNextAccountNumber(ctx)
+		for accNumber < gacc.AccountNumber {
+			accNumber = app.accountMapper.GetNextAccountNumber(ctx)
+		}
+		err = acc.SetAccountNumber(accNumber)
+		if err != nil {
+			panic(err)
+		}
 		app.accountMapper.SetAccount(ctx, acc)
 	}
 
+	// load the unordered accounts and the pubkey change times
+	err = auth.InitGenesis(ctx, app.accountMapper, app.replayKeeper, genesisState.AuthData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// load the denomination registry and the issued denominations
+	err = bank.InitGenesis(ctx, app.denomKeeper, app.issuanceKeeper, genesisState.BankData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// the denominations of the genesis which aren't issued can't be issued
+	app.reserveDenom(ctx, genesisState.StakeData.Params.BondDenom)
+	for _, gacc := range genesisState.Accounts {
+		for _, coin := range gacc.Coins {
+			app.reserveDenom(ctx, coin.Denom)
+		}
+	}
+
 	// load the initial stake information
 	err = stake.InitGenesis(ctx, app.stakeKeeper, genesisState.StakeData)
 	if err != nil {
@@ -179,16 +313,68 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 		// return sdk.ErrGenesisParse("").TraceCause(err, "")
 	}
 
-	gov.InitGenesis(ctx, app.govKeeper, gov.DefaultGenesisState())
+	// start the rewards of the genesis validators and delegations
+	err = distribution.InitGenesis(ctx, app.distrKeeper, genesisState.DistrData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// start minting the provisions of the stake supply
+	err = mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// load the slashing params and the signing infos of the validators
+	err = slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.SlashingData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// load the proposals with their deposits and votes
+	err = gov.InitGenesis(ctx, app.govKeeper, genesisState.GovData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	err = ibc.InitGenesis(ctx, app.ibcMapper, genesisState.IBCData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	// without a supply in the genesis, the supply is the coins held
+	if len(genesisState.BankData.Supply) == 0 {
+		holdings := app.supplyHoldings(ctx)
+		app.coinKeeper.SetTotalSupply(ctx, holdings)
+	}
+
+	err = feegrant.InitGenesis(ctx, app.feeGrantKeeper, genesisState.FeeGrantData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
+
+	err = authz.InitGenesis(ctx, app.authzKeeper, genesisState.AuthzData)
+	if err != nil {
+		panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+	}
 
 	return abci.ResponseInitChain{}
 }
 
+// reserve the denomination unless it is issued
+func (app *GaiaApp) reserveDenom(ctx sdk.Context, denom string) {
+	if _, found := app.issuanceKeeper.GetIssuance(ctx, denom); found || denom == "" {
+		return
+	}
+	app.issuanceKeeper.ReserveDenom(ctx, denom)
+}
+
 // export the state of gaia for a genesis file
 func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	ctx := app.NewContext(true, abci.Header{})
 
-	// iterate to get the accounts
+	// iterate to get the accounts, in order of account number so that they
+	// keep it
 	accounts := []GenesisAccount{}
 	appendAccount := func(acc auth.Account) (stop bool) {
 		account := NewGenesisAccountI(acc)
@@ -196,10 +382,22 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 		return false
 	}
 	app.accountMapper.IterateAccounts(ctx, appendAccount)
+	sort.SliceStable(accounts, func(i, j int) bool {
+		return accounts[i].AccountNumber < accounts[j].AccountNumber
+	})
 
 	genState := GenesisState{
-		Accounts:  accounts,
-		StakeData: stake.WriteGenesis(ctx, app.stakeKeeper),
+		Accounts:     accounts,
+		AuthData:     auth.WriteGenesis(ctx, app.accountMapper, app.replayKeeper),
+		BankData:     bank.WriteGenesis(ctx, app.denomKeeper, app.issuanceKeeper),
+		StakeData:    stake.WriteGenesis(ctx, app.stakeKeeper),
+		DistrData:    distribution.WriteGenesis(ctx, app.distrKeeper),
+		MintData:     mint.WriteGenesis(ctx, app.mintKeeper),
+		SlashingData: slashing.WriteGenesis(ctx, app.slashingKeeper),
+		GovData:      gov.WriteGenesis(ctx, app.govKeeper),
+		IBCData:      ibc.WriteGenesis(ctx, app.ibcMapper),
+		FeeGrantData: feegrant.WriteGenesis(ctx, app.feeGrantKeeper),
+		AuthzData:    authz.WriteGenesis(ctx, app.authzKeeper),
 	}
 	appState, err = wire.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
@@ -1,11 +1,25 @@
 package app
 
 import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
@@ -15,8 +29,12 @@ func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
 	}
 
 	genesisState := GenesisState{
-		Accounts:  genaccs,
-		StakeData: stake.DefaultGenesisState(),
+		Accounts:     genaccs,
+		StakeData:    stake.DefaultGenesisState(),
+		DistrData:    distribution.DefaultGenesisState(),
+		MintData:     mint.DefaultGenesisState(),
+		SlashingData: slashing.DefaultGenesisState(),
+		GovData:      gov.DefaultGenesisState(),
 	}
 
 	stateBytes, err := wire.MarshalJSONIndent(gapp.cdc, genesisState)
@@ -31,3 +49,124 @@ func setGenesis(gapp *GaiaApp, accs ...*auth.BaseAccount) error {
 
 	return nil
 }
+
+func TestSupplyInvariant(t *testing.T) {
+	gapp := NewGaiaApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	addr := sdk.AccAddress([]byte("addr"))
+	acc := auth.NewBaseAccountWithAddress(addr)
+	acc.Coins = sdk.Coins{sdk.NewCoin("atom", 20), sdk.NewCoin("steak", 100)}
+	require.Nil(t, setGenesis(gapp, &acc))
+
+	// without a genesis supply, the supply is the coins of the genesis
+	ctx := gapp.NewContext(false, abci.Header{})
+	require.True(t, gapp.coinKeeper.GetTotalSupply(ctx).IsEqual(acc.Coins))
+	require.Nil(t, gapp.AssertSupplyInvariant(ctx))
+
+	// coins moved to the fee pool and minted through the keeper are held
+	_, _, err := gapp.coinKeeper.SubtractCoins(ctx, addr, sdk.Coins{sdk.NewCoin("steak", 10)})
+	require.Nil(t, err)
+	gapp.feeCollectionKeeper.AddCollectedFees(ctx, sdk.Coins{sdk.NewCoin("steak", 10)})
+	_, err = gapp.coinKeeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewCoin("atom", 5)})
+	require.Nil(t, err)
+	require.Nil(t, gapp.AssertSupplyInvariant(ctx))
+
+	// coins created without updating the supply break the invariant
+	_, _, err = gapp.coinKeeper.AddCoins(ctx, addr, sdk.Coins{sdk.NewCoin("atom", 1)})
+	require.Nil(t, err)
+	require.NotNil(t, gapp.AssertSupplyInvariant(ctx))
+}
+
+func TestStakeSupplyInvariant(t *testing.T) {
+	gapp := NewGaiaApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	// a genesis validator bonding 100 steak
+	pk := crypto.GenPrivKeyEd25519().PubKey()
+	addr := sdk.AccAddress(pk.Address())
+	appGenTx, _, _, err := GaiaAppGenTxNF(gapp.cdc, pk, addr, "validator")
+	require.Nil(t, err)
+	genesisState, err := GaiaAppGenState(gapp.cdc, []json.RawMessage{appGenTx})
+	require.Nil(t, err)
+	stateBytes, err := wire.MarshalJSONIndent(gapp.cdc, genesisState)
+	require.Nil(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	header := abci.Header{Height: 1, Time: 1000}
+	ctx := gapp.NewContext(false, header)
+	later := ctx.WithBlockHeader(abci.Header{Height: 1, Time: header.Time + gapp.stakeKeeper.GetParams(ctx).UnbondingTime})
+
+	// slashing a third of the tokens leaves each share worth a fraction of a
+	// token, so none of the unbondings below returns a whole amount
+	gapp.stakeKeeper.Slash(ctx, pk, header.Height, 100, sdk.NewRat(1, 3))
+	require.Nil(t, gapp.AssertSupplyInvariant(ctx))
+
+	for i := 0; i < 10; i++ {
+		require.Nil(t, gapp.stakeKeeper.BeginUnbonding(ctx, addr, addr, sdk.NewRat(10)))
+		if i == 0 {
+			// slash the unbonding delegation and the validator again
+			gapp.stakeKeeper.Slash(ctx, pk, 0, 100, sdk.NewRat(1, 3))
+		}
+		require.Nil(t, gapp.AssertSupplyInvariant(ctx))
+		require.Nil(t, gapp.stakeKeeper.CompleteUnbonding(later, addr, addr))
+		require.Nil(t, gapp.AssertSupplyInvariant(ctx))
+	}
+
+	// the validator unbonded all its shares, the fractions of tokens left are
+	// kept in the bonded pool
+	_, found := gapp.stakeKeeper.GetValidator(ctx, addr)
+	require.False(t, found)
+	require.True(t, gapp.coinKeeper.GetModuleAccount(ctx, stake.NotBondedPoolName).GetCoins().IsZero())
+	require.False(t, gapp.coinKeeper.GetModuleAccount(ctx, stake.BondedPoolName).GetCoins().IsZero())
+}
+
+func TestExportImportGenesis(t *testing.T) {
+	gapp := NewGaiaApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+
+	// a genesis validator
+	pk := crypto.GenPrivKeyEd25519().PubKey()
+	addr := sdk.AccAddress(pk.Address())
+	appGenTx, _, _, err := GaiaAppGenTxNF(gapp.cdc, pk, addr, "validator")
+	require.Nil(t, err)
+	genesisState, err := GaiaAppGenState(gapp.cdc, []json.RawMessage{appGenTx})
+	require.Nil(t, err)
+	stateBytes, err := wire.MarshalJSONIndent(gapp.cdc, genesisState)
+	require.Nil(t, err)
+	gapp.InitChain(abci.RequestInitChain{AppStateBytes: stateBytes})
+	gapp.Commit()
+
+	// a block signed by the validator, with the state of the modules which
+	// the genesis above doesn't set
+	header := abci.Header{Height: 1, Time: 1000, Proposer: abci.Validator{Address: pk.Address()}}
+	gapp.BeginBlock(abci.RequestBeginBlock{Header: header, Validators: []abci.SigningValidator{{
+		Validator:       abci.Validator{PubKey: tmtypes.TM2PB.PubKey(pk), Power: 100},
+		SignedLastBlock: true,
+	}}})
+	ctx := gapp.NewContext(false, header)
+	proposal := gapp.govKeeper.NewTextProposal(ctx, "title", "description", gov.ProposalTypeText)
+	sdkErr, _ := gapp.govKeeper.AddDeposit(ctx, proposal.GetProposalID(), addr, sdk.Coins{sdk.NewCoin("steak", 5)})
+	require.Nil(t, sdkErr)
+	gapp.ibcMapper.SetIngressSequence(ctx, "chain", 3)
+	gapp.replayKeeper.SetUnordered(ctx, addr, true)
+	gapp.EndBlock(abci.RequestEndBlock{})
+	gapp.Commit()
+
+	appState, _, err := gapp.ExportAppStateAndValidators()
+	require.Nil(t, err)
+	var exported GenesisState
+	require.Nil(t, gapp.cdc.UnmarshalJSON(appState, &exported))
+	require.Nil(t, GaiaValidateGenesisState(exported))
+	require.Len(t, exported.GovData.Proposals, 1)
+	require.Len(t, exported.GovData.Deposits, 1)
+	require.Len(t, exported.SlashingData.SigningInfos, 1)
+	require.Len(t, exported.IBCData.IngressSequences, 1)
+	require.Equal(t, []sdk.AccAddress{addr}, exported.AuthData.UnorderedAccounts)
+
+	// a new chain started from the exported state exports the same state
+	gapp2 := NewGaiaApp(log.NewNopLogger(), dbm.NewMemDB(), nil)
+	gapp2.InitChain(abci.RequestInitChain{AppStateBytes: appState})
+	gapp2.Commit()
+	appState2, _, err := gapp2.ExportAppStateAndValidators()
+	require.Nil(t, err)
+	require.Equal(t, string(appState), string(appState2))
+}
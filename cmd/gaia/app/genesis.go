@@ -3,6 +3,7 @@ package app
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/spf13/pflag"
 	"github.com/tendermint/tendermint/crypto"
@@ -13,6 +14,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 )
 
@@ -24,14 +33,41 @@ var (
 
 // State to Unmarshal
 type GenesisState struct {
-	Accounts  []GenesisAccount   `json:"accounts"`
-	StakeData stake.GenesisState `json:"stake"`
+	Accounts     []GenesisAccount          `json:"accounts"`
+	AuthData     auth.GenesisState         `json:"auth"`
+	BankData     bank.GenesisState         `json:"bank"`
+	StakeData    stake.GenesisState        `json:"stake"`
+	DistrData    distribution.GenesisState `json:"distr"`
+	MintData     mint.GenesisState         `json:"mint"`
+	SlashingData slashing.GenesisState     `json:"slashing"`
+	GovData      gov.GenesisState          `json:"gov"`
+	IBCData      ibc.GenesisState          `json:"ibc"`
+	FeeGrantData feegrant.GenesisState     `json:"feegrant"`
+	AuthzData    authz.GenesisState        `json:"authz"`
 }
 
-// GenesisAccount doesn't need pubkey or sequence
+// GenesisAccount doesn't need pubkey or sequence, which are kept when the
+// state is exported. The accounts keep their account number if it is
+// greater than the ones before, otherwise they take the next one.
 type GenesisAccount struct {
-	Address sdk.AccAddress `json:"address"`
-	Coins   sdk.Coins      `json:"coins"`
+	Address       sdk.AccAddress `json:"address"`
+	Coins         sdk.Coins      `json:"coins"`
+	PubKey        crypto.PubKey  `json:"public_key,omitempty"`
+	AccountNumber int64          `json:"account_number,omitempty"`
+	Sequence      int64          `json:"sequence,omitempty"`
+
+	// vesting account fields, the account is a vesting account if
+	// OriginalVesting is set: delayed if StartTime is zero, continuous otherwise
+	OriginalVesting  sdk.Coins `json:"original_vesting,omitempty"`
+	DelegatedFree    sdk.Coins `json:"delegated_free,omitempty"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting,omitempty"`
+	StartTime        int64     `json:"start_time,omitempty"`
+	EndTime          int64     `json:"end_time,omitempty"`
+
+	// module account fields, the account is a module account if ModuleName
+	// is set
+	ModuleName        string   `json:"module_name,omitempty"`
+	ModulePermissions []string `json:"module_permissions,omitempty"`
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -42,18 +78,135 @@ func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
 }
 
 func NewGenesisAccountI(acc auth.Account) GenesisAccount {
-	return GenesisAccount{
-		Address: acc.GetAddress(),
-		Coins:   acc.GetCoins(),
+	gacc := GenesisAccount{
+		Address:       acc.GetAddress(),
+		Coins:         acc.GetCoins(),
+		PubKey:        acc.GetPubKey(),
+		AccountNumber: acc.GetAccountNumber(),
+		Sequence:      acc.GetSequence(),
+	}
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		gacc.OriginalVesting = vacc.GetOriginalVesting()
+		gacc.DelegatedFree = vacc.GetDelegatedFree()
+		gacc.DelegatedVesting = vacc.GetDelegatedVesting()
+		gacc.StartTime = vacc.GetStartTime()
+		gacc.EndTime = vacc.GetEndTime()
+	}
+	if macc, ok := acc.(*auth.ModuleAccount); ok {
+		gacc.ModuleName = macc.Name
+		gacc.ModulePermissions = macc.Permissions
+	}
+	return gacc
+}
+
+// convert GenesisAccount to auth.BaseAccount, or to a module account if it
+// has a module name, or to a vesting account if it has original vesting coins
+func (ga *GenesisAccount) ToAccount() (acc auth.Account) {
+	baseAcc := &auth.BaseAccount{
+		Address:  ga.Address,
+		Coins:    ga.Coins.Sort(),
+		PubKey:   ga.PubKey,
+		Sequence: ga.Sequence,
+	}
+	if ga.ModuleName != "" {
+		return &auth.ModuleAccount{
+			BaseAccount: baseAcc,
+			Name:        ga.ModuleName,
+			Permissions: ga.ModulePermissions,
+		}
+	}
+	if ga.OriginalVesting.IsZero() {
+		return baseAcc
+	}
+
+	bva := &auth.BaseVestingAccount{
+		BaseAccount:      baseAcc,
+		OriginalVesting:  ga.OriginalVesting.Sort(),
+		DelegatedFree:    ga.DelegatedFree.Sort(),
+		DelegatedVesting: ga.DelegatedVesting.Sort(),
+		EndTime:          ga.EndTime,
+	}
+	if ga.StartTime != 0 {
+		return &auth.ContinuousVestingAccount{
+			BaseVestingAccount: bva,
+			StartTime:          ga.StartTime,
+		}
+	}
+	return &auth.DelayedVestingAccount{BaseVestingAccount: bva}
+}
+
+// validate the module account or the vesting schedule of the genesis account
+func (ga *GenesisAccount) validate() error {
+	if ga.ModuleName != "" {
+		if !ga.OriginalVesting.IsZero() {
+			return fmt.Errorf("module account %s can't be a vesting account", ga.ModuleName)
+		}
+		return ga.ToAccount().(*auth.ModuleAccount).Validate()
+	}
+	if ga.OriginalVesting.IsZero() {
+		return nil
 	}
+	if !ga.OriginalVesting.IsValid() || !ga.OriginalVesting.IsPositive() {
+		return fmt.Errorf("invalid original vesting coins of account %s: %s", ga.Address, ga.OriginalVesting)
+	}
+	if ga.EndTime == 0 || ga.StartTime >= ga.EndTime {
+		return fmt.Errorf("invalid vesting schedule of account %s: start %d, end %d", ga.Address, ga.StartTime, ga.EndTime)
+	}
+	if !ga.Coins.Plus(ga.DelegatedFree).Plus(ga.DelegatedVesting).IsGTE(ga.OriginalVesting) {
+		return fmt.Errorf("original vesting coins of account %s exceed its coins", ga.Address)
+	}
+	return nil
 }
 
-// convert GenesisAccount to auth.BaseAccount
-func (ga *GenesisAccount) ToAccount() (acc *auth.BaseAccount) {
-	return &auth.BaseAccount{
-		Address: ga.Address,
-		Coins:   ga.Coins.Sort(),
+// GaiaValidateGenesisState checks the accounts and the genesis state of
+// every module which can be checked without the state
+func GaiaValidateGenesisState(genesisState GenesisState) error {
+	addrs := make(map[string]bool, len(genesisState.Accounts))
+	var lastAccNumber int64
+	for i, gacc := range genesisState.Accounts {
+		if addrs[string(gacc.Address)] {
+			return fmt.Errorf("duplicate account %s", gacc.Address)
+		}
+		addrs[string(gacc.Address)] = true
+		if gacc.AccountNumber < 0 || (gacc.AccountNumber != 0 && i > 0 && gacc.AccountNumber <= lastAccNumber) {
+			return fmt.Errorf("account number %d of account %s isn't greater than the previous one", gacc.AccountNumber, gacc.Address)
+		}
+		if gacc.AccountNumber > lastAccNumber {
+			lastAccNumber = gacc.AccountNumber
+		}
+		if err := gacc.validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := auth.ValidateGenesis(genesisState.AuthData); err != nil {
+		return err
+	}
+	if err := bank.ValidateGenesis(genesisState.BankData); err != nil {
+		return err
 	}
+	if err := stake.ValidateGenesis(genesisState.StakeData); err != nil {
+		return err
+	}
+	if err := distribution.ValidateGenesis(genesisState.DistrData); err != nil {
+		return err
+	}
+	if err := mint.ValidateGenesis(genesisState.MintData); err != nil {
+		return err
+	}
+	if err := slashing.ValidateGenesis(genesisState.SlashingData); err != nil {
+		return err
+	}
+	if err := gov.ValidateGenesis(genesisState.GovData); err != nil {
+		return err
+	}
+	if err := ibc.ValidateGenesis(genesisState.IBCData); err != nil {
+		return err
+	}
+	if err := feegrant.ValidateGenesis(genesisState.FeeGrantData); err != nil {
+		return err
+	}
+	return authz.ValidateGenesis(genesisState.AuthzData)
 }
 
 // get app init parameters for server init command
@@ -144,6 +297,7 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 
 	// get genesis flag account information
 	genaccs := make([]GenesisAccount, len(appGenTxs))
+	bonded := sdk.ZeroInt()
 	for i, appGenTx := range appGenTxs {
 
 		var genTx GaiaGenTx
@@ -174,6 +328,7 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 			var issuedDelShares sdk.Rat
 			validator, stakeData.Pool, issuedDelShares = validator.AddTokensFromDel(stakeData.Pool, freeFermionVal)
 			stakeData.Validators = append(stakeData.Validators, validator)
+			bonded = bonded.AddRaw(freeFermionVal)
 
 			// create the self-delegation from the issuedDelShares
 			delegation := stake.Delegation{
@@ -187,10 +342,26 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 		}
 	}
 
+	// the bonded pool holds the tokens of the genesis validators
+	if !bonded.IsZero() {
+		pool := auth.NewModuleAccount(stake.BondedPoolName, moduleAccountPermissions[stake.BondedPoolName]...)
+		pool.Coins = sdk.Coins{{stakeData.Params.BondDenom, bonded}}
+		genaccs = append(genaccs, NewGenesisAccountI(pool))
+	}
+
 	// create the final app state
 	genesisState = GenesisState{
-		Accounts:  genaccs,
-		StakeData: stakeData,
+		Accounts:     genaccs,
+		AuthData:     auth.DefaultGenesisState(),
+		BankData:     bank.DefaultGenesisState(),
+		StakeData:    stakeData,
+		DistrData:    distribution.DefaultGenesisState(),
+		MintData:     mint.DefaultGenesisState(),
+		SlashingData: slashing.DefaultGenesisState(),
+		GovData:      gov.DefaultGenesisState(),
+		IBCData:      ibc.DefaultGenesisState(),
+		FeeGrantData: feegrant.DefaultGenesisState(),
+		AuthzData:    authz.DefaultGenesisState(),
 	}
 	return
 }
@@ -5,6 +5,11 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 )
@@ -14,7 +19,63 @@ func TestToAccount(t *testing.T) {
 	addr := sdk.AccAddress(priv.PubKey().Address())
 	authAcc := auth.NewBaseAccountWithAddress(addr)
 	genAcc := NewGenesisAccount(&authAcc)
-	require.Equal(t, authAcc, *genAcc.ToAccount())
+	require.Equal(t, &authAcc, genAcc.ToAccount())
+}
+
+func TestToVestingAccount(t *testing.T) {
+	priv := crypto.GenPrivKeyEd25519()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	authAcc := auth.NewBaseAccountWithAddress(addr)
+	authAcc.Coins = sdk.Coins{sdk.NewCoin("steak", 100)}
+
+	// continuous vesting
+	cva, err := auth.NewContinuousVestingAccount(&authAcc, 100, 200)
+	require.Nil(t, err)
+	genAcc := NewGenesisAccountI(cva)
+	require.Nil(t, genAcc.validate())
+	require.Equal(t, cva, genAcc.ToAccount())
+
+	// delayed vesting
+	dva, err := auth.NewDelayedVestingAccount(&authAcc, 200)
+	require.Nil(t, err)
+	genAcc = NewGenesisAccountI(dva)
+	require.Nil(t, genAcc.validate())
+	require.Equal(t, dva, genAcc.ToAccount())
+
+	// invalid schedule
+	genAcc.StartTime = 300
+	require.NotNil(t, genAcc.validate())
+}
+
+func TestGaiaValidateGenesisState(t *testing.T) {
+	genesisState := GenesisState{
+		StakeData:    stake.DefaultGenesisState(),
+		DistrData:    distribution.DefaultGenesisState(),
+		MintData:     mint.DefaultGenesisState(),
+		SlashingData: slashing.DefaultGenesisState(),
+		GovData:      gov.DefaultGenesisState(),
+	}
+	require.Nil(t, GaiaValidateGenesisState(genesisState))
+
+	acc1 := auth.NewBaseAccountWithAddress(sdk.AccAddress([]byte("addr1")))
+	acc1.AccountNumber = 2
+	acc2 := auth.NewBaseAccountWithAddress(sdk.AccAddress([]byte("addr2")))
+	acc2.AccountNumber = 1
+
+	// the account numbers must increase
+	genesisState.Accounts = []GenesisAccount{NewGenesisAccountI(&acc1), NewGenesisAccountI(&acc2)}
+	require.NotNil(t, GaiaValidateGenesisState(genesisState))
+	genesisState.Accounts = []GenesisAccount{NewGenesisAccountI(&acc2), NewGenesisAccountI(&acc1)}
+	require.Nil(t, GaiaValidateGenesisState(genesisState))
+
+	// the accounts can't be duplicated
+	genesisState.Accounts = append(genesisState.Accounts, NewGenesisAccountI(&acc1))
+	require.NotNil(t, GaiaValidateGenesisState(genesisState))
+
+	// the state of the modules is validated
+	genesisState.Accounts = nil
+	genesisState.GovData.StartingProposalID = 0
+	require.NotNil(t, GaiaValidateGenesisState(genesisState))
 }
 
 func TestGaiaAppGenTx(t *testing.T) {
@@ -0,0 +1,58 @@
+package app
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// SetHistoryIndexer indexes the transfers of the blocks by address with the
+// indexer and serves their history on the "history" query route
+func (app *GaiaApp) SetHistoryIndexer(hi *bank.HistoryIndexer) {
+	app.historyIndexer = hi
+	app.QueryRouter().AddRoute("history", bank.NewHistoryQuerier(hi))
+}
+
+// BeginBlock implements ABCI, indexing the transfers of the BeginBlocker
+func (app *GaiaApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	res := app.BaseApp.BeginBlock(req)
+	if app.historyIndexer != nil {
+		app.logHistoryError(app.historyIndexer.BeginBlock(req.Header.Height, res.Tags))
+	}
+	return res
+}
+
+// DeliverTx implements ABCI, indexing the transfers of the tx
+func (app *GaiaApp) DeliverTx(txBytes []byte) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(txBytes)
+	if app.historyIndexer != nil {
+		app.logHistoryError(app.historyIndexer.DeliverTx(txBytes, res))
+	}
+	return res
+}
+
+// EndBlock implements ABCI, indexing the transfers of the EndBlocker
+func (app *GaiaApp) EndBlock(req abci.RequestEndBlock) abci.ResponseEndBlock {
+	res := app.BaseApp.EndBlock(req)
+	if app.historyIndexer != nil {
+		app.logHistoryError(app.historyIndexer.EndBlock(res.Tags))
+	}
+	return res
+}
+
+// Commit implements ABCI, writing the index of the block once it's committed
+func (app *GaiaApp) Commit() abci.ResponseCommit {
+	res := app.BaseApp.Commit()
+	if app.historyIndexer != nil {
+		app.historyIndexer.Commit()
+	}
+	return res
+}
+
+// the history is a convenience of the node, failing to index it doesn't stop
+// the chain
+func (app *GaiaApp) logHistoryError(err error) {
+	if err != nil {
+		app.Logger.Error("failed to index the transfer history", "err", err)
+	}
+}
@@ -0,0 +1,34 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// supplyHoldings sums the coins held by the accounts, including the module
+// accounts holding the fee pool, the rewards not yet withdrawn, the deposits
+// on the proposals and the tokens bonded and unbonding in stake
+func (app *GaiaApp) supplyHoldings(ctx sdk.Context) sdk.Coins {
+	holdings := sdk.Coins{}
+	app.accountMapper.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		holdings = holdings.Plus(acc.GetCoins())
+		return false
+	})
+	return holdings
+}
+
+// AssertSupplyInvariant checks that the supply recorded by the bank equals
+// the coins held by the accounts
+func (app *GaiaApp) AssertSupplyInvariant(ctx sdk.Context) error {
+	holdings := app.supplyHoldings(ctx)
+	supply := app.coinKeeper.GetTotalSupply(ctx)
+
+	for _, coin := range supply.Minus(holdings) {
+		if !coin.IsZero() {
+			return fmt.Errorf("supply %s doesn't match the coins held %s", supply, holdings)
+		}
+	}
+	return nil
+}
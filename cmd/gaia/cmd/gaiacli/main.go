@@ -12,7 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authzcmd "github.com/cosmos/cosmos-sdk/x/authz/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	distrcmd "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	feegrantcmd "github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	govcmd "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	ibccmd "github.com/cosmos/cosmos-sdk/x/ibc/client/cli"
 	slashingcmd "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
@@ -103,6 +106,27 @@ func main() {
 		stakeCmd,
 	)
 
+	//Add distribution commands
+	distrCmd := &cobra.Command{
+		Use:   "distr",
+		Short: "Reward distribution subcommands",
+	}
+	distrCmd.AddCommand(
+		client.GetCommands(
+			distrcmd.GetCmdQueryDelegationRewards("distribution", cdc),
+			distrcmd.GetCmdQueryValidatorCommission("distribution", cdc),
+			distrcmd.GetCmdQueryCommunityPool("distribution", cdc),
+		)...)
+	distrCmd.AddCommand(
+		client.PostCommands(
+			distrcmd.GetCmdWithdrawDelegatorReward(cdc),
+			distrcmd.GetCmdWithdrawValidatorCommission(cdc),
+			distrcmd.GetCmdFundCommunityPool(cdc),
+		)...)
+	rootCmd.AddCommand(
+		distrCmd,
+	)
+
 	//Add stake commands
 	govCmd := &cobra.Command{
 		Use:   "gov",
@@ -123,15 +147,82 @@ func main() {
 		govCmd,
 	)
 
+	//Add fee grant commands
+	feeGrantCmd := &cobra.Command{
+		Use:   "feegrant",
+		Short: "Fee allowance subcommands",
+	}
+	feeGrantCmd.AddCommand(
+		client.GetCommands(
+			feegrantcmd.GetCmdQueryFeeGrant("feegrant", cdc),
+		)...)
+	feeGrantCmd.AddCommand(
+		client.PostCommands(
+			feegrantcmd.GetCmdGrantFeeAllowance(cdc),
+			feegrantcmd.GetCmdRevokeFeeAllowance(cdc),
+		)...)
+	rootCmd.AddCommand(
+		feeGrantCmd,
+	)
+
+	//Add authorization commands
+	authzCmd := &cobra.Command{
+		Use:   "authz",
+		Short: "Authorization subcommands",
+	}
+	authzCmd.AddCommand(
+		client.GetCommands(
+			authzcmd.GetCmdQueryAuthorization("authz", cdc),
+		)...)
+	authzCmd.AddCommand(
+		client.PostCommands(
+			authzcmd.GetCmdGrantAuthorization(cdc),
+			authzcmd.GetCmdRevokeAuthorization(cdc),
+			authzcmd.GetCmdExec(cdc),
+		)...)
+	rootCmd.AddCommand(
+		authzCmd,
+	)
+
+	//Add issuance commands
+	issuanceCmd := &cobra.Command{
+		Use:   "issuance",
+		Short: "Issued denomination subcommands",
+	}
+	issuanceCmd.AddCommand(
+		client.GetCommands(
+			bankcmd.GetCmdQueryIssuance("bank", cdc),
+		)...)
+	issuanceCmd.AddCommand(
+		client.PostCommands(
+			bankcmd.GetCmdRegisterDenom(cdc),
+			bankcmd.GetCmdIssue(cdc),
+			bankcmd.GetCmdBurn(cdc),
+			bankcmd.GetCmdTransferIssuer(cdc),
+		)...)
+	rootCmd.AddCommand(
+		issuanceCmd,
+	)
+
 	//Add auth and bank commands
 	rootCmd.AddCommand(
 		client.GetCommands(
 			authcmd.GetAccountCmd("acc", cdc, authcmd.GetAccountDecoder(cdc)),
+			bankcmd.GetCmdQueryBalance("bank", "acc", cdc, authcmd.GetAccountDecoder(cdc)),
+			bankcmd.GetCmdQueryDenoms("bank", cdc),
+			bankcmd.GetCmdQuerySupply(cdc),
 		)...)
 	rootCmd.AddCommand(
 		client.PostCommands(
 			bankcmd.SendTxCmd(cdc),
+			authcmd.GetSetUnorderedCmd(cdc),
+			authcmd.GetChangePubKeyCmd(cdc),
 		)...)
+	rootCmd.AddCommand(
+		authcmd.GetSignCommand(cdc, authcmd.GetAccountDecoder(cdc)),
+		authcmd.GetMultiSignCommand(cdc),
+		authcmd.GetBroadcastCommand(cdc),
+	)
 
 	// add proxy, version and key info
 	rootCmd.AddCommand(
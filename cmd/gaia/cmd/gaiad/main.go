@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"io"
+	"path/filepath"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 
@@ -17,6 +18,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/cmd/gaia/app"
 	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/stake"
 )
 
 func main() {
@@ -43,7 +48,29 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewGaiaApp(logger, db, traceStore, baseapp.SetPruning(viper.GetString("pruning")))
+	options := []func(*baseapp.BaseApp){
+		baseapp.SetPruning(viper.GetString("pruning")),
+		baseapp.SetMinimumGasPrices(viper.GetString(server.FlagMinimumGasPrices)),
+	}
+	metrics := viper.GetString(server.FlagMetricsAddress) != ""
+	if metrics {
+		options = append(options, baseapp.SetMetrics(baseapp.PrometheusMetrics(), store.PrometheusMetrics()))
+	}
+
+	gApp := app.NewGaiaApp(logger, db, traceStore, options...)
+	if metrics {
+		gApp.SetModuleMetrics(stake.PrometheusMetrics(), gov.PrometheusMetrics())
+	}
+	if viper.GetBool(server.FlagIndexHistory) {
+		// the history is kept next to the state, in a db of its own
+		dataDir := filepath.Join(viper.GetString(cli.HomeFlag), "data")
+		historyDB, err := dbm.NewGoLevelDB("history", dataDir)
+		if err != nil {
+			panic(err)
+		}
+		gApp.SetHistoryIndexer(bank.NewHistoryIndexer(historyDB))
+	}
+	return gApp
 }
 
 func exportAppStateAndTMValidators(
@@ -2,6 +2,7 @@ package keys
 
 import (
 	"bufio"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/bip39"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	tmcrypto "github.com/tendermint/tendermint/crypto"
 	dbm "github.com/tendermint/tendermint/libs/db"
@@ -195,9 +197,10 @@ func (kb dbKeybase) Get(name string) (Info, error) {
 	return readInfo(bs)
 }
 
-// Sign signs the msg with the named key.
-// It returns an error if the key doesn't exist or the decryption fails.
-func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig tmcrypto.Signature, pub tmcrypto.PubKey, err error) {
+// Sign signs the msg rendered in the sign mode with the named key.
+// It returns an error if the key doesn't exist, the decryption fails or the
+// key can't sign in the sign mode.
+func (kb dbKeybase) Sign(name, passphrase string, msg []byte, mode sdk.SignMode) (sig tmcrypto.Signature, pub tmcrypto.PubKey, err error) {
 	info, err := kb.Get(name)
 	if err != nil {
 		return
@@ -215,6 +218,11 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig tmcrypto.Sign
 			return nil, nil, err
 		}
 	case ledgerInfo:
+		// the ledger app parses the JSON sign documents to display them
+		if mode != sdk.SignModeJSON {
+			err = fmt.Errorf("ledger keys can't sign in %s sign mode", mode)
+			return
+		}
 		linfo := info.(ledgerInfo)
 		priv, err = crypto.NewPrivKeyLedgerSecp256k1(linfo.Path)
 		if err != nil {
@@ -222,7 +230,12 @@ func (kb dbKeybase) Sign(name, passphrase string, msg []byte) (sig tmcrypto.Sign
 		}
 	case offlineInfo:
 		linfo := info.(offlineInfo)
-		fmt.Printf("Bytes to sign:\n%s", msg)
+		// binary sign documents aren't printable
+		if mode == sdk.SignModeBinary {
+			fmt.Printf("Bytes to sign:\n%s", hex.EncodeToString(msg))
+		} else {
+			fmt.Printf("Bytes to sign:\n%s", msg)
+		}
 		buf := bufio.NewReader(os.Stdin)
 		fmt.Printf("\nEnter Amino-encoded signature:\n")
 		// Will block until user inputs the signature
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
@@ -121,19 +122,19 @@ func TestSignVerify(t *testing.T) {
 	d3 := []byte("feels like I forgot something...")
 
 	// try signing both data with both ..
-	s11, pub1, err := cstore.Sign(n1, p1, d1)
+	s11, pub1, err := cstore.Sign(n1, p1, d1, sdk.SignModeJSON)
 	require.Nil(t, err)
 	require.Equal(t, i1.GetPubKey(), pub1)
 
-	s12, pub1, err := cstore.Sign(n1, p1, d2)
+	s12, pub1, err := cstore.Sign(n1, p1, d2, sdk.SignModeJSON)
 	require.Nil(t, err)
 	require.Equal(t, i1.GetPubKey(), pub1)
 
-	s21, pub2, err := cstore.Sign(n2, p2, d1)
+	s21, pub2, err := cstore.Sign(n2, p2, d1, sdk.SignModeJSON)
 	require.Nil(t, err)
 	require.Equal(t, i2.GetPubKey(), pub2)
 
-	s22, pub2, err := cstore.Sign(n2, p2, d2)
+	s22, pub2, err := cstore.Sign(n2, p2, d2, sdk.SignModeJSON)
 	require.Nil(t, err)
 	require.Equal(t, i2.GetPubKey(), pub2)
 
@@ -162,7 +163,7 @@ func TestSignVerify(t *testing.T) {
 	}
 
 	// Now try to sign data with a secret-less key
-	_, _, err = cstore.Sign(n3, p3, d3)
+	_, _, err = cstore.Sign(n3, p3, d3, sdk.SignModeJSON)
 	require.NotNil(t, err)
 }
 
@@ -357,7 +358,7 @@ func ExampleNew() {
 
 	// We need to use passphrase to generate a signature
 	tx := []byte("deadbeef")
-	sig, pub, err := cstore.Sign("Bob", "friend", tx)
+	sig, pub, err := cstore.Sign("Bob", "friend", tx, sdk.SignModeJSON)
 	if err != nil {
 		fmt.Println("don't accept real passphrase")
 	}
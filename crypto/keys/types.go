@@ -2,6 +2,7 @@ package keys
 
 import (
 	ccrypto "github.com/cosmos/cosmos-sdk/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/hd"
@@ -15,8 +16,8 @@ type Keybase interface {
 	Get(name string) (Info, error)
 	Delete(name, passphrase string) error
 
-	// Sign some bytes, looking up the private key to use
-	Sign(name, passphrase string, msg []byte) (crypto.Signature, crypto.PubKey, error)
+	// Sign some bytes rendered in the sign mode, looking up the private key to use
+	Sign(name, passphrase string, msg []byte, mode sdk.SignMode) (crypto.Signature, crypto.PubKey, error)
 
 	// CreateMnemonic creates a new mnemonic, and derives a hierarchical deterministic
 	// key from that.
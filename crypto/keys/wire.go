@@ -2,6 +2,7 @@ package keys
 
 import (
 	ccrypto "github.com/cosmos/cosmos-sdk/crypto"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 	amino "github.com/tendermint/go-amino"
 	tcrypto "github.com/tendermint/tendermint/crypto"
 )
@@ -10,6 +11,7 @@ var cdc = amino.NewCodec()
 
 func init() {
 	tcrypto.RegisterAmino(cdc)
+	multisig.RegisterAmino(cdc)
 	cdc.RegisterInterface((*Info)(nil), nil)
 	cdc.RegisterConcrete(ccrypto.PrivKeyLedgerSecp256k1{},
 		"tendermint/PrivKeyLedgerSecp256k1", nil)
@@ -0,0 +1,90 @@
+package multisig
+
+import (
+	"errors"
+	"fmt"
+)
+
+// CompactBitArray is an array of bits which stores eight bits per byte,
+// ExtraBitsStored is the number of bits used in the last byte, zero if it is
+// fully used.
+type CompactBitArray struct {
+	ExtraBitsStored byte   `json:"extra_bits"`
+	Elems           []byte `json:"bits"`
+}
+
+// NewCompactBitArray returns a new compact bit array with all bits unset.
+// It returns nil if the number of bits is zero or negative.
+func NewCompactBitArray(bits int) *CompactBitArray {
+	if bits <= 0 {
+		return nil
+	}
+	return &CompactBitArray{
+		ExtraBitsStored: byte(bits % 8),
+		Elems:           make([]byte, (bits+7)/8),
+	}
+}
+
+// Validate returns an error if the bit array is malformed, i.e. its bytes
+// don't hold its number of bits. Bit arrays decoded from signatures must be
+// validated before use.
+func (bA *CompactBitArray) Validate() error {
+	if bA == nil {
+		return errors.New("nil bit array")
+	}
+	if bA.ExtraBitsStored >= 8 {
+		return fmt.Errorf("invalid number of extra bits %d", bA.ExtraBitsStored)
+	}
+	if len(bA.Elems) == 0 {
+		return errors.New("empty bit array")
+	}
+	return nil
+}
+
+// Size returns the number of bits in the bit array, zero if it is malformed
+func (bA *CompactBitArray) Size() int {
+	if bA.Validate() != nil {
+		return 0
+	}
+	if bA.ExtraBitsStored == 0 {
+		return len(bA.Elems) * 8
+	}
+	return (len(bA.Elems)-1)*8 + int(bA.ExtraBitsStored)
+}
+
+// GetIndex returns the bit at index i, false if i is out of range
+func (bA *CompactBitArray) GetIndex(i int) bool {
+	if i < 0 || i >= bA.Size() {
+		return false
+	}
+	return bA.Elems[i>>3]&(uint8(1)<<uint8(7-(i%8))) > 0
+}
+
+// SetIndex sets the bit at index i, it returns false if i is out of range
+func (bA *CompactBitArray) SetIndex(i int, v bool) bool {
+	if i < 0 || i >= bA.Size() {
+		return false
+	}
+	if v {
+		bA.Elems[i>>3] |= uint8(1) << uint8(7-(i%8))
+	} else {
+		bA.Elems[i>>3] &^= uint8(1) << uint8(7-(i%8))
+	}
+	return true
+}
+
+// NumTrueBitsBefore returns the number of bits set before index i
+func (bA *CompactBitArray) NumTrueBitsBefore(i int) int {
+	count := 0
+	for j := 0; j < i && j < bA.Size(); j++ {
+		if bA.GetIndex(j) {
+			count++
+		}
+	}
+	return count
+}
+
+// NumTrueBits returns the number of bits set
+func (bA *CompactBitArray) NumTrueBits() int {
+	return bA.NumTrueBitsBefore(bA.Size())
+}
@@ -0,0 +1,68 @@
+package multisig
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var _ crypto.Signature = SignatureMultisig{}
+
+// SignatureMultisig is the signature of a PubKeyMultisigThreshold. The bit
+// array records which of the pubkeys signed, Sigs holds their signatures in
+// the order of the pubkeys.
+type SignatureMultisig struct {
+	BitArray *CompactBitArray   `json:"bit_array"`
+	Sigs     []crypto.Signature `json:"sigs"`
+}
+
+// NewSignatureMultisig returns an empty signature for a multisig of n pubkeys
+func NewSignatureMultisig(n int) SignatureMultisig {
+	return SignatureMultisig{BitArray: NewCompactBitArray(n)}
+}
+
+// AddSignature adds the signature of the pubkey at the given index,
+// replacing any previous signature of that pubkey.
+func (sig *SignatureMultisig) AddSignature(subSig crypto.Signature, index int) {
+	sigIndex := sig.BitArray.NumTrueBitsBefore(index)
+	if sig.BitArray.GetIndex(index) {
+		sig.Sigs[sigIndex] = subSig
+		return
+	}
+	sig.BitArray.SetIndex(index, true)
+	sig.Sigs = append(sig.Sigs, nil)
+	copy(sig.Sigs[sigIndex+1:], sig.Sigs[sigIndex:])
+	sig.Sigs[sigIndex] = subSig
+}
+
+// AddSignatureFromPubKey adds the signature of pubkey, keys are the pubkeys
+// of the multisig.
+func (sig *SignatureMultisig) AddSignatureFromPubKey(subSig crypto.Signature, pubkey crypto.PubKey, keys []crypto.PubKey) error {
+	for i, key := range keys {
+		if key.Equals(pubkey) {
+			sig.AddSignature(subSig, i)
+			return nil
+		}
+	}
+	return fmt.Errorf("provided key %X doesn't belong to the multisig", pubkey.Address())
+}
+
+// Bytes returns the amino encoded signature
+func (sig SignatureMultisig) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(sig)
+}
+
+// IsZero returns true if the signature holds no sub-signatures
+func (sig SignatureMultisig) IsZero() bool {
+	return len(sig.Sigs) == 0
+}
+
+// Equals returns true if other is the same multisig signature
+func (sig SignatureMultisig) Equals(other crypto.Signature) bool {
+	otherSig, ok := other.(SignatureMultisig)
+	if !ok {
+		return false
+	}
+	return bytes.Equal(sig.Bytes(), otherSig.Bytes())
+}
@@ -0,0 +1,118 @@
+package multisig
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+var _ crypto.PubKey = PubKeyMultisigThreshold{}
+
+// PubKeyMultisigThreshold implements a K of N threshold multisig, a signature
+// is valid if at least K of the N pubkeys signed.
+type PubKeyMultisigThreshold struct {
+	K       uint            `json:"threshold"`
+	PubKeys []crypto.PubKey `json:"pubkeys"`
+}
+
+// NewPubKeyMultisigThreshold returns a K of N threshold multisig pubkey.
+// The pubkeys are sorted by address, so the multisig address doesn't depend
+// on the order in which they are provided.
+// Panics if k is not positive or greater than the number of pubkeys.
+func NewPubKeyMultisigThreshold(k int, pubkeys []crypto.PubKey) crypto.PubKey {
+	if k <= 0 {
+		panic("threshold k of n multisignature: k <= 0")
+	}
+	if len(pubkeys) < k {
+		panic("threshold k of n multisignature: len(pubkeys) < k")
+	}
+	sorted := make([]crypto.PubKey, len(pubkeys))
+	copy(sorted, pubkeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address(), sorted[j].Address()) < 0
+	})
+	return PubKeyMultisigThreshold{uint(k), sorted}
+}
+
+// Validate returns an error if the threshold can't be met, i.e. K is zero or
+// greater than the number of pubkeys, or if a nested multisig pubkey is
+// invalid. Pubkeys decoded from txs must be validated before use.
+func (pk PubKeyMultisigThreshold) Validate() error {
+	if pk.K == 0 {
+		return errors.New("threshold k of n multisignature: k == 0")
+	}
+	if int(pk.K) > len(pk.PubKeys) {
+		return fmt.Errorf("threshold k of n multisignature: k %d > n %d", pk.K, len(pk.PubKeys))
+	}
+	for _, subKey := range pk.PubKeys {
+		if nested, ok := subKey.(PubKeyMultisigThreshold); ok {
+			if err := nested.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// VerifyBytes expects a SignatureMultisig, it checks that at least K of the
+// pubkeys signed and that every sub-signature is valid for its pubkey.
+func (pk PubKeyMultisigThreshold) VerifyBytes(msg []byte, marshalledSig crypto.Signature) bool {
+	if pk.Validate() != nil {
+		return false
+	}
+	sig, ok := marshalledSig.(SignatureMultisig)
+	if !ok || sig.BitArray.Validate() != nil {
+		return false
+	}
+	size := sig.BitArray.Size()
+	// ensure bit array is the correct size and enough sub-signatures are present
+	if len(pk.PubKeys) != size || len(sig.Sigs) < int(pk.K) || len(sig.Sigs) > size {
+		return false
+	}
+	// ensure there is exactly one sub-signature per bit set
+	if sig.BitArray.NumTrueBits() != len(sig.Sigs) {
+		return false
+	}
+	sigIndex := 0
+	for i := 0; i < size; i++ {
+		if sig.BitArray.GetIndex(i) {
+			if !pk.PubKeys[i].VerifyBytes(msg, sig.Sigs[sigIndex]) {
+				return false
+			}
+			sigIndex++
+		}
+	}
+	return true
+}
+
+// Bytes returns the amino encoded pubkey
+func (pk PubKeyMultisigThreshold) Bytes() []byte {
+	return cdc.MustMarshalBinaryBare(pk)
+}
+
+// Address returns the tmhash of the amino encoded pubkey
+func (pk PubKeyMultisigThreshold) Address() crypto.Address {
+	return crypto.Address(tmhash.Sum(pk.Bytes()))
+}
+
+// Equals returns true if other is a multisig with the same threshold and
+// pubkeys in the same order
+func (pk PubKeyMultisigThreshold) Equals(other crypto.PubKey) bool {
+	otherKey, ok := other.(PubKeyMultisigThreshold)
+	if !ok {
+		return false
+	}
+	if pk.K != otherKey.K || len(pk.PubKeys) != len(otherKey.PubKeys) {
+		return false
+	}
+	for i := range pk.PubKeys {
+		if !pk.PubKeys[i].Equals(otherKey.PubKeys[i]) {
+			return false
+		}
+	}
+	return true
+}
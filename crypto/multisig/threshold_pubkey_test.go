@@ -0,0 +1,168 @@
+package multisig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func generatePubKeysAndSignatures(n int, msg []byte) (pubkeys []crypto.PubKey, signatures []crypto.Signature) {
+	pubkeys = make([]crypto.PubKey, n)
+	signatures = make([]crypto.Signature, n)
+	for i := 0; i < n; i++ {
+		var privkey crypto.PrivKey
+		if i%2 == 0 {
+			privkey = crypto.GenPrivKeyEd25519()
+		} else {
+			privkey = crypto.GenPrivKeySecp256k1()
+		}
+		pubkeys[i] = privkey.PubKey()
+		signatures[i], _ = privkey.Sign(msg)
+	}
+	return
+}
+
+func TestThresholdMultisigValidCases(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(5, msg)
+	multisigKey := NewPubKeyMultisigThreshold(2, pubkeys).(PubKeyMultisigThreshold)
+	keys := multisigKey.PubKeys
+
+	multisig := NewSignatureMultisig(len(pubkeys))
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// one signature is below the threshold
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[3], pubkeys[3], keys))
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[0], pubkeys[0], keys))
+	require.True(t, multisigKey.VerifyBytes(msg, multisig))
+	require.False(t, multisigKey.VerifyBytes([]byte{1, 2, 3}, multisig))
+
+	// adding the same signature again doesn't change the signature
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[0], pubkeys[0], keys))
+	require.Equal(t, 2, len(multisig.Sigs))
+	require.True(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// more than the threshold is valid
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[4], pubkeys[4], keys))
+	require.True(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// the signature survives an amino round trip
+	var decoded crypto.Signature
+	require.NoError(t, cdc.UnmarshalBinaryBare(multisig.Bytes(), &decoded))
+	require.True(t, multisigKey.VerifyBytes(msg, decoded))
+	require.True(t, multisig.Equals(decoded))
+}
+
+func TestThresholdMultisigInvalidCases(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(3, msg)
+	multisigKey := NewPubKeyMultisigThreshold(2, pubkeys).(PubKeyMultisigThreshold)
+	keys := multisigKey.PubKeys
+
+	// unknown pubkey
+	otherKeys, otherSigs := generatePubKeysAndSignatures(1, msg)
+	multisig := NewSignatureMultisig(len(pubkeys))
+	require.Error(t, multisig.AddSignatureFromPubKey(otherSigs[0], otherKeys[0], keys))
+
+	// a signature under the wrong pubkey
+	multisig.AddSignature(sigs[0], 0)
+	multisig.AddSignature(sigs[0], 1)
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// more signatures than bits set
+	multisig = NewSignatureMultisig(len(pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[0], pubkeys[0], keys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[1], pubkeys[1], keys))
+	multisig.Sigs = append(multisig.Sigs, sigs[2])
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// a bit array of the wrong size
+	multisig = NewSignatureMultisig(len(pubkeys) + 1)
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[0], pubkeys[0], keys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[1], pubkeys[1], keys))
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// malformed bit arrays claiming more bits than their bytes hold
+	multisig = NewSignatureMultisig(len(pubkeys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[0], pubkeys[0], keys))
+	require.NoError(t, multisig.AddSignatureFromPubKey(sigs[1], pubkeys[1], keys))
+	multisig.BitArray = &CompactBitArray{ExtraBitsStored: 11, Elems: multisig.BitArray.Elems}
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+	multisig.BitArray = &CompactBitArray{ExtraBitsStored: 3}
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+	multisig.BitArray = nil
+	require.False(t, multisigKey.VerifyBytes(msg, multisig))
+
+	// not a multisig signature
+	require.False(t, multisigKey.VerifyBytes(msg, sigs[0]))
+
+	// decoded pubkeys with a threshold of zero or greater than the number of
+	// pubkeys verify nothing, not even an empty signature
+	zero := PubKeyMultisigThreshold{0, keys}
+	require.Error(t, zero.Validate())
+	require.False(t, zero.VerifyBytes(msg, NewSignatureMultisig(len(keys))))
+	tooHigh := PubKeyMultisigThreshold{4, keys}
+	require.Error(t, tooHigh.Validate())
+	multisig = NewSignatureMultisig(len(pubkeys))
+	for i := range pubkeys {
+		require.NoError(t, multisig.AddSignatureFromPubKey(sigs[i], pubkeys[i], keys))
+	}
+	require.False(t, tooHigh.VerifyBytes(msg, multisig))
+	nested := PubKeyMultisigThreshold{1, []crypto.PubKey{zero, keys[0]}}
+	require.Error(t, nested.Validate())
+	require.NoError(t, multisigKey.Validate())
+}
+
+func TestMultisigAddress(t *testing.T) {
+	pubkeys, _ := generatePubKeysAndSignatures(3, []byte{})
+	multisigKey := NewPubKeyMultisigThreshold(2, pubkeys)
+
+	// the address doesn't depend on the order of the pubkeys
+	reversed := []crypto.PubKey{pubkeys[2], pubkeys[1], pubkeys[0]}
+	require.Equal(t, multisigKey.Address(), NewPubKeyMultisigThreshold(2, reversed).Address())
+	require.True(t, multisigKey.Equals(NewPubKeyMultisigThreshold(2, reversed)))
+
+	// but it depends on the threshold
+	require.NotEqual(t, multisigKey.Address(), NewPubKeyMultisigThreshold(3, pubkeys).Address())
+
+	// the pubkey survives an amino round trip
+	var decoded crypto.PubKey
+	require.NoError(t, cdc.UnmarshalBinaryBare(multisigKey.Bytes(), &decoded))
+	require.True(t, multisigKey.Equals(decoded))
+}
+
+func TestCompactBitArray(t *testing.T) {
+	require.Nil(t, NewCompactBitArray(0))
+	require.Equal(t, 0, (*CompactBitArray)(nil).Size())
+	require.Error(t, (*CompactBitArray)(nil).Validate())
+
+	// the bytes must hold the bits
+	require.Error(t, (&CompactBitArray{ExtraBitsStored: 10, Elems: []byte{0xff}}).Validate())
+	require.Error(t, (&CompactBitArray{ExtraBitsStored: 1}).Validate())
+	require.Equal(t, 0, (&CompactBitArray{ExtraBitsStored: 10, Elems: []byte{0xff}}).Size())
+	require.False(t, (&CompactBitArray{ExtraBitsStored: 10, Elems: []byte{0xff}}).GetIndex(8))
+
+	for _, size := range []int{2, 7, 8, 9, 17} {
+		bA := NewCompactBitArray(size)
+		require.NoError(t, bA.Validate())
+		require.Equal(t, size, bA.Size())
+		require.False(t, bA.SetIndex(size, true))
+		require.False(t, bA.GetIndex(size))
+
+		require.True(t, bA.SetIndex(0, true))
+		require.True(t, bA.SetIndex(size-1, true))
+		require.True(t, bA.GetIndex(0))
+		require.True(t, bA.GetIndex(size-1))
+		require.False(t, bA.GetIndex(1))
+		require.Equal(t, 0, bA.NumTrueBitsBefore(0))
+		require.Equal(t, 1, bA.NumTrueBitsBefore(size-1))
+		require.Equal(t, 2, bA.NumTrueBits())
+
+		require.True(t, bA.SetIndex(0, false))
+		require.False(t, bA.GetIndex(0))
+		require.Equal(t, 1, bA.NumTrueBits())
+	}
+}
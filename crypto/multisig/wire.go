@@ -0,0 +1,22 @@
+package multisig
+
+import (
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+var cdc = amino.NewCodec()
+
+func init() {
+	crypto.RegisterAmino(cdc)
+	RegisterAmino(cdc)
+}
+
+// RegisterAmino registers the multisig pubkey and signature types in the
+// given codec, the crypto interfaces must be registered separately.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeyMultisigThreshold{},
+		"cosmos-sdk/PubKeyMultisigThreshold", nil)
+	cdc.RegisterConcrete(SignatureMultisig{},
+		"cosmos-sdk/SignatureMultisig", nil)
+}
@@ -0,0 +1,27 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// FlagMetricsAddress is the address to serve the Prometheus metrics on,
+// metrics are disabled when empty.
+const FlagMetricsAddress = "metrics-address"
+
+// startMetricsServer serves the metrics of the default Prometheus registry,
+// which holds both the SDK and the Tendermint metrics.
+func startMetricsServer(ctx *Context, addr string) *http.Server {
+	srv := &http.Server{
+		Addr:    addr,
+		Handler: promhttp.Handler(),
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			ctx.Logger.Error("Metrics HTTP server ListenAndServe", "err", err)
+		}
+	}()
+	ctx.Logger.Info("Serving metrics", "address", addr)
+	return srv
+}
@@ -19,6 +19,14 @@ const (
 	flagAddress        = "address"
 	flagTraceStore     = "trace-store"
 	flagPruning        = "pruning"
+
+	// FlagMinimumGasPrices is the node's minimum gas prices, only checked
+	// when txs enter the mempool
+	FlagMinimumGasPrices = "minimum-gas-prices"
+
+	// FlagIndexHistory enables the index of the transfer history of the
+	// addresses on the node, for the apps supporting it
+	FlagIndexHistory = "index-history"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -45,6 +53,9 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(FlagMinimumGasPrices, "", "Minimum gas prices for a tx to be accepted into the mempool, e.g. 0.025steak,0.1photino")
+	cmd.Flags().String(FlagMetricsAddress, "", "Serve Prometheus metrics on this address, e.g. localhost:26661 (disabled if empty)")
+	cmd.Flags().Bool(FlagIndexHistory, false, "Index the transfer history of the addresses to serve the history queries")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -61,6 +72,10 @@ func startStandAlone(ctx *Context, appCreator AppCreator) error {
 		return err
 	}
 
+	if metricsAddr := viper.GetString(FlagMetricsAddress); metricsAddr != "" {
+		startMetricsServer(ctx, metricsAddr)
+	}
+
 	svr, err := server.NewServer(addr, "socket", app)
 	if err != nil {
 		return errors.Errorf("error creating listener: %v\n", err)
@@ -94,6 +109,10 @@ func startInProcess(ctx *Context, appCreator AppCreator) (*node.Node, error) {
 		return nil, err
 	}
 
+	if metricsAddr := viper.GetString(FlagMetricsAddress); metricsAddr != "" {
+		startMetricsServer(ctx, metricsAddr)
+	}
+
 	// create & start tendermint node
 	tmNode, err := node.NewNode(
 		cfg,
@@ -2,6 +2,7 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"net"
 	"os"
 	"path/filepath"
@@ -20,6 +21,13 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 )
 
+// log output formats
+const (
+	flagLogFormat  = "log_format"
+	logFormatPlain = "plain"
+	logFormatJSON  = "json"
+)
+
 // server context
 type Context struct {
 	Config *cfg.Config
@@ -51,7 +59,10 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 		if err != nil {
 			return err
 		}
-		logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+		logger, err := newLogger(viper.GetString(flagLogFormat))
+		if err != nil {
+			return err
+		}
 		logger, err = tmflags.ParseLogLevel(config.LogLevel, logger, cfg.DefaultLogLevel())
 		if err != nil {
 			return err
@@ -66,6 +77,18 @@ func PersistentPreRunEFn(context *Context) func(*cobra.Command, []string) error
 	}
 }
 
+// newLogger returns a stdout logger writing in the given format
+func newLogger(format string) (log.Logger, error) {
+	switch format {
+	case "", logFormatPlain:
+		return log.NewTMLogger(log.NewSyncWriter(os.Stdout)), nil
+	case logFormatJSON:
+		return log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout)), nil
+	default:
+		return nil, fmt.Errorf("unknown log format %q, must be %q or %q", format, logFormatPlain, logFormatJSON)
+	}
+}
+
 // If a new config is created, change some of the default tendermint settings
 func interceptLoadConfig() (conf *cfg.Config, err error) {
 	tmpConf := cfg.DefaultConfig()
@@ -101,7 +124,10 @@ func AddCommands(
 	rootCmd *cobra.Command, appInit AppInit,
 	appCreator AppCreator, appExport AppExporter) {
 
-	rootCmd.PersistentFlags().String("log_level", ctx.Config.LogLevel, "Log level")
+	rootCmd.PersistentFlags().String("log_level", ctx.Config.LogLevel,
+		"Log level, may be set per module, e.g. \"x/stake:debug,baseapp:info,*:error\"")
+	rootCmd.PersistentFlags().String(flagLogFormat, logFormatPlain,
+		fmt.Sprintf("Log output format (%s|%s)", logFormatPlain, logFormatJSON))
 
 	tendermintCmd := &cobra.Command{
 		Use:   "tendermint",
@@ -38,3 +38,14 @@ func TestInsertKeyJSON(t *testing.T) {
 
 	require.Equal(t, bar, resBar, "appended: %v", appended)
 }
+
+func TestNewLogger(t *testing.T) {
+	for _, format := range []string{"", logFormatPlain, logFormatJSON} {
+		logger, err := newLogger(format)
+		require.NoError(t, err, "format %q", format)
+		require.NotNil(t, logger)
+	}
+
+	_, err := newLogger("xml")
+	require.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package store
+
+import (
+	"bytes"
+	"io"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DiffMultiStore cache-wraps a MultiStore and records the keys written to
+// its substores, so that the writes can be summarized as StoreDiffs.
+// Stores cache-wrapped from it share its record.
+type DiffMultiStore struct {
+	parent CacheMultiStore
+	writes *writeLog
+}
+
+var _ CacheMultiStore = DiffMultiStore{}
+
+// writeLog holds the keys written in order of first write, with their value
+// before the first write
+type writeLog struct {
+	writes []write
+	seen   map[string]bool
+}
+
+type write struct {
+	key    StoreKey
+	k      []byte
+	before []byte
+}
+
+// NewDiffMultiStore returns a DiffMultiStore cache-wrapping the MultiStore
+func NewDiffMultiStore(ms MultiStore) DiffMultiStore {
+	return DiffMultiStore{
+		parent: ms.CacheMultiStore(),
+		writes: &writeLog{seen: make(map[string]bool)},
+	}
+}
+
+// Diffs returns the keys written to the store whose value changed, with
+// their value before the first write and their current value
+func (dms DiffMultiStore) Diffs() []sdk.StoreDiff {
+	var diffs []sdk.StoreDiff
+	for _, w := range dms.writes.writes {
+		after := dms.parent.GetKVStore(w.key).Get(w.k)
+		if bytes.Equal(w.before, after) {
+			continue
+		}
+		diffs = append(diffs, sdk.StoreDiff{
+			Store:  w.key.Name(),
+			Key:    w.k,
+			Before: w.before,
+			After:  after,
+		})
+	}
+	return diffs
+}
+
+// Implements Store.
+func (dms DiffMultiStore) GetStoreType() StoreType {
+	return sdk.StoreTypeMulti
+}
+
+// Implements CacheMultiStore.
+func (dms DiffMultiStore) Write() {
+	dms.parent.Write()
+}
+
+// Implements CacheWrapper.
+func (dms DiffMultiStore) CacheWrap() CacheWrap {
+	return dms.CacheMultiStore().(CacheWrap)
+}
+
+// CacheWrapWithTrace implements the CacheWrapper interface.
+func (dms DiffMultiStore) CacheWrapWithTrace(_ io.Writer, _ TraceContext) CacheWrap {
+	return dms.CacheWrap()
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) CacheMultiStore() CacheMultiStore {
+	return DiffMultiStore{dms.parent.CacheMultiStore(), dms.writes}
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) GetStore(key StoreKey) Store {
+	return dms.GetKVStore(key)
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) GetKVStore(key StoreKey) KVStore {
+	return diffKVStore{dms.parent.GetKVStore(key), key, dms.writes}
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) GetKVStoreWithGas(meter sdk.GasMeter, key StoreKey) KVStore {
+	return NewGasKVStore(meter, dms.GetKVStore(key))
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) TracingEnabled() bool {
+	return dms.parent.TracingEnabled()
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) WithTracer(w io.Writer) MultiStore {
+	return DiffMultiStore{dms.parent.WithTracer(w).(CacheMultiStore), dms.writes}
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) WithTracingContext(tc TraceContext) MultiStore {
+	return DiffMultiStore{dms.parent.WithTracingContext(tc).(CacheMultiStore), dms.writes}
+}
+
+// Implements MultiStore.
+func (dms DiffMultiStore) ResetTraceContext() MultiStore {
+	return DiffMultiStore{dms.parent.ResetTraceContext().(CacheMultiStore), dms.writes}
+}
+
+// diffKVStore records the keys written to its parent in the writeLog
+type diffKVStore struct {
+	KVStore
+	key    StoreKey
+	writes *writeLog
+}
+
+// record the value of the key before its first write
+func (dkv diffKVStore) record(k []byte) {
+	id := dkv.key.Name() + "/" + string(k)
+	if dkv.writes.seen[id] {
+		return
+	}
+	dkv.writes.seen[id] = true
+	dkv.writes.writes = append(dkv.writes.writes, write{
+		key:    dkv.key,
+		k:      append([]byte{}, k...),
+		before: dkv.KVStore.Get(k),
+	})
+}
+
+// Implements KVStore
+func (dkv diffKVStore) Set(key, value []byte) {
+	dkv.record(key)
+	dkv.KVStore.Set(key, value)
+}
+
+// Implements KVStore
+func (dkv diffKVStore) Delete(key []byte) {
+	dkv.record(key)
+	dkv.KVStore.Delete(key)
+}
+
+// Implements KVStore
+func (dkv diffKVStore) Prefix(prefix []byte) KVStore {
+	return prefixStore{dkv, prefix}
+}
+
+// Implements CacheWrap
+func (dkv diffKVStore) CacheWrap() CacheWrap {
+	return NewCacheKVStore(dkv)
+}
+
+// CacheWrapWithTrace implements the KVStore interface.
+func (dkv diffKVStore) CacheWrapWithTrace(w io.Writer, tc TraceContext) CacheWrap {
+	return NewCacheKVStore(NewTraceKVStore(dkv, w, tc))
+}
@@ -0,0 +1,48 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDiffMultiStore(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+	key1, key2 := ms.keysByName["store1"], ms.keysByName["store2"]
+
+	ms.GetKVStore(key1).Set([]byte("a"), []byte("1"))
+	ms.GetKVStore(key1).Set([]byte("b"), []byte("2"))
+
+	dms := NewDiffMultiStore(ms)
+	store1 := dms.GetKVStore(key1)
+	store1.Set([]byte("a"), []byte("3"))
+	store1.Set([]byte("a"), []byte("4"))
+	store1.Delete([]byte("b"))
+	dms.GetKVStore(key2).Prefix([]byte("p/")).Set([]byte("c"), []byte("5"))
+
+	// the writes of a discarded cache are recorded but leave no diff
+	cache := dms.CacheMultiStore()
+	cache.GetKVStore(key2).Set([]byte("d"), []byte("6"))
+
+	// the writes of a written cache leave a diff
+	cache = dms.CacheMultiStore()
+	cache.GetKVStoreWithGas(sdk.NewInfiniteGasMeter(), key1).Set([]byte("e"), []byte("7"))
+	cache.Write()
+
+	require.Equal(t, []sdk.StoreDiff{
+		{Store: "store1", Key: []byte("a"), Before: []byte("1"), After: []byte("4")},
+		{Store: "store1", Key: []byte("b"), Before: []byte("2"), After: nil},
+		{Store: "store2", Key: []byte("p/c"), Before: nil, After: []byte("5")},
+		{Store: "store1", Key: []byte("e"), Before: nil, After: []byte("7")},
+	}, dms.Diffs())
+
+	// the parent is unchanged
+	require.Equal(t, []byte("1"), ms.GetKVStore(key1).Get([]byte("a")))
+	require.Nil(t, ms.GetKVStore(key2).Get([]byte("p/c")))
+}
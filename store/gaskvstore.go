@@ -42,16 +42,14 @@ func (gi *gasKVStore) GetStoreType() sdk.StoreType {
 func (gi *gasKVStore) Get(key []byte) (value []byte) {
 	gi.gasMeter.ConsumeGas(ReadCostFlat, "GetFlat")
 	value = gi.parent.Get(key)
-	// TODO overflow-safe math?
-	gi.gasMeter.ConsumeGas(ReadCostPerByte*sdk.Gas(len(value)), "ReadPerByte")
+	gi.gasMeter.ConsumeGas(sdk.MulGas(ReadCostPerByte, sdk.Gas(len(value)), "ReadPerByte"), "ReadPerByte")
 	return value
 }
 
 // Implements KVStore.
 func (gi *gasKVStore) Set(key []byte, value []byte) {
 	gi.gasMeter.ConsumeGas(WriteCostFlat, "SetFlat")
-	// TODO overflow-safe math?
-	gi.gasMeter.ConsumeGas(WriteCostPerByte*sdk.Gas(len(value)), "SetPerByte")
+	gi.gasMeter.ConsumeGas(sdk.MulGas(WriteCostPerByte, sdk.Gas(len(value)), "SetPerByte"), "SetPerByte")
 	gi.parent.Set(key, value)
 }
 
@@ -140,7 +138,7 @@ func (g *gasIterator) Key() (key []byte) {
 func (g *gasIterator) Value() (value []byte) {
 	value = g.parent.Value()
 	g.gasMeter.ConsumeGas(ValueCostFlat, "ValueFlat")
-	g.gasMeter.ConsumeGas(ValueCostPerByte*sdk.Gas(len(value)), "ValuePerByte")
+	g.gasMeter.ConsumeGas(sdk.MulGas(ValueCostPerByte, sdk.Gas(len(value)), "ValuePerByte"), "ValuePerByte")
 	return value
 }
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/iavl"
@@ -53,6 +54,9 @@ type iavlStore struct {
 	// By default this value should be set the same across all nodes,
 	// so that nodes can know the waypoints their peers store.
 	storeEvery int64
+
+	// Metrics labeled with the name of the store.
+	metrics *Metrics
 }
 
 // CONTRACT: tree should be fully loaded.
@@ -61,12 +65,22 @@ func newIAVLStore(tree *iavl.VersionedTree, numRecent int64, storeEvery int64) *
 		tree:       tree,
 		numRecent:  numRecent,
 		storeEvery: storeEvery,
+		metrics:    NopMetrics(),
 	}
 	return st
 }
 
+// SetMetrics sets the metrics recorded by the store.
+func (st *iavlStore) SetMetrics(metrics *Metrics) {
+	st.metrics = metrics
+}
+
 // Implements Committer.
 func (st *iavlStore) Commit() CommitID {
+	start := time.Now()
+	defer func() {
+		st.metrics.CommitDuration.Observe(time.Since(start).Seconds())
+	}()
 
 	// Save a new version.
 	hash, version, err := st.tree.SaveVersion()
@@ -137,11 +151,13 @@ func (st *iavlStore) CacheWrapWithTrace(w io.Writer, tc TraceContext) CacheWrap
 
 // Implements KVStore.
 func (st *iavlStore) Set(key, value []byte) {
+	st.metrics.Sets.Add(1)
 	st.tree.Set(key, value)
 }
 
 // Implements KVStore.
 func (st *iavlStore) Get(key []byte) (value []byte) {
+	st.metrics.Gets.Add(1)
 	_, v := st.tree.Get(key)
 	return v
 }
@@ -163,11 +179,13 @@ func (st *iavlStore) Prefix(prefix []byte) KVStore {
 
 // Implements KVStore.
 func (st *iavlStore) Iterator(start, end []byte) Iterator {
+	st.metrics.Iterations.Add(1)
 	return newIAVLIterator(st.tree.Tree(), start, end, true)
 }
 
 // Implements KVStore.
 func (st *iavlStore) ReverseIterator(start, end []byte) Iterator {
+	st.metrics.Iterations.Add(1)
 	return newIAVLIterator(st.tree.Tree(), start, end, false)
 }
 
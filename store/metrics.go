@@ -0,0 +1,71 @@
+package store
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsSubsystem = "store"
+
+// Metrics contains metrics exposed by this package.
+// All metrics are labeled by the name of the store.
+type Metrics struct {
+	// Number of IAVL gets.
+	Gets metrics.Counter
+	// Number of IAVL sets.
+	Sets metrics.Counter
+	// Number of IAVL iterators created.
+	Iterations metrics.Counter
+	// Time to commit an IAVL store in seconds.
+	CommitDuration metrics.Histogram
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics() *Metrics {
+	return &Metrics{
+		Gets: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "iavl_gets",
+			Help:      "Number of IAVL gets.",
+		}, []string{"store"}),
+		Sets: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "iavl_sets",
+			Help:      "Number of IAVL sets.",
+		}, []string{"store"}),
+		Iterations: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "iavl_iterations",
+			Help:      "Number of IAVL iterators created.",
+		}, []string{"store"}),
+		CommitDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Subsystem: metricsSubsystem,
+			Name:      "iavl_commit_duration_seconds",
+			Help:      "Time to commit an IAVL store in seconds.",
+			Buckets:   stdprometheus.ExponentialBuckets(0.0001, 2, 16),
+		}, []string{"store"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Gets:           discard.NewCounter(),
+		Sets:           discard.NewCounter(),
+		Iterations:     discard.NewCounter(),
+		CommitDuration: discard.NewHistogram(),
+	}
+}
+
+// withStore returns the metrics labeled with the given store name
+func (m *Metrics) withStore(name string) *Metrics {
+	return &Metrics{
+		Gets:           m.Gets.With("store", name),
+		Sets:           m.Sets.With("store", name),
+		Iterations:     m.Iterations.With("store", name),
+		CommitDuration: m.CommitDuration.With("store", name),
+	}
+}
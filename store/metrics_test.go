@@ -0,0 +1,49 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// counter records the total added per store label
+type counter struct {
+	store  string
+	totals map[string]float64
+}
+
+func (c counter) With(labelValues ...string) metrics.Counter {
+	return counter{labelValues[1], c.totals}
+}
+
+func (c counter) Add(delta float64) {
+	c.totals[c.store] += delta
+}
+
+func TestIAVLMetrics(t *testing.T) {
+	gets, sets, iterations := map[string]float64{}, map[string]float64{}, map[string]float64{}
+	m := &Metrics{
+		Gets:           counter{totals: gets},
+		Sets:           counter{totals: sets},
+		Iterations:     counter{totals: iterations},
+		CommitDuration: discard.NewHistogram(),
+	}
+
+	ms := newMultiStoreWithMounts(dbm.NewMemDB())
+	ms.SetMetrics(m)
+	require.Nil(t, ms.LoadLatestVersion())
+
+	store1 := ms.getStoreByName("store1").(KVStore)
+	store1.Set([]byte("key"), []byte("value"))
+	store1.Get([]byte("key"))
+	store1.Get([]byte("key"))
+	store1.Iterator(nil, nil).Close()
+	ms.getStoreByName("store2").(KVStore).Set([]byte("key"), []byte("value"))
+
+	require.Equal(t, map[string]float64{"store1": 1, "store2": 1}, sets)
+	require.Equal(t, map[string]float64{"store1": 2}, gets)
+	require.Equal(t, map[string]float64{"store1": 1}, iterations)
+}
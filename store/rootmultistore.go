@@ -29,6 +29,7 @@ type rootMultiStore struct {
 	storesParams map[StoreKey]storeParams
 	stores       map[StoreKey]CommitStore
 	keysByName   map[string]StoreKey
+	metrics      *Metrics
 
 	traceWriter  io.Writer
 	traceContext TraceContext
@@ -44,6 +45,18 @@ func NewCommitMultiStore(db dbm.DB) *rootMultiStore {
 		storesParams: make(map[StoreKey]storeParams),
 		stores:       make(map[StoreKey]CommitStore),
 		keysByName:   make(map[string]StoreKey),
+		metrics:      NopMetrics(),
+	}
+}
+
+// SetMetrics sets the metrics recorded by the IAVL substores, they are
+// labeled with the name of each store.
+func (rs *rootMultiStore) SetMetrics(metrics *Metrics) {
+	rs.metrics = metrics
+	for key, substore := range rs.stores {
+		if st, ok := substore.(*iavlStore); ok {
+			st.SetMetrics(metrics.withStore(key.Name()))
+		}
 	}
 }
 
@@ -323,6 +336,9 @@ func (rs *rootMultiStore) loadCommitStoreFromParams(id CommitID, params storePar
 		// return NewCommitMultiStore(db, id)
 	case sdk.StoreTypeIAVL:
 		store, err = LoadIAVLStore(db, id, rs.pruning)
+		if err == nil {
+			store.(*iavlStore).SetMetrics(rs.metrics.withStore(params.key.Name()))
+		}
 		return
 	case sdk.StoreTypeDB:
 		panic("dbm.DB is not a CommitStore")
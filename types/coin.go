@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
@@ -76,6 +77,10 @@ func (coin Coin) Minus(coinB Coin) Coin {
 //----------------------------------------
 // Coins
 
+// ErrInsufficientCoinsAmount is returned by Coins.SafeSub when the result
+// would contain a negative amount
+var ErrInsufficientCoinsAmount = errors.New("insufficient coins amount")
+
 // Coins is a set of Coin, one per currency
 type Coins []Coin
 
@@ -116,18 +121,30 @@ func (coins Coins) IsValid() bool {
 
 // Plus combines two sets of coins
 // CONTRACT: Plus will never return Coins where one Coin has a 0 amount.
+// Plus panics on overflow, see SafeAdd.
 func (coins Coins) Plus(coinsB Coins) Coins {
+	sum, err := coins.SafeAdd(coinsB)
+	if err != nil {
+		panic(err.Error())
+	}
+	return sum
+}
+
+// SafeAdd combines two sets of coins, returning ErrIntOverflow if the sum
+// of any denomination overflows.
+// CONTRACT: SafeAdd will never return Coins where one Coin has a 0 amount.
+func (coins Coins) SafeAdd(coinsB Coins) (Coins, error) {
 	sum := ([]Coin)(nil)
 	indexA, indexB := 0, 0
 	lenA, lenB := len(coins), len(coinsB)
 	for {
 		if indexA == lenA {
 			if indexB == lenB {
-				return sum
+				return sum, nil
 			}
-			return append(sum, coinsB[indexB:]...)
+			return append(sum, coinsB[indexB:]...), nil
 		} else if indexB == lenB {
-			return append(sum, coins[indexA:]...)
+			return append(sum, coins[indexA:]...), nil
 		}
 		coinA, coinB := coins[indexA], coinsB[indexB]
 		switch strings.Compare(coinA.Denom, coinB.Denom) {
@@ -135,11 +152,14 @@ func (coins Coins) Plus(coinsB Coins) Coins {
 			sum = append(sum, coinA)
 			indexA++
 		case 0:
-			if coinA.Amount.Add(coinB.Amount).IsZero() {
-				// ignore 0 sum coin type
-			} else {
-				sum = append(sum, coinA.Plus(coinB))
+			amount, err := coinA.Amount.SafeAdd(coinB.Amount)
+			if err != nil {
+				return nil, err
+			}
+			if !amount.IsZero() {
+				sum = append(sum, Coin{coinA.Denom, amount})
 			}
+			// ignore 0 sum coin type
 			indexA++
 			indexB++
 		case 1:
@@ -166,6 +186,35 @@ func (coins Coins) Minus(coinsB Coins) Coins {
 	return coins.Plus(coinsB.Negative())
 }
 
+// SafeSub subtracts a set of coins from another, returning ErrIntOverflow on
+// overflow and ErrInsufficientCoinsAmount if any resulting amount is negative.
+func (coins Coins) SafeSub(coinsB Coins) (Coins, error) {
+	diff, err := coins.SafeAdd(coinsB.Negative())
+	if err != nil {
+		return nil, err
+	}
+	if !diff.IsNotNegative() {
+		return nil, ErrInsufficientCoinsAmount
+	}
+	return diff, nil
+}
+
+// SafeMul multiplies every amount by a factor, returning ErrIntOverflow on
+// overflow. Coins multiplied to 0 are removed.
+func (coins Coins) SafeMul(factor Int) (Coins, error) {
+	res := ([]Coin)(nil)
+	for _, coin := range coins {
+		amount, err := coin.Amount.SafeMul(factor)
+		if err != nil {
+			return nil, err
+		}
+		if !amount.IsZero() {
+			res = append(res, Coin{coin.Denom, amount})
+		}
+	}
+	return res, nil
+}
+
 // IsGTE returns True iff coins is NonNegative(), and for every
 // currency in coinsB, the currency is present at an equal or greater
 // amount in coinsB
@@ -273,16 +322,25 @@ func (coins Coins) Sort() Coins {
 // Parsing
 
 var (
-	// Denominations can be 3 ~ 16 characters long.
-	reDnm  = `[[:alpha:]][[:alnum:]]{2,15}`
-	reAmt  = `[[:digit:]]+`
+	// Denominations are checked against a denomination format once parsed,
+	// they can't start with a digit nor contain spaces or commas.
+	reDnm  = `[^[:space:][:digit:].,][^[:space:],]*`
+	reAmt  = `[[:digit:]]+(?:\.[[:digit:]]+)?`
 	reSpc  = `[[:space:]]*`
 	reCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reAmt, reSpc, reDnm))
 )
 
 // ParseCoin parses a cli input for one coin type, returning errors if invalid.
 // This returns an error on an empty string as well.
-func ParseCoin(coinStr string) (coin Coin, err error) {
+// Amounts in a display denomination of the provided metadata, e.g. "1.5atom",
+// are converted to base units. Other amounts must be integers.
+func ParseCoin(coinStr string, metadata ...DenomMetadata) (coin Coin, err error) {
+	return ParseCoinWithFormat(coinStr, DefaultDenomRegex, metadata...)
+}
+
+// ParseCoinWithFormat parses a coin like ParseCoin, the denomination must
+// match the given denomination format.
+func ParseCoinWithFormat(coinStr string, format string, metadata ...DenomMetadata) (coin Coin, err error) {
 	coinStr = strings.TrimSpace(coinStr)
 
 	matches := reCoin.FindStringSubmatch(coinStr)
@@ -291,6 +349,20 @@ func ParseCoin(coinStr string) (coin Coin, err error) {
 		return
 	}
 	denomStr, amountStr := matches[2], matches[1]
+	err = ValidateDenom(denomStr, format)
+	if err != nil {
+		return
+	}
+
+	for _, meta := range metadata {
+		if meta.Display == denomStr {
+			amount, err := meta.ToBase(amountStr)
+			if err != nil {
+				return Coin{}, err
+			}
+			return Coin{meta.Base, amount}, nil
+		}
+	}
 
 	amount, err := strconv.Atoi(amountStr)
 	if err != nil {
@@ -302,8 +374,14 @@ func ParseCoin(coinStr string) (coin Coin, err error) {
 
 // ParseCoins will parse out a list of coins separated by commas.
 // If nothing is provided, it returns nil Coins.
-// Returned coins are sorted.
-func ParseCoins(coinsStr string) (coins Coins, err error) {
+// Returned coins are sorted and expressed in base units, see ParseCoin.
+func ParseCoins(coinsStr string, metadata ...DenomMetadata) (coins Coins, err error) {
+	return ParseCoinsWithFormat(coinsStr, DefaultDenomRegex, metadata...)
+}
+
+// ParseCoinsWithFormat parses coins like ParseCoins, the denominations must
+// match the given denomination format.
+func ParseCoinsWithFormat(coinsStr string, format string, metadata ...DenomMetadata) (coins Coins, err error) {
 	coinsStr = strings.TrimSpace(coinsStr)
 	if len(coinsStr) == 0 {
 		return nil, nil
@@ -311,7 +389,7 @@ func ParseCoins(coinsStr string) (coins Coins, err error) {
 
 	coinStrs := strings.Split(coinsStr, ",")
 	for _, coinStr := range coinStrs {
-		coin, err := ParseCoin(coinStr)
+		coin, err := ParseCoinWithFormat(coinStr, format, metadata...)
 		if err != nil {
 			return nil, err
 		}
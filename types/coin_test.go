@@ -1,6 +1,7 @@
 package types
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -219,6 +220,36 @@ func TestPlusCoins(t *testing.T) {
 	}
 }
 
+func TestSafeCoinsArithmetic(t *testing.T) {
+	intmax := NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1)))
+	maxCoins := Coins{{"atom", intmax}}
+	one := Coins{{"atom", OneInt()}, {"steak", OneInt()}}
+
+	sum, err := one.SafeAdd(one)
+	require.Nil(t, err)
+	require.Equal(t, Coins{{"atom", NewInt(2)}, {"steak", NewInt(2)}}, sum)
+	_, err = maxCoins.SafeAdd(one)
+	require.Equal(t, ErrIntOverflow, err)
+	require.Panics(t, func() { maxCoins.Plus(one) })
+
+	diff, err := sum.SafeSub(one)
+	require.Nil(t, err)
+	require.Equal(t, one, diff)
+	_, err = one.SafeSub(sum)
+	require.Equal(t, ErrInsufficientCoinsAmount, err)
+	_, err = maxCoins.Negative().SafeSub(one)
+	require.Equal(t, ErrIntOverflow, err)
+
+	prod, err := one.SafeMul(NewInt(3))
+	require.Nil(t, err)
+	require.Equal(t, Coins{{"atom", NewInt(3)}, {"steak", NewInt(3)}}, prod)
+	prod, err = one.SafeMul(ZeroInt())
+	require.Nil(t, err)
+	require.Nil(t, prod)
+	_, err = maxCoins.SafeMul(NewInt(2))
+	require.Equal(t, ErrIntOverflow, err)
+}
+
 //Test the parsing of Coin and Coins
 func TestParse(t *testing.T) {
 	one := NewInt(1)
@@ -45,6 +45,10 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 	c = c.WithLogger(logger)
 	c = c.WithSigningValidators(nil)
 	c = c.WithGasMeter(NewInfiniteGasMeter())
+	c = c.WithMinimumGasPrices(nil)
+	c = c.WithSimulate(false)
+	c = c.WithMsgIndex(-1)
+	c = c.WithTagCollector(nil)
 	return c
 }
 
@@ -130,6 +134,10 @@ const (
 	contextKeyLogger
 	contextKeySigningValidators
 	contextKeyGasMeter
+	contextKeyMinimumGasPrices
+	contextKeySimulate
+	contextKeyMsgIndex
+	contextKeyTagCollector
 )
 
 // NOTE: Do not expose MultiStore.
@@ -161,6 +169,18 @@ func (c Context) SigningValidators() []abci.SigningValidator {
 func (c Context) GasMeter() GasMeter {
 	return c.Value(contextKeyGasMeter).(GasMeter)
 }
+func (c Context) MinimumGasPrices() GasPrices {
+	return c.Value(contextKeyMinimumGasPrices).(GasPrices)
+}
+func (c Context) IsSimulate() bool {
+	return c.Value(contextKeySimulate).(bool)
+}
+func (c Context) MsgIndex() int {
+	return c.Value(contextKeyMsgIndex).(int)
+}
+func (c Context) TagCollector() *TagCollector {
+	return c.Value(contextKeyTagCollector).(*TagCollector)
+}
 func (c Context) WithMultiStore(ms MultiStore) Context {
 	return c.withValue(contextKeyMultiStore, ms)
 }
@@ -186,13 +206,49 @@ func (c Context) WithSigningValidators(SigningValidators []abci.SigningValidator
 func (c Context) WithGasMeter(meter GasMeter) Context {
 	return c.withValue(contextKeyGasMeter, meter)
 }
+func (c Context) WithMinimumGasPrices(prices GasPrices) Context {
+	return c.withValue(contextKeyMinimumGasPrices, prices)
+}
+func (c Context) WithSimulate(simulate bool) Context {
+	return c.withValue(contextKeySimulate, simulate)
+}
+
+// WithMsgIndex sets the index of the msg run in its tx, -1 outside of msgs
+func (c Context) WithMsgIndex(msgIndex int) Context {
+	return c.withValue(contextKeyMsgIndex, msgIndex)
+}
+
+// WithTagCollector sets the TagCollector collecting the tags emitted with
+// EmitTags, nil to drop them
+func (c Context) WithTagCollector(tc *TagCollector) Context {
+	return c.withValue(contextKeyTagCollector, tc)
+}
+
+// EmitTags adds the tags to the result of the msg or of the block hook run
+// with the context, e.g. for keepers whose callers don't return their tags
+func (c Context) EmitTags(tags Tags) {
+	if tc := c.TagCollector(); tc != nil {
+		tc.AppendTags(tags)
+	}
+}
 
 // Cache the multistore and return a new cached context. The cached context is
-// written to the context when writeCache is called.
+// written to the context when writeCache is called, along with the tags
+// emitted with it.
 func (c Context) CacheContext() (cc Context, writeCache func()) {
 	cms := c.multiStore().CacheMultiStore()
 	cc = c.WithMultiStore(cms)
-	return cc, cms.Write
+	parent := c.TagCollector()
+	if parent == nil {
+		return cc, cms.Write
+	}
+	tc := NewTagCollector()
+	cc = cc.WithTagCollector(tc)
+	return cc, func() {
+		cms.Write()
+		parent.AppendTags(tc.Tags())
+		tc.tags = nil
+	}
 }
 
 //----------------------------------------
@@ -88,6 +88,29 @@ func TestCacheContext(t *testing.T) {
 	require.Equal(t, v2, store.Get(k2))
 }
 
+func TestEmitTags(t *testing.T) {
+	key := types.NewKVStoreKey(t.Name())
+	ctx := defaultContext(key)
+
+	// without a collector the tags are dropped
+	ctx.EmitTags(types.NewTags("a", []byte("1")))
+	require.Equal(t, -1, ctx.MsgIndex())
+
+	tc := types.NewTagCollector()
+	ctx = ctx.WithTagCollector(tc)
+	ctx.EmitTags(types.NewTags("a", []byte("1")))
+
+	// the tags of a cache context are only kept if it is written
+	cctx, _ := ctx.CacheContext()
+	cctx.EmitTags(types.NewTags("b", []byte("2")))
+	require.Equal(t, types.NewTags("a", []byte("1")), tc.Tags())
+
+	cctx, write := ctx.CacheContext()
+	cctx.EmitTags(types.NewTags("c", []byte("3")))
+	write()
+	require.Equal(t, types.NewTags("a", []byte("1"), "c", []byte("3")), tc.Tags())
+}
+
 func TestLogContext(t *testing.T) {
 	key := types.NewKVStoreKey(t.Name())
 	ctx := defaultContext(key)
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// DefaultDenomRegex is the denomination format accepted when no other
+// format has been configured: 3 ~ 16 alphanumeric characters starting with
+// a letter.
+const DefaultDenomRegex = `[[:alpha:]][[:alnum:]]{2,15}`
+
+// maximum exponent between a base and a display denomination
+const maxDenomExponent = 18
+
+var reDecAmt = regexp.MustCompile(`^[[:digit:]]+(\.[[:digit:]]+)?$`)
+
+// the compiled denomination formats, a chain uses a single format which is
+// compiled once rather than on every validation
+var denomFormats sync.Map
+
+// compile a denomination format, anchored to match whole denominations
+func compileDenomFormat(format string) (*regexp.Regexp, error) {
+	if re, ok := denomFormats.Load(format); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(fmt.Sprintf(`^(?:%s)$`, format))
+	if err != nil {
+		return nil, fmt.Errorf("invalid denom format %q: %v", format, err)
+	}
+	denomFormats.Store(format, re)
+	return re, nil
+}
+
+// ValidateDenomFormat returns an error if format is not a valid regex
+func ValidateDenomFormat(format string) error {
+	_, err := compileDenomFormat(format)
+	return err
+}
+
+// ValidateDenom returns an error if denom doesn't fully match the given
+// denomination format regex.
+func ValidateDenom(denom string, format string) error {
+	re, err := compileDenomFormat(format)
+	if err != nil {
+		return err
+	}
+	if !re.MatchString(denom) {
+		return fmt.Errorf("invalid denom %q, must match %s", denom, format)
+	}
+	return nil
+}
+
+// DenomMetadata describes a coin denomination. All on-chain amounts are
+// expressed in the Base denomination, one unit of the Display denomination
+// is worth 10^Exponent base units.
+type DenomMetadata struct {
+	Base        string `json:"base"`
+	Display     string `json:"display"`
+	Exponent    uint8  `json:"exponent"`
+	Description string `json:"description"`
+}
+
+// NewDenomMetadata creates a new DenomMetadata
+func NewDenomMetadata(base, display string, exponent uint8, description string) DenomMetadata {
+	return DenomMetadata{
+		Base:        base,
+		Display:     display,
+		Exponent:    exponent,
+		Description: description,
+	}
+}
+
+// ValidateBasic checks the denominations against the given format and
+// ensures the exponent is within range.
+func (meta DenomMetadata) ValidateBasic(format string) error {
+	if err := ValidateDenom(meta.Base, format); err != nil {
+		return err
+	}
+	if err := ValidateDenom(meta.Display, format); err != nil {
+		return err
+	}
+	if meta.Base == meta.Display && meta.Exponent != 0 {
+		return fmt.Errorf("display denom %s equals base denom but exponent is %d", meta.Display, meta.Exponent)
+	}
+	if meta.Exponent > maxDenomExponent {
+		return fmt.Errorf("exponent %d is greater than the maximum %d", meta.Exponent, maxDenomExponent)
+	}
+	return nil
+}
+
+// unit returns the amount of base units in one display unit
+func (meta DenomMetadata) unit() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(meta.Exponent)), nil)
+}
+
+// ToBase converts a decimal amount of display units, e.g. "1.5",
+// to base units. It errors if the amount has more decimals than the
+// exponent allows.
+func (meta DenomMetadata) ToBase(amount string) (Int, error) {
+	if !reDecAmt.MatchString(amount) {
+		return Int{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	parts := strings.Split(amount, ".")
+	intPart, fracPart := parts[0], ""
+	if len(parts) == 2 {
+		fracPart = parts[1]
+	}
+	if len(fracPart) > int(meta.Exponent) {
+		return Int{}, fmt.Errorf("amount %s%s has more than %d decimals", amount, meta.Display, meta.Exponent)
+	}
+
+	// shift the decimal point by the exponent
+	digits := intPart + fracPart + strings.Repeat("0", int(meta.Exponent)-len(fracPart))
+	res, ok := NewIntFromString(strings.TrimLeft(digits, "0"))
+	if !ok {
+		if strings.Trim(digits, "0") == "" {
+			return ZeroInt(), nil
+		}
+		return Int{}, fmt.Errorf("invalid amount %q", amount)
+	}
+	return res, nil
+}
+
+// ToDisplay converts an amount of base units to a decimal amount of display
+// units with trailing zeros removed, e.g. 1500000 -> "1.5".
+func (meta DenomMetadata) ToDisplay(amount Int) string {
+	bi := amount.BigInt()
+	neg := bi.Sign() < 0
+	bi.Abs(bi)
+
+	quo, rem := new(big.Int).QuoRem(bi, meta.unit(), new(big.Int))
+	res := quo.String()
+	if rem.Sign() != 0 {
+		frac := rem.String()
+		frac = strings.Repeat("0", int(meta.Exponent)-len(frac)) + frac
+		res += "." + strings.TrimRight(frac, "0")
+	}
+	if neg {
+		res = "-" + res
+	}
+	return res
+}
+
+// DisplayCoin renders a coin in its display denomination. Coins without
+// metadata are rendered in their base denomination.
+func DisplayCoin(coin Coin, metadata []DenomMetadata) string {
+	for _, meta := range metadata {
+		if meta.Base == coin.Denom {
+			return meta.ToDisplay(coin.Amount) + meta.Display
+		}
+	}
+	return coin.String()
+}
+
+// DisplayCoins renders a set of coins in their display denominations.
+func DisplayCoins(coins Coins, metadata []DenomMetadata) string {
+	strs := make([]string, len(coins))
+	for i, coin := range coins {
+		strs[i] = DisplayCoin(coin, metadata)
+	}
+	return strings.Join(strs, ",")
+}
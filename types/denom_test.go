@@ -0,0 +1,106 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDenom(t *testing.T) {
+	require.NoError(t, ValidateDenom("atom", DefaultDenomRegex))
+	require.NoError(t, ValidateDenom("uatom2", DefaultDenomRegex))
+	require.Error(t, ValidateDenom("at", DefaultDenomRegex))
+	require.Error(t, ValidateDenom("1atom", DefaultDenomRegex))
+	require.Error(t, ValidateDenom("atom-x", DefaultDenomRegex))
+	require.Error(t, ValidateDenom("atom", "[a-z"))
+	require.NoError(t, ValidateDenom("ATOM", "[A-Z]{4}"))
+	require.Error(t, ValidateDenom("atom", "[A-Z]{4}"))
+}
+
+func TestDenomMetadataValidateBasic(t *testing.T) {
+	cases := []struct {
+		meta  DenomMetadata
+		valid bool
+	}{
+		{NewDenomMetadata("uatom", "atom", 6, "atom"), true},
+		{NewDenomMetadata("atom", "atom", 0, ""), true},
+		{NewDenomMetadata("atom", "atom", 6, ""), false},
+		{NewDenomMetadata("uatom", "atom", 19, ""), false},
+		{NewDenomMetadata("u", "atom", 6, ""), false},
+		{NewDenomMetadata("uatom", "", 6, ""), false},
+	}
+
+	for tcIndex, tc := range cases {
+		err := tc.meta.ValidateBasic(DefaultDenomRegex)
+		require.Equal(t, tc.valid, err == nil, "tc #%d: %v", tcIndex, err)
+	}
+}
+
+func TestDenomMetadataConversion(t *testing.T) {
+	meta := NewDenomMetadata("uatom", "atom", 6, "")
+
+	cases := []struct {
+		display string
+		base    Int
+		valid   bool
+	}{
+		{"1", NewInt(1000000), true},
+		{"1.5", NewInt(1500000), true},
+		{"0.000001", NewInt(1), true},
+		{"0", ZeroInt(), true},
+		{"0.0000001", Int{}, false},
+		{"1.", Int{}, false},
+		{".5", Int{}, false},
+		{"0x10", Int{}, false},
+	}
+
+	for tcIndex, tc := range cases {
+		res, err := meta.ToBase(tc.display)
+		if !tc.valid {
+			require.Error(t, err, "tc #%d", tcIndex)
+			continue
+		}
+		require.NoError(t, err, "tc #%d", tcIndex)
+		require.True(t, tc.base.Equal(res), "tc #%d: expected %v, got %v", tcIndex, tc.base, res)
+	}
+
+	require.Equal(t, "1.5", meta.ToDisplay(NewInt(1500000)))
+	require.Equal(t, "0.000001", meta.ToDisplay(NewInt(1)))
+	require.Equal(t, "2", meta.ToDisplay(NewInt(2000000)))
+	require.Equal(t, "-1.5", meta.ToDisplay(NewInt(-1500000)))
+
+	coins := Coins{NewCoin("steak", 5), NewCoin("uatom", 2500000)}
+	require.Equal(t, "5steak,2.5atom", DisplayCoins(coins, []DenomMetadata{meta}))
+}
+
+func TestParseDisplayCoins(t *testing.T) {
+	meta := NewDenomMetadata("uatom", "atom", 6, "")
+
+	res, err := ParseCoins("1.5atom,10steak", meta)
+	require.NoError(t, err)
+	require.Equal(t, Coins{NewCoin("steak", 10), NewCoin("uatom", 1500000)}, res)
+
+	res, err = ParseCoins("15uatom", meta)
+	require.NoError(t, err)
+	require.Equal(t, Coins{NewCoin("uatom", 15)}, res)
+
+	// decimals are only allowed for display denominations
+	_, err = ParseCoins("1.5uatom", meta)
+	require.Error(t, err)
+	_, err = ParseCoins("1.5atom")
+	require.Error(t, err)
+}
+
+func TestParseCoinsWithFormat(t *testing.T) {
+	format := `[a-z]+(/[a-z0-9]+)*`
+
+	res, err := ParseCoinsWithFormat("5transfer/ch0/atom", format)
+	require.NoError(t, err)
+	require.Equal(t, Coins{NewCoin("transfer/ch0/atom", 5)}, res)
+
+	// the default format only accepts alphanumeric denominations
+	_, err = ParseCoins("5transfer/ch0/atom")
+	require.Error(t, err)
+	_, err = ParseCoinsWithFormat("5Atom", format)
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@ var codeTypes = []CodeType{
 	CodeUnknownRequest,
 	CodeUnknownAddress,
 	CodeInvalidPubKey,
+	CodeInsufficientFee,
 }
 
 type errFn func(msg string) Error
@@ -29,6 +30,7 @@ var errFns = []errFn{
 	ErrUnknownRequest,
 	ErrUnknownAddress,
 	ErrInvalidPubKey,
+	ErrInsufficientFee,
 }
 
 func TestCodeType(t *testing.T) {
@@ -1,5 +1,7 @@
 package types
 
+import "math"
+
 // Gas measured by the SDK
 type Gas = int64
 
@@ -8,6 +10,12 @@ type ErrorOutOfGas struct {
 	Descriptor string
 }
 
+// Error thrown when gas arithmetic overflows or a negative
+// amount of gas is consumed
+type ErrorGasOverflow struct {
+	Descriptor string
+}
+
 // GasMeter interface to track gas consumption
 type GasMeter interface {
 	GasConsumed() Gas
@@ -31,7 +39,7 @@ func (g *basicGasMeter) GasConsumed() Gas {
 }
 
 func (g *basicGasMeter) ConsumeGas(amount Gas, descriptor string) {
-	g.consumed += amount
+	g.consumed = AddGas(g.consumed, amount, descriptor)
 	if g.consumed > g.limit {
 		panic(ErrorOutOfGas{descriptor})
 	}
@@ -52,5 +60,23 @@ func (g *infiniteGasMeter) GasConsumed() Gas {
 }
 
 func (g *infiniteGasMeter) ConsumeGas(amount Gas, descriptor string) {
-	g.consumed += amount
+	g.consumed = AddGas(g.consumed, amount, descriptor)
+}
+
+// AddGas adds a non-negative amount of gas to the gas consumed so far.
+// Panics with ErrorGasOverflow on overflow or if amount is negative.
+func AddGas(consumed Gas, amount Gas, descriptor string) Gas {
+	if amount < 0 || consumed > math.MaxInt64-amount {
+		panic(ErrorGasOverflow{descriptor})
+	}
+	return consumed + amount
+}
+
+// MulGas multiplies a non-negative gas cost by a non-negative factor.
+// Panics with ErrorGasOverflow on overflow or if either is negative.
+func MulGas(cost Gas, factor Gas, descriptor string) Gas {
+	if cost < 0 || factor < 0 || (factor != 0 && cost > math.MaxInt64/factor) {
+		panic(ErrorGasOverflow{descriptor})
+	}
+	return cost * factor
 }
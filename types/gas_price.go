@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// maximum number of decimals of a gas price
+const gasPricePrecision = 18
+
+// GasPrice is the price of one unit of gas in a denomination
+type GasPrice struct {
+	Denom  string `json:"denom"`
+	Amount Rat    `json:"amount"`
+}
+
+// String provides a human-readable representation of a gas price
+func (price GasPrice) String() string {
+	return fmt.Sprintf("%v%v", price.Amount.FloatString(), price.Denom)
+}
+
+// GasPrices is a set of gas prices sorted by denomination, a fee may be paid
+// in any one of them.
+type GasPrices []GasPrice
+
+func (prices GasPrices) String() string {
+	strs := make([]string, len(prices))
+	for i, price := range prices {
+		strs[i] = price.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Fee returns the fee required to pay for gas at each of the prices, the
+// amounts are rounded up to the next base unit.
+func (prices GasPrices) Fee(gas int64) Coins {
+	fee := Coins{}
+	for _, price := range prices {
+		amount := price.Amount.Mul(NewRat(gas))
+		quo, rem := new(big.Int).QuoRem(amount.Num().BigInt(), amount.Denom().BigInt(), new(big.Int))
+		if rem.Sign() > 0 {
+			quo.Add(quo, big.NewInt(1))
+		}
+		if quo.Sign() > 0 {
+			fee = append(fee, Coin{price.Denom, NewIntFromBigInt(quo)})
+		}
+	}
+	return fee
+}
+
+// IsCoveredBy returns true if the fee pays for gas at any one of the prices.
+func (prices GasPrices) IsCoveredBy(fee Coins, gas int64) bool {
+	required := prices.Fee(gas)
+	if len(required) == 0 {
+		return true
+	}
+	for _, coin := range required {
+		if !fee.AmountOf(coin.Denom).LT(coin.Amount) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseGasPrices will parse out a list of gas prices separated by commas,
+// e.g. "0.025steak,0.1photino". If nothing is provided, it returns nil
+// GasPrices. The denominations are checked against the default format, the
+// AnteHandler checks them against the chain's format.
+func ParseGasPrices(pricesStr string) (GasPrices, error) {
+	pricesStr = strings.TrimSpace(pricesStr)
+	if len(pricesStr) == 0 {
+		return nil, nil
+	}
+
+	priceStrs := strings.Split(pricesStr, ",")
+	prices := make(GasPrices, len(priceStrs))
+	for i, priceStr := range priceStrs {
+		matches := reCoin.FindStringSubmatch(strings.TrimSpace(priceStr))
+		if matches == nil {
+			return nil, fmt.Errorf("invalid gas price expression: %s", priceStr)
+		}
+		err := ValidateDenom(matches[2], DefaultDenomRegex)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := NewRatFromDecimal(matches[1], gasPricePrecision)
+		if err != nil {
+			return nil, err
+		}
+		prices[i] = GasPrice{Denom: matches[2], Amount: amount}
+	}
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Denom < prices[j].Denom })
+	for i := 1; i < len(prices); i++ {
+		if prices[i].Denom == prices[i-1].Denom {
+			return nil, fmt.Errorf("duplicate gas price denomination %s", prices[i].Denom)
+		}
+	}
+	return prices, nil
+}
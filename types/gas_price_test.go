@@ -0,0 +1,54 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGasPrices(t *testing.T) {
+	cases := []struct {
+		input    string
+		valid    bool
+		expected GasPrices
+	}{
+		{"", true, nil},
+		{"0.025steak", true, GasPrices{{"steak", NewRat(1, 40)}}},
+		{"1photino, 0.5atom", true, GasPrices{{"atom", NewRat(1, 2)}, {"photino", NewRat(1)}}},
+		{"0.1atom,0.2atom", false, nil},
+		{"steak", false, nil},
+		{"-1steak", false, nil},
+		{"0.0000000000000000001steak", false, nil},
+	}
+
+	for tcIndex, tc := range cases {
+		res, err := ParseGasPrices(tc.input)
+		if !tc.valid {
+			require.Error(t, err, "tc #%d", tcIndex)
+			continue
+		}
+		require.NoError(t, err, "tc #%d", tcIndex)
+		require.Equal(t, len(tc.expected), len(res), "tc #%d", tcIndex)
+		for i := range res {
+			require.Equal(t, tc.expected[i].Denom, res[i].Denom, "tc #%d", tcIndex)
+			require.True(t, tc.expected[i].Amount.Equal(res[i].Amount), "tc #%d", tcIndex)
+		}
+	}
+}
+
+func TestGasPricesFee(t *testing.T) {
+	prices, err := ParseGasPrices("0.025steak,0.1atom")
+	require.NoError(t, err)
+
+	require.Equal(t, Coins{NewCoin("atom", 1000), NewCoin("steak", 250)}, prices.Fee(10000))
+	// amounts are rounded up
+	require.Equal(t, Coins{NewCoin("atom", 1), NewCoin("steak", 1)}, prices.Fee(1))
+	require.Equal(t, Coins{}, prices.Fee(0))
+
+	require.True(t, prices.IsCoveredBy(Coins{NewCoin("steak", 250)}, 10000))
+	require.True(t, prices.IsCoveredBy(Coins{NewCoin("atom", 1000)}, 10000))
+	require.False(t, prices.IsCoveredBy(Coins{NewCoin("atom", 999), NewCoin("steak", 249)}, 10000))
+	require.False(t, prices.IsCoveredBy(Coins{}, 10000))
+	require.True(t, prices.IsCoveredBy(Coins{}, 0))
+	require.True(t, GasPrices(nil).IsCoveredBy(Coins{}, 10000))
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGasMeter(t *testing.T) {
+	meter := NewGasMeter(100)
+	meter.ConsumeGas(60, "first")
+	require.Equal(t, Gas(60), meter.GasConsumed())
+	require.Panics(t, func() { meter.ConsumeGas(41, "second") })
+
+	// overflow must not wrap around the limit
+	meter = NewGasMeter(math.MaxInt64)
+	meter.ConsumeGas(10, "first")
+	require.PanicsWithValue(t, ErrorGasOverflow{"overflow"}, func() { meter.ConsumeGas(math.MaxInt64, "overflow") })
+	require.PanicsWithValue(t, ErrorGasOverflow{"negative"}, func() { meter.ConsumeGas(-1, "negative") })
+
+	infinite := NewInfiniteGasMeter()
+	infinite.ConsumeGas(math.MaxInt64, "max")
+	require.Equal(t, Gas(math.MaxInt64), infinite.GasConsumed())
+	require.PanicsWithValue(t, ErrorGasOverflow{"overflow"}, func() { infinite.ConsumeGas(1, "overflow") })
+}
+
+func TestGasArithmetic(t *testing.T) {
+	require.Equal(t, Gas(30), AddGas(10, 20, "add"))
+	require.Equal(t, Gas(200), MulGas(10, 20, "mul"))
+	require.Equal(t, Gas(0), MulGas(10, 0, "mul"))
+	require.Panics(t, func() { AddGas(math.MaxInt64, 1, "add") })
+	require.Panics(t, func() { MulGas(math.MaxInt64/2+1, 2, "mul") })
+	require.Panics(t, func() { MulGas(-1, 2, "mul") })
+}
@@ -2,10 +2,19 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 
 	"math/big"
 )
 
+// Errors returned by the checked arithmetic of Int and Uint. The unchecked
+// operations panic with the error message instead.
+var (
+	ErrIntOverflow    = errors.New("Int overflow")
+	ErrUintOverflow   = errors.New("Uint overflow")
+	ErrDivisionByZero = errors.New("Division by zero")
+)
+
 func newIntegerFromString(s string) (*big.Int, bool) {
 	return new(big.Int).SetString(s, 0)
 }
@@ -141,6 +150,16 @@ func (i Int) Int64() int64 {
 	return i.i.Int64()
 }
 
+// IsInt64 returns true if Int can be represented as an int64
+func (i Int) IsInt64() bool {
+	return i.i.IsInt64()
+}
+
+// IsNil returns true if Int is uninitialized, e.g. missing from a decoded message
+func (i Int) IsNil() bool {
+	return i.i == nil
+}
+
 // IsZero returns true if Int is zero
 func (i Int) IsZero() bool {
 	return i.i.Sign() == 0
@@ -168,10 +187,19 @@ func (i Int) LT(i2 Int) bool {
 
 // Add adds Int from another
 func (i Int) Add(i2 Int) (res Int) {
+	res, err := i.SafeAdd(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeAdd adds Int from another, returning ErrIntOverflow on overflow
+func (i Int) SafeAdd(i2 Int) (res Int, err error) {
 	res = Int{add(i.i, i2.i)}
 	// Check overflow
 	if res.i.BitLen() > 255 {
-		panic("Int overflow")
+		return Int{}, ErrIntOverflow
 	}
 	return
 }
@@ -183,10 +211,19 @@ func (i Int) AddRaw(i2 int64) Int {
 
 // Sub subtracts Int from another
 func (i Int) Sub(i2 Int) (res Int) {
+	res, err := i.SafeSub(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeSub subtracts Int from another, returning ErrIntOverflow on overflow
+func (i Int) SafeSub(i2 Int) (res Int, err error) {
 	res = Int{sub(i.i, i2.i)}
 	// Check overflow
 	if res.i.BitLen() > 255 {
-		panic("Int overflow")
+		return Int{}, ErrIntOverflow
 	}
 	return
 }
@@ -198,14 +235,23 @@ func (i Int) SubRaw(i2 int64) Int {
 
 // Mul multiples two Ints
 func (i Int) Mul(i2 Int) (res Int) {
+	res, err := i.SafeMul(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeMul multiples two Ints, returning ErrIntOverflow on overflow
+func (i Int) SafeMul(i2 Int) (res Int, err error) {
 	// Check overflow
 	if i.i.BitLen()+i2.i.BitLen()-1 > 255 {
-		panic("Int overflow")
+		return Int{}, ErrIntOverflow
 	}
 	res = Int{mul(i.i, i2.i)}
 	// Check overflow if sign of both are same
 	if res.i.BitLen() > 255 {
-		panic("Int overflow")
+		return Int{}, ErrIntOverflow
 	}
 	return
 }
@@ -217,11 +263,20 @@ func (i Int) MulRaw(i2 int64) Int {
 
 // Div divides Int with Int
 func (i Int) Div(i2 Int) (res Int) {
+	res, err := i.SafeDiv(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeDiv divides Int with Int, returning ErrDivisionByZero if i2 is zero
+func (i Int) SafeDiv(i2 Int) (res Int, err error) {
 	// Check division-by-zero
 	if i2.i.Sign() == 0 {
-		panic("Division by zero")
+		return Int{}, ErrDivisionByZero
 	}
-	return Int{div(i.i, i2.i)}
+	return Int{div(i.i, i2.i)}, nil
 }
 
 // DivRaw divides Int with int64
@@ -256,7 +311,10 @@ func (i *Int) UnmarshalAmino(text string) error {
 	if i.i == nil { // Necessary since default Int initialization has i.i as nil
 		i.i = new(big.Int)
 	}
-	return unmarshalAmino(i.i, text)
+	if err := unmarshalAmino(i.i, text); err != nil {
+		return err
+	}
+	return checkIntBound(i.i)
 }
 
 // MarshalJSON defines custom encoding scheme
@@ -272,7 +330,18 @@ func (i *Int) UnmarshalJSON(bz []byte) error {
 	if i.i == nil { // Necessary since default Int initialization has i.i as nil
 		i.i = new(big.Int)
 	}
-	return unmarshalJSON(i.i, bz)
+	if err := unmarshalJSON(i.i, bz); err != nil {
+		return err
+	}
+	return checkIntBound(i.i)
+}
+
+// decoded Ints are bound like the results of the arithmetic
+func checkIntBound(i *big.Int) error {
+	if i.BitLen() > 255 {
+		return ErrIntOverflow
+	}
+	return nil
 }
 
 // Int wraps integer with 256 bit range bound
@@ -370,10 +439,19 @@ func (i Uint) LT(i2 Uint) bool {
 
 // Add adds Uint from another
 func (i Uint) Add(i2 Uint) (res Uint) {
+	res, err := i.SafeAdd(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeAdd adds Uint from another, returning ErrUintOverflow on overflow
+func (i Uint) SafeAdd(i2 Uint) (res Uint, err error) {
 	res = Uint{add(i.i, i2.i)}
 	// Check overflow
 	if res.Sign() == -1 || res.Sign() == 1 && res.i.BitLen() > 256 {
-		panic("Uint overflow")
+		return Uint{}, ErrUintOverflow
 	}
 	return
 }
@@ -385,10 +463,20 @@ func (i Uint) AddRaw(i2 uint64) Uint {
 
 // Sub subtracts Uint from another
 func (i Uint) Sub(i2 Uint) (res Uint) {
+	res, err := i.SafeSub(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeSub subtracts Uint from another, returning ErrUintOverflow if the
+// result would be negative
+func (i Uint) SafeSub(i2 Uint) (res Uint, err error) {
 	res = Uint{sub(i.i, i2.i)}
 	// Check overflow
 	if res.Sign() == -1 || res.Sign() == 1 && res.i.BitLen() > 256 {
-		panic("Uint overflow")
+		return Uint{}, ErrUintOverflow
 	}
 	return
 }
@@ -400,14 +488,23 @@ func (i Uint) SubRaw(i2 uint64) Uint {
 
 // Mul multiples two Uints
 func (i Uint) Mul(i2 Uint) (res Uint) {
+	res, err := i.SafeMul(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeMul multiples two Uints, returning ErrUintOverflow on overflow
+func (i Uint) SafeMul(i2 Uint) (res Uint, err error) {
 	// Check overflow
 	if i.i.BitLen()+i2.i.BitLen()-1 > 256 {
-		panic("Uint overflow")
+		return Uint{}, ErrUintOverflow
 	}
 	res = Uint{mul(i.i, i2.i)}
 	// Check overflow
 	if res.Sign() == -1 || res.Sign() == 1 && res.i.BitLen() > 256 {
-		panic("Uint overflow")
+		return Uint{}, ErrUintOverflow
 	}
 	return
 }
@@ -419,11 +516,20 @@ func (i Uint) MulRaw(i2 uint64) Uint {
 
 // Div divides Uint with Uint
 func (i Uint) Div(i2 Uint) (res Uint) {
+	res, err := i.SafeDiv(i2)
+	if err != nil {
+		panic(err.Error())
+	}
+	return
+}
+
+// SafeDiv divides Uint with Uint, returning ErrDivisionByZero if i2 is zero
+func (i Uint) SafeDiv(i2 Uint) (res Uint, err error) {
 	// Check division-by-zero
 	if i2.Sign() == 0 {
-		panic("division-by-zero")
+		return Uint{}, ErrDivisionByZero
 	}
-	return Uint{div(i.i, i2.i)}
+	return Uint{div(i.i, i2.i)}, nil
 }
 
 // Div divides Uint with int64
@@ -449,7 +555,10 @@ func (i *Uint) UnmarshalAmino(text string) error {
 	if i.i == nil { // Necessary since default Uint initialization has i.i as nil
 		i.i = new(big.Int)
 	}
-	return unmarshalAmino(i.i, text)
+	if err := unmarshalAmino(i.i, text); err != nil {
+		return err
+	}
+	return checkUintBound(i.i)
 }
 
 // MarshalJSON defines custom encoding scheme
@@ -465,5 +574,16 @@ func (i *Uint) UnmarshalJSON(bz []byte) error {
 	if i.i == nil { // Necessary since default Uint initialization has i.i as nil
 		i.i = new(big.Int)
 	}
-	return unmarshalJSON(i.i, bz)
+	if err := unmarshalJSON(i.i, bz); err != nil {
+		return err
+	}
+	return checkUintBound(i.i)
+}
+
+// decoded Uints are bound like the results of the arithmetic
+func checkUintBound(i *big.Int) error {
+	if i.Sign() == -1 || i.BitLen() > 256 {
+		return ErrUintOverflow
+	}
+	return nil
 }
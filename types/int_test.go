@@ -109,3 +109,57 @@ func TestUint(t *testing.T) {
 	// Division-by-zero check
 	require.Panics(t, func() { i1.Div(uintmin) })
 }
+
+func TestSafeArithmetic(t *testing.T) {
+	intmax := NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1)))
+	uintmax := NewUintFromBigInt(new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(256), nil), big.NewInt(1)))
+
+	res, err := intmax.SafeSub(OneInt())
+	require.Nil(t, err)
+	res, err = res.SafeAdd(OneInt())
+	require.Nil(t, err)
+	require.True(t, intmax.Equal(res))
+
+	_, err = intmax.SafeAdd(OneInt())
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = intmax.Neg().SafeSub(OneInt())
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = intmax.SafeMul(NewInt(2))
+	require.Equal(t, ErrIntOverflow, err)
+	_, err = intmax.SafeDiv(ZeroInt())
+	require.Equal(t, ErrDivisionByZero, err)
+
+	ures, err := uintmax.SafeSub(OneUint())
+	require.Nil(t, err)
+	ures, err = ures.SafeAdd(OneUint())
+	require.Nil(t, err)
+	require.True(t, uintmax.Equal(ures))
+
+	_, err = uintmax.SafeAdd(OneUint())
+	require.Equal(t, ErrUintOverflow, err)
+	_, err = ZeroUint().SafeSub(OneUint())
+	require.Equal(t, ErrUintOverflow, err)
+	_, err = uintmax.SafeMul(NewUint(2))
+	require.Equal(t, ErrUintOverflow, err)
+	_, err = uintmax.SafeDiv(ZeroUint())
+	require.Equal(t, ErrDivisionByZero, err)
+}
+
+func TestDecodeBounds(t *testing.T) {
+	intmax := new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(255), nil), big.NewInt(1))
+	uintmax := new(big.Int).Sub(new(big.Int).Exp(big.NewInt(2), big.NewInt(256), nil), big.NewInt(1))
+	over := func(max *big.Int) string { return new(big.Int).Add(max, big.NewInt(1)).String() }
+
+	var i Int
+	require.Nil(t, i.UnmarshalAmino(intmax.String()))
+	require.Nil(t, i.UnmarshalJSON([]byte(`"-`+intmax.String()+`"`)))
+	require.Equal(t, ErrIntOverflow, i.UnmarshalAmino(over(intmax)))
+	require.Equal(t, ErrIntOverflow, i.UnmarshalJSON([]byte(`"`+over(intmax)+`"`)))
+
+	var u Uint
+	require.Nil(t, u.UnmarshalAmino(uintmax.String()))
+	require.Nil(t, u.UnmarshalJSON([]byte(`"`+uintmax.String()+`"`)))
+	require.Equal(t, ErrUintOverflow, u.UnmarshalAmino(over(uintmax)))
+	require.Equal(t, ErrUintOverflow, u.UnmarshalJSON([]byte(`"`+over(uintmax)+`"`)))
+	require.Equal(t, ErrUintOverflow, u.UnmarshalJSON([]byte(`"-1"`)))
+}
@@ -0,0 +1,9 @@
+package types
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Querier serves the custom queries of a module. The path holds the
+// elements of the query path after the route of the module.
+type Querier func(ctx Context, path []string, req abci.RequestQuery) (res []byte, err Error)
@@ -174,6 +174,26 @@ func TestEvaluate(t *testing.T) {
 	}
 }
 
+func TestTruncateInt(t *testing.T) {
+	tests := []struct {
+		r1  Rat
+		res int64
+	}{
+		{NewRat(0), 0},
+		{NewRat(1), 1},
+		{NewRat(1, 2), 0},
+		{NewRat(3, 4), 0},
+		{NewRat(5, 2), 2},
+		{NewRat(113, 12), 9},
+		{NewRat(20, 3), 6},
+	}
+
+	for tcIndex, tc := range tests {
+		require.Equal(t, tc.res, tc.r1.TruncateInt().Int64(), "%v. tc #%d", tc.r1, tcIndex)
+		require.Equal(t, tc.res*-1, tc.r1.Mul(NewRat(-1)).TruncateInt().Int64(), "%v. tc #%d", tc.r1.Mul(NewRat(-1)), tcIndex)
+	}
+}
+
 func TestRound(t *testing.T) {
 	many3 := "333333333333333333333333333333333333333333333"
 	many7 := "777777777777777777777777777777777777777777777"
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+)
+
+// SignMode is the encoding of the document signed by a transaction signature
+type SignMode byte
+
+// nolint
+const (
+	SignModeJSON    SignMode = 0x00 // sorted JSON, the default
+	SignModeBinary  SignMode = 0x01 // canonical amino binary
+	SignModeTextual SignMode = 0x02 // human-readable text
+)
+
+// String implements fmt.Stringer
+func (mode SignMode) String() string {
+	switch mode {
+	case SignModeJSON:
+		return "json"
+	case SignModeBinary:
+		return "binary"
+	case SignModeTextual:
+		return "textual"
+	default:
+		return fmt.Sprintf("SignMode(%d)", byte(mode))
+	}
+}
+
+// ParseSignMode parses the name of a sign mode, the empty string is the
+// default JSON mode
+func ParseSignMode(s string) (SignMode, error) {
+	switch s {
+	case "", "json":
+		return SignModeJSON, nil
+	case "binary":
+		return SignModeBinary, nil
+	case "textual":
+		return SignModeTextual, nil
+	default:
+		return SignModeJSON, fmt.Errorf("unknown sign mode %s, expected one of json, binary or textual", s)
+	}
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"math"
+)
+
+// DefaultGasAdjustment is the multiplier applied to the gas used by a
+// simulation to recommend a gas limit, covering the gas consumed differently
+// once the tx is signed or the state changed before it is delivered.
+const DefaultGasAdjustment = 1.2
+
+// StoreDiff is a key written by a transaction, with its value in the store
+// before and after the transaction. A nil value is a missing key.
+type StoreDiff struct {
+	Store  string `json:"store"`
+	Key    []byte `json:"key"`
+	Before []byte `json:"before"`
+	After  []byte `json:"after"`
+}
+
+// SimulationResult is the outcome of the simulation of a transaction, its
+// writes are discarded.
+type SimulationResult struct {
+	Result
+
+	// GasRecommended is the gas used adjusted by the gas adjustment of the
+	// simulation, a gas limit for the transaction.
+	GasRecommended int64 `json:"gas_recommended"`
+
+	// Diffs are the keys written by the transaction, in order of first write.
+	Diffs []StoreDiff `json:"diffs"`
+}
+
+// RecommendedGas returns the gas limit recommended for a transaction which
+// used the gas, the gas multiplied by the adjustment and rounded up.
+func RecommendedGas(gasUsed int64, adjustment float64) int64 {
+	if adjustment <= 0 {
+		adjustment = DefaultGasAdjustment
+	}
+	return int64(math.Ceil(float64(gasUsed) * adjustment))
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecommendedGas(t *testing.T) {
+	cases := []struct {
+		gasUsed    int64
+		adjustment float64
+		expected   int64
+	}{
+		{0, 1.5, 0},
+		{100, 1, 100},
+		{100, 1.5, 150},
+		{101, 1.5, 152}, // rounded up
+		{100, 0, 120},   // default adjustment
+		{100, -1, 120},
+	}
+	for _, tc := range cases {
+		require.Equal(t, tc.expected, RecommendedGas(tc.gasUsed, tc.adjustment), "%+v", tc)
+	}
+}
@@ -92,3 +92,17 @@ type DelegationSet interface {
 	IterateDelegations(ctx Context, delegator AccAddress,
 		fn func(index int64, delegation Delegation) (stop bool))
 }
+
+//_______________________________________________________________________________
+
+// StakingHooks are called by the staking module when the validators and the
+// delegations change, the Before hooks while the previous validator and
+// shares can still be read
+type StakingHooks interface {
+	AfterValidatorCreated(ctx Context, valAddr AccAddress)                   // after a validator is created
+	BeforeValidatorRemoved(ctx Context, valAddr AccAddress)                  // before a validator is removed
+	BeforeDelegationCreated(ctx Context, delAddr, valAddr AccAddress)        // before a delegation is created
+	BeforeDelegationSharesModified(ctx Context, delAddr, valAddr AccAddress) // before the shares of a delegation change
+	BeforeDelegationRemoved(ctx Context, delAddr, valAddr AccAddress)        // before a delegation is removed
+	AfterDelegationModified(ctx Context, delAddr, valAddr AccAddress)        // after a delegation is created or its shares changed
+}
@@ -54,10 +54,34 @@ func MakeTag(k string, v []byte) Tag {
 
 //__________________________________________________
 
+// TagCollector collects the tags emitted through a Context
+type TagCollector struct {
+	tags Tags
+}
+
+// NewTagCollector returns a new empty TagCollector
+func NewTagCollector() *TagCollector {
+	return &TagCollector{}
+}
+
+// AppendTags adds the tags to the collected tags
+func (tc *TagCollector) AppendTags(tags Tags) {
+	tc.tags = tc.tags.AppendTags(tags)
+}
+
+// Tags returns the collected tags
+func (tc *TagCollector) Tags() Tags {
+	return tc.tags
+}
+
+//__________________________________________________
+
 // common tags
 var (
 	TagAction       = "action"
 	TagSrcValidator = "source-validator"
 	TagDstValidator = "destination-validator"
 	TagDelegator    = "delegator"
+	TagFee          = "fee"
+	TagRequiredFee  = "fee.required"
 )
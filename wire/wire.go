@@ -6,6 +6,8 @@ import (
 
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 )
 
 // amino codec to marshal/unmarshal
@@ -16,9 +18,10 @@ func NewCodec() *Codec {
 	return cdc
 }
 
-// Register the go-crypto to the codec
+// Register the go-crypto and the multisig types to the codec
 func RegisterCrypto(cdc *Codec) {
 	crypto.RegisterAmino(cdc)
+	multisig.RegisterAmino(cdc)
 }
 
 // attempt to make some pretty json
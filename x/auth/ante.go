@@ -4,6 +4,9 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,10 +17,42 @@ const (
 	maxMemoCharacters         = 100
 )
 
+// FeeGrantKeeper deducts fees paid by a granter from the fee allowance it
+// granted to the fee payer.
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error
+}
+
+// DenomValidator checks denominations and coins against the denomination
+// format of the chain
+type DenomValidator interface {
+	ValidateDenom(ctx sdk.Context, denom string) sdk.Error
+	ValidateCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error
+}
+
 // NewAnteHandler returns an AnteHandler that checks
 // and increments sequence numbers, checks signatures & account numbers,
 // and deducts fees from the first signer.
 func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
+	return NewFeeGrantAnteHandler(am, fck, nil)
+}
+
+// NewFeeGrantAnteHandler returns an AnteHandler like NewAnteHandler which
+// also deducts the fees of txs setting a fee granter from the granter,
+// within the fee allowance it granted to the first signer.
+func NewFeeGrantAnteHandler(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper) sdk.AnteHandler {
+	return newAnteHandler(am, fck, fgk, nil, nil)
+}
+
+// NewReplayAnteHandler returns an AnteHandler like NewFeeGrantAnteHandler
+// which also accepts unordered txs from the accounts which opted into them.
+// Unordered txs are rejected once timed out or if their hash was already seen.
+// If dv is set, the fees must be in denominations of the chain's format.
+func NewReplayAnteHandler(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper, rk ReplayKeeper, dv DenomValidator) sdk.AnteHandler {
+	return newAnteHandler(am, fck, fgk, &rk, dv)
+}
+
+func newAnteHandler(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper, rk *ReplayKeeper, dv DenomValidator) sdk.AnteHandler {
 
 	return func(
 		ctx sdk.Context, tx sdk.Tx,
@@ -34,6 +69,20 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			return ctx, err.Result(), true
 		}
 
+		// Reject txs paying less than the node's minimum gas prices. These are
+		// only set on the CheckTx context, DeliverTx doesn't depend on them.
+		err = checkMinimumGasPrices(ctx, stdTx.Fee, dv)
+		if err != nil {
+			return ctx, err.Result(), true
+		}
+
+		if dv != nil && !stdTx.Fee.Amount.IsZero() {
+			err = dv.ValidateCoins(ctx, stdTx.Fee.Amount)
+			if err != nil {
+				return ctx, err.Result(), true
+			}
+		}
+
 		sigs := stdTx.GetSignatures()
 		signerAddrs := stdTx.GetSigners()
 		msgs := tx.GetMsgs()
@@ -42,7 +91,16 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		ctx = ctx.WithGasMeter(sdk.NewGasMeter(stdTx.Fee.Gas))
 
 		// charge gas for the memo
-		ctx.GasMeter().ConsumeGas(memoCostPerByte*sdk.Gas(len(stdTx.GetMemo())), "memo")
+		ctx.GasMeter().ConsumeGas(sdk.MulGas(memoCostPerByte, sdk.Gas(len(stdTx.GetMemo())), "memo"), "memo")
+
+		// unordered txs are checked for replays by their hash
+		var unorderedHash []byte
+		if stdTx.IsUnordered() {
+			unorderedHash, err = checkUnorderedTx(ctx, rk, stdTx)
+			if err != nil {
+				return ctx, err.Result(), true
+			}
+		}
 
 		// Get the sign bytes (requires all account & sequence numbers and the fee)
 		sequences := make([]int64, len(sigs))
@@ -58,26 +116,51 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		for i := 0; i < len(sigs); i++ {
 			signerAddr, sig := signerAddrs[i], sigs[i]
 
+			if stdTx.IsUnordered() && !rk.IsUnordered(ctx, signerAddr) {
+				return ctx, sdk.ErrUnauthorized(
+					fmt.Sprintf("account %s doesn't accept unordered txs", signerAddr)).Result(), true
+			}
+
+			// render the signed document in the sign mode of the signature
+			signBytes, signErr := StdSignMsg{
+				ChainID:       ctx.ChainID(),
+				AccountNumber: accNums[i],
+				Sequence:      sequences[i],
+				Fee:           fee,
+				Msgs:          msgs,
+				Memo:          stdTx.GetMemo(),
+				TimeoutHeight: stdTx.TimeoutHeight,
+				Nonce:         stdTx.Nonce,
+			}.SignBytes(am.cdc, sig.SignMode)
+			if signErr != nil {
+				return ctx, sdk.ErrUnauthorized(signErr.Error()).Result(), true
+			}
+
 			// check signature, return account with incremented nonce
-			signBytes := StdSignBytes(ctx.ChainID(), accNums[i], sequences[i], fee, msgs, stdTx.GetMemo())
 			signerAcc, res := processSig(
 				ctx, am,
-				signerAddr, sig, signBytes,
+				signerAddr, sig, signBytes, !stdTx.IsUnordered(),
 			)
 			if !res.IsOK() {
 				return ctx, res, true
 			}
 
-			// first sig pays the fees
-			// TODO: Add min fees
-			// Can this function be moved outside of the loop?
+			// first sig pays the fees, unless they are paid by a fee granter
+			// TODO: Can this function be moved outside of the loop?
 			if i == 0 && !fee.Amount.IsZero() {
 				ctx.GasMeter().ConsumeGas(deductFeesCost, "deductFees")
-				signerAcc, res = deductFees(signerAcc, fee)
+				payer := signerAddr
+				if len(fee.Granter) == 0 {
+					signerAcc, res = deductFees(signerAcc, fee, ctx.BlockHeader().Time)
+				} else {
+					payer = fee.Granter
+					res = deductGrantedFees(ctx, am, fgk, signerAddr, fee)
+				}
 				if !res.IsOK() {
 					return ctx, res, true
 				}
-				fck.addCollectedFees(ctx, fee.Amount)
+				fck.AddCollectedFees(ctx, fee.Amount)
+				EmitTransfers(ctx, payer, NewModuleAddress(FeeCollectorName), fee.Amount)
 			}
 
 			// Save the account.
@@ -85,13 +168,54 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			signerAccs[i] = signerAcc
 		}
 
+		// record unordered txs until they time out
+		if unorderedHash != nil {
+			rk.setSeenTx(ctx, unorderedHash, stdTx.TimeoutHeight)
+		}
+
 		// cache the signer accounts in the context
 		ctx = WithSigners(ctx, signerAccs)
 
-		// TODO: tx tags (?)
+		return ctx, feeResult(fee), false // continue...
+	}
+}
+
+// feeResult reports the fee deducted by the AnteHandler. ABCI only carries a
+// single fee denomination, so FeeAmount and FeeDenom hold the first coin of
+// the (sorted) fee, while the full multi-denom fee is included as a tag.
+func feeResult(fee StdFee) sdk.Result {
+	if fee.Amount.IsZero() {
+		return sdk.Result{}
+	}
+	var res sdk.Result
+	first := fee.Amount[0]
+	if first.Amount.IsInt64() {
+		res.FeeAmount = first.Amount.Int64()
+		res.FeeDenom = first.Denom
+	}
+	res.Tags = sdk.NewTags(sdk.TagFee, []byte(fee.Amount.String()))
+	return res
+}
 
-		return ctx, sdk.Result{}, false // continue...
+// checkMinimumGasPrices returns an error if the fee doesn't pay for the gas
+// limit at any of the minimum gas prices of the context. If dv is set, the
+// prices must be in denominations of the chain's format, a fee can't be paid
+// in any other.
+func checkMinimumGasPrices(ctx sdk.Context, fee StdFee, dv DenomValidator) sdk.Error {
+	prices := ctx.MinimumGasPrices()
+	if dv != nil {
+		for _, price := range prices {
+			if err := dv.ValidateDenom(ctx, price.Denom); err != nil {
+				return err
+			}
+		}
+	}
+	if prices.IsCoveredBy(fee.Amount, fee.Gas) {
+		return nil
 	}
+	return sdk.ErrInsufficientFee(fmt.Sprintf(
+		"fee %s is below the minimum gas prices %s, requires one of %s for %d gas",
+		fee.Amount, prices, prices.Fee(fee.Gas), fee.Gas))
 }
 
 // Validate the transaction based on things that don't depend on the context
@@ -114,14 +238,40 @@ func validateBasic(tx StdTx) (err sdk.Error) {
 			fmt.Sprintf("maximum number of characters is %d but received %d characters",
 				maxMemoCharacters, len(memo)))
 	}
+
+	if tx.TimeoutHeight < 0 {
+		return sdk.ErrUnauthorized("timeout height must not be negative")
+	}
 	return nil
 }
 
-// verify the signature and increment the sequence.
+// Check an unordered tx can be included at the current height and wasn't
+// seen before, returning its hash.
+func checkUnorderedTx(ctx sdk.Context, rk *ReplayKeeper, tx StdTx) ([]byte, sdk.Error) {
+	if rk == nil {
+		return nil, sdk.ErrUnauthorized("unordered txs are not supported")
+	}
+	height := ctx.BlockHeight()
+	if tx.TimeoutHeight < height {
+		return nil, sdk.ErrInvalidSequence(
+			fmt.Sprintf("tx timed out at height %d, current height is %d", tx.TimeoutHeight, height))
+	}
+	if tx.TimeoutHeight > height+MaxTimeoutHeightDelta {
+		return nil, sdk.ErrInvalidSequence(
+			fmt.Sprintf("timeout height %d is more than %d blocks after the current height %d", tx.TimeoutHeight, MaxTimeoutHeightDelta, height))
+	}
+	hash := UnorderedTxHash(ctx.ChainID(), tx)
+	if rk.HasSeenTx(ctx, hash) {
+		return nil, sdk.ErrInvalidSequence("unordered tx was already processed")
+	}
+	return hash, nil
+}
+
+// verify the signature and, if checkSequence, the sequence and increment it.
 // if the account doesn't have a pubkey, set it.
 func processSig(
 	ctx sdk.Context, am AccountMapper,
-	addr sdk.AccAddress, sig StdSignature, signBytes []byte) (
+	addr sdk.AccAddress, sig StdSignature, signBytes []byte, checkSequence bool) (
 	acc Account, res sdk.Result) {
 
 	// Get the account.
@@ -137,21 +287,24 @@ func processSig(
 			fmt.Sprintf("Invalid account number. Got %d, expected %d", sig.AccountNumber, accnum)).Result()
 	}
 
-	// Check and increment sequence number.
-	seq := acc.GetSequence()
-	if seq != sig.Sequence {
-		return nil, sdk.ErrInvalidSequence(
-			fmt.Sprintf("Invalid sequence. Got %d, expected %d", sig.Sequence, seq)).Result()
-	}
-	err := acc.SetSequence(seq + 1)
-	if err != nil {
-		// Handle w/ #870
-		panic(err)
+	// Check and increment sequence number, unless the tx is unordered.
+	if checkSequence {
+		seq := acc.GetSequence()
+		if seq != sig.Sequence {
+			return nil, sdk.ErrInvalidSequence(
+				fmt.Sprintf("Invalid sequence. Got %d, expected %d", sig.Sequence, seq)).Result()
+		}
+		err := acc.SetSequence(seq + 1)
+		if err != nil {
+			// Handle w/ #870
+			panic(err)
+		}
 	}
 	// If pubkey is not known for account,
 	// set it from the StdSignature.
 	pubKey := acc.GetPubKey()
-	if pubKey == nil {
+	newPubKey := pubKey == nil
+	if newPubKey {
 		pubKey = sig.PubKey
 		if pubKey == nil {
 			return nil, sdk.ErrInvalidPubKey("PubKey not found").Result()
@@ -160,33 +313,80 @@ func processSig(
 			return nil, sdk.ErrInvalidPubKey(
 				fmt.Sprintf("PubKey does not match Signer address %v", addr)).Result()
 		}
-		err = acc.SetPubKey(pubKey)
+		err := acc.SetPubKey(pubKey)
 		if err != nil {
 			return nil, sdk.ErrInternal("setting PubKey on signer's account").Result()
 		}
 	}
 
-	// Check sig.
-	ctx.GasMeter().ConsumeGas(verifyCost, "ante verify")
-	if !pubKey.VerifyBytes(signBytes, sig.Signature) {
-		return nil, sdk.ErrUnauthorized("signature verification failed").Result()
+	// multisig pubkeys come from the txs, their threshold must be met by the
+	// signature and by the simulated gas
+	if multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold); ok {
+		if err := multisigPubKey.Validate(); err != nil {
+			return nil, sdk.ErrInvalidPubKey(err.Error()).Result()
+		}
+	}
+
+	// Simulations may leave out the signature, to estimate the gas of a tx
+	// before it is signed. The gas of its verification is still charged.
+	if ctx.IsSimulate() && sig.Signature == nil {
+		consumeSimulatedSignatureGas(ctx.GasMeter(), pubKey)
+	} else {
+		// Check sig.
+		consumeSignatureGas(ctx.GasMeter(), sig.Signature)
+		if !pubKey.VerifyBytes(signBytes, sig.Signature) {
+			return nil, sdk.ErrUnauthorized("signature verification failed").Result()
+		}
+	}
+
+	// index the account by the pubkey it was given
+	if newPubKey {
+		am.setPubKeyIndex(ctx, pubKey, addr)
 	}
 
 	return
 }
 
+// consumeSignatureGas charges the cost of verifying a signature, multisig
+// signatures are charged for each of their sub-signatures.
+func consumeSignatureGas(meter sdk.GasMeter, sig crypto.Signature) {
+	multisignature, ok := sig.(multisig.SignatureMultisig)
+	if !ok {
+		meter.ConsumeGas(verifyCost, "ante verify")
+		return
+	}
+	for _, subSig := range multisignature.Sigs {
+		consumeSignatureGas(meter, subSig)
+	}
+}
+
+// consumeSimulatedSignatureGas charges the cost of verifying a signature of
+// the pubkey, multisig pubkeys are charged for the threshold of signatures.
+func consumeSimulatedSignatureGas(meter sdk.GasMeter, pubKey crypto.PubKey) {
+	multisigPubKey, ok := pubKey.(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		meter.ConsumeGas(verifyCost, "ante verify")
+		return
+	}
+	for _, subKey := range multisigPubKey.PubKeys[:multisigPubKey.K] {
+		consumeSimulatedSignatureGas(meter, subKey)
+	}
+}
+
 // Deduct the fee from the account.
 // We could use the CoinKeeper (in addition to the AccountMapper,
 // because the CoinKeeper doesn't give us accounts), but it seems easier to do this.
-func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
+func deductFees(acc Account, fee StdFee, blockTime int64) (Account, sdk.Result) {
 	coins := acc.GetCoins()
 	feeAmount := fee.Amount
 
-	newCoins := coins.Minus(feeAmount)
-	if !newCoins.IsNotNegative() {
-		errMsg := fmt.Sprintf("%s < %s", coins, feeAmount)
+	// fees can only be paid with the coins of vesting accounts which are unlocked
+	spendable := SpendableCoins(acc, blockTime)
+	if !spendable.Minus(feeAmount).IsNotNegative() {
+		errMsg := fmt.Sprintf("%s < %s", spendable, feeAmount)
 		return nil, sdk.ErrInsufficientFunds(errMsg).Result()
 	}
+	newCoins := coins.Minus(feeAmount)
 	err := acc.SetCoins(newCoins)
 	if err != nil {
 		// Handle w/ #870
@@ -195,5 +395,29 @@ func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
 	return acc, sdk.Result{}
 }
 
+// Deduct the fee from the fee granter, within the fee allowance of the payer.
+func deductGrantedFees(ctx sdk.Context, am AccountMapper, fgk FeeGrantKeeper, payer sdk.AccAddress, fee StdFee) sdk.Result {
+	if fgk == nil {
+		return sdk.ErrUnauthorized("fee grants are not supported").Result()
+	}
+	if bytes.Equal(fee.Granter, payer) {
+		return sdk.ErrUnauthorized("fee granter can't be the fee payer").Result()
+	}
+	granterAcc := am.GetAccount(ctx, fee.Granter)
+	if granterAcc == nil {
+		return sdk.ErrUnknownAddress(fee.Granter.String()).Result()
+	}
+	granterAcc, res := deductFees(granterAcc, fee, ctx.BlockHeader().Time)
+	if !res.IsOK() {
+		return res
+	}
+	err := fgk.UseGrantedFees(ctx, fee.Granter, payer, fee.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	am.SetAccount(ctx, granterAcc)
+	return sdk.Result{}
+}
+
 // BurnFeeHandler burns all fees (decreasing total supply)
 func BurnFeeHandler(_ sdk.Context, _ sdk.Tx, _ sdk.Coins) {}
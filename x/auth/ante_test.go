@@ -9,6 +9,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
 )
@@ -336,6 +337,193 @@ func TestAnteHandlerFees(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx)
 
 	require.True(t, feeCollector.GetCollectedFees(ctx).IsEqual(sdk.Coins{sdk.NewCoin("atom", 150)}))
+
+	// the deducted fee is reported on the result, multi-denom fees are tagged
+	acc1 = mapper.GetAccount(ctx, addr1)
+	acc1.SetCoins(sdk.Coins{sdk.NewCoin("atom", 150), sdk.NewCoin("btc", 10)})
+	mapper.SetAccount(ctx, acc1)
+	fee = NewStdFee(5000, sdk.NewCoin("atom", 150), sdk.NewCoin("btc", 10))
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{1}, fee)
+	_, result, abort := anteHandler(ctx, tx)
+	require.False(t, abort)
+	require.Equal(t, int64(150), result.FeeAmount)
+	require.Equal(t, "atom", result.FeeDenom)
+	require.Equal(t, sdk.NewTags(sdk.TagFee, []byte("150atom,10btc")), result.Tags)
+}
+
+// Test logic around the minimum gas prices of the node.
+// feeGrantKeeper allows each grantee to have fees paid up to a limit
+type feeGrantKeeper map[string]sdk.Coins
+
+func (fgk feeGrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	key := granter.String() + "/" + grantee.String()
+	left := fgk[key].Minus(fee)
+	if fgk[key] == nil || !left.IsNotNegative() {
+		return sdk.ErrUnauthorized("no fee allowance")
+	}
+	fgk[key] = left
+	return nil
+}
+
+func TestAnteHandlerFeeGrant(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	fgk := feeGrantKeeper{}
+	anteHandler := NewFeeGrantAnteHandler(mapper, feeCollector, fgk)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	_, addr2 := privAndAddr()
+
+	// set the accounts, only the granter has coins
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(sdk.Coins{sdk.NewCoin("atom", 200)})
+	mapper.SetAccount(ctx, acc2)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []int64{0}, []int64{0}
+	fee := newStdFee()
+	fee.Granter = addr2
+	msgs := []sdk.Msg{msg}
+
+	// no allowance from the granter
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+
+	// fee grants are not supported by the default AnteHandler
+	fgk[addr2.String()+"/"+addr1.String()] = sdk.Coins{sdk.NewCoin("atom", 300)}
+	checkInvalidTx(t, NewAnteHandler(mapper, feeCollector), ctx, tx, sdk.CodeUnauthorized)
+
+	// the granter pays the fee from the allowance
+	checkValidTx(t, anteHandler, ctx, tx)
+	require.True(t, mapper.GetAccount(ctx, addr2).GetCoins().IsEqual(sdk.Coins{sdk.NewCoin("atom", 50)}))
+	require.True(t, mapper.GetAccount(ctx, addr1).GetCoins().IsZero())
+	require.True(t, fgk[addr2.String()+"/"+addr1.String()].IsEqual(sdk.Coins{sdk.NewCoin("atom", 150)}))
+	require.True(t, feeCollector.GetCollectedFees(ctx).IsEqual(sdk.Coins{sdk.NewCoin("atom", 150)}))
+
+	// the granter can't pay the fee, the allowance is left untouched
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{1}, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeInsufficientFunds)
+	require.True(t, fgk[addr2.String()+"/"+addr1.String()].IsEqual(sdk.Coins{sdk.NewCoin("atom", 150)}))
+
+	// the fee payer can't be the granter
+	fee.Granter = addr1
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{1}, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+}
+
+func TestAnteHandlerMinimumGasPrices(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.Coins{sdk.NewCoin("atom", 1000)})
+	mapper.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums := []crypto.PrivKey{priv1}, []int64{0}
+	msgs := []sdk.Msg{msg}
+
+	prices, err := sdk.ParseGasPrices("0.03atom")
+	require.NoError(t, err)
+	checkCtx := ctx.WithMinimumGasPrices(prices)
+
+	// 5000 gas at 0.03atom requires 150atom
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{0}, NewStdFee(5000, sdk.NewCoin("atom", 149)))
+	checkInvalidTx(t, anteHandler, checkCtx, tx, sdk.CodeInsufficientFee)
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{0}, NewStdFee(5000))
+	checkInvalidTx(t, anteHandler, checkCtx, tx, sdk.CodeInsufficientFee)
+
+	// without minimum gas prices the fee is accepted
+	checkValidTx(t, anteHandler, ctx, tx)
+
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{1}, NewStdFee(5000, sdk.NewCoin("atom", 150)))
+	checkValidTx(t, anteHandler, checkCtx, tx)
+}
+
+// Test threshold multisig accounts, gas is charged per sub-signature.
+func TestAnteHandlerMultisig(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// a 2 of 3 multisig account
+	priv1, _ := privAndAddr()
+	priv2, _ := privAndAddr()
+	priv3, _ := privAndAddr()
+	pubkey := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()})
+	keys := pubkey.(multisig.PubKeyMultisigThreshold).PubKeys
+	addr := sdk.AccAddress(pubkey.Address())
+
+	acc := mapper.NewAccountWithAddress(ctx, addr)
+	acc.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc)
+
+	msgs := []sdk.Msg{newTestMsg(addr)}
+	fee := newStdFee()
+	newMultisignature := func(seq int64, privs ...crypto.PrivKey) multisig.SignatureMultisig {
+		signBytes := StdSignBytes(ctx.ChainID(), 0, seq, fee, msgs, "")
+		multisignature := multisig.NewSignatureMultisig(len(keys))
+		for _, priv := range privs {
+			sig, err := priv.Sign(signBytes)
+			require.NoError(t, err)
+			require.NoError(t, multisignature.AddSignatureFromPubKey(sig, priv.PubKey(), keys))
+		}
+		return multisignature
+	}
+	newMultisigTx := func(seq int64, privs ...crypto.PrivKey) sdk.Tx {
+		sigs := []StdSignature{{PubKey: pubkey, Signature: newMultisignature(seq, privs...), AccountNumber: 0, Sequence: seq}}
+		return NewStdTx(msgs, fee, sigs, "")
+	}
+
+	// below the threshold
+	checkInvalidTx(t, anteHandler, ctx, newMultisigTx(0, priv1), sdk.CodeUnauthorized)
+
+	checkValidTx(t, anteHandler, ctx, newMultisigTx(0, priv1, priv3))
+	require.Equal(t, pubkey, mapper.GetAccount(ctx, addr).GetPubKey())
+	checkValidTx(t, anteHandler, ctx, newMultisigTx(1, priv1, priv2, priv3))
+
+	// a sub-signature of the wrong sequence
+	multisignature := newMultisignature(2, priv1)
+	sig, err := priv2.Sign(StdSignBytes(ctx.ChainID(), 0, 1, fee, msgs, ""))
+	require.NoError(t, err)
+	require.NoError(t, multisignature.AddSignatureFromPubKey(sig, priv2.PubKey(), keys))
+	tx := NewStdTx(msgs, fee, []StdSignature{{PubKey: pubkey, Signature: multisignature, AccountNumber: 0, Sequence: 2}}, "")
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+
+	// each sub-signature is charged
+	meter := sdk.NewInfiniteGasMeter()
+	consumeSignatureGas(meter, newMultisignature(2, priv1, priv2, priv3))
+	require.Equal(t, sdk.Gas(3*verifyCost), meter.GasConsumed())
+	meter = sdk.NewInfiniteGasMeter()
+	consumeSignatureGas(meter, sig)
+	require.Equal(t, sdk.Gas(verifyCost), meter.GasConsumed())
 }
 
 // Test logic around memo gas consumption.
@@ -567,3 +755,287 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 	acc2 = mapper.GetAccount(ctx, addr2)
 	require.Nil(t, acc2.GetPubKey())
 }
+
+// Test that simulations accept txs leaving out the signatures, and charge
+// the gas of the signatures.
+func TestAnteHandlerSimulate(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	priv2, _ := privAndAddr()
+	priv3, _ := privAndAddr()
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()})
+	multisigKeys := multisigKey.(multisig.PubKeyMultisigThreshold).PubKeys
+	multisigAddr := sdk.AccAddress(multisigKey.Address())
+
+	// set the accounts
+	for _, addr := range []sdk.AccAddress{addr1, multisigAddr} {
+		acc := mapper.NewAccountWithAddress(ctx, addr)
+		acc.SetCoins(newCoins())
+		mapper.SetAccount(ctx, acc)
+	}
+
+	fee := newStdFee()
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	multisigMsgs := []sdk.Msg{newTestMsg(multisigAddr)}
+	unsignedTx := func(pubKey crypto.PubKey, accNum, seq int64, msgs []sdk.Msg) StdTx {
+		sigs := []StdSignature{{PubKey: pubKey, AccountNumber: accNum, Sequence: seq}}
+		return NewStdTx(msgs, fee, sigs, "")
+	}
+	multisigTx := func(seq int64, privs ...crypto.PrivKey) StdTx {
+		signBytes := StdSignBytes(ctx.ChainID(), 1, seq, fee, multisigMsgs, "")
+		multisignature := multisig.NewSignatureMultisig(len(multisigKeys))
+		for _, priv := range privs {
+			sig, err := priv.Sign(signBytes)
+			require.NoError(t, err)
+			require.NoError(t, multisignature.AddSignatureFromPubKey(sig, priv.PubKey(), multisigKeys))
+		}
+		sigs := []StdSignature{{PubKey: multisigKey, Signature: multisignature, AccountNumber: 1, Sequence: seq}}
+		return NewStdTx(multisigMsgs, fee, sigs, "")
+	}
+	gasUsed := func(ctx sdk.Context, tx sdk.Tx) sdk.Gas {
+		newCtx, result, abort := anteHandler(ctx, tx)
+		require.False(t, abort)
+		require.True(t, result.IsOK(), result.Log)
+		return newCtx.GasMeter().GasConsumed()
+	}
+	simCtx := ctx.WithSimulate(true)
+
+	// the signature is required outside of simulations
+	checkInvalidTx(t, anteHandler, ctx, unsignedTx(priv1.PubKey(), 0, 0, msgs), sdk.CodeUnauthorized)
+
+	// simulations charge the gas of the signature they leave out, the first
+	// simulation sets the pubkey
+	checkValidTx(t, anteHandler, simCtx, unsignedTx(priv1.PubKey(), 0, 0, msgs))
+	simulated := gasUsed(simCtx, unsignedTx(priv1.PubKey(), 0, 1, msgs))
+	signed := gasUsed(ctx, newTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{2}, fee))
+	require.Equal(t, signed, simulated)
+
+	// multisig pubkeys are charged for the threshold of signatures
+	checkValidTx(t, anteHandler, simCtx, unsignedTx(multisigKey, 1, 0, multisigMsgs))
+	simulated = gasUsed(simCtx, unsignedTx(multisigKey, 1, 1, multisigMsgs))
+	signed = gasUsed(ctx, multisigTx(2, priv1, priv3))
+	require.Equal(t, signed, simulated)
+
+	// invalid signatures are still rejected
+	tx := newTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []int64{0}, []int64{3}, fee).(StdTx)
+	tx.Signatures[0].PubKey = priv1.PubKey()
+	checkInvalidTx(t, anteHandler, simCtx, tx, sdk.CodeUnauthorized)
+
+	// multisig pubkeys whose threshold is zero or greater than their number
+	// of pubkeys are rejected, before their gas is simulated
+	for _, invalidKey := range []multisig.PubKeyMultisigThreshold{{0, multisigKeys}, {4, multisigKeys}} {
+		invalidAddr := sdk.AccAddress(invalidKey.Address())
+		acc := mapper.NewAccountWithAddress(ctx, invalidAddr)
+		acc.SetCoins(newCoins())
+		mapper.SetAccount(ctx, acc)
+		invalidMsgs := []sdk.Msg{newTestMsg(invalidAddr)}
+		checkInvalidTx(t, anteHandler, simCtx, unsignedTx(invalidKey, acc.GetAccountNumber(), 0, invalidMsgs), sdk.CodeInvalidPubKey)
+		tx := unsignedTx(invalidKey, acc.GetAccountNumber(), 0, invalidMsgs)
+		tx.Signatures[0].Signature = multisig.NewSignatureMultisig(len(multisigKeys))
+		checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeInvalidPubKey)
+	}
+}
+
+func newSignModeTestTx(cdc *wire.Codec, ctx sdk.Context, msgs []sdk.Msg, priv crypto.PrivKey, accNum, seq int64, fee StdFee, mode sdk.SignMode) StdTx {
+	signBytes, err := StdSignMsg{
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      seq,
+		Fee:           fee,
+		Msgs:          msgs,
+	}.SignBytes(cdc, mode)
+	if err != nil {
+		panic(err)
+	}
+	sig, err := priv.Sign(signBytes)
+	if err != nil {
+		panic(err)
+	}
+	sigs := []StdSignature{{PubKey: priv.PubKey(), Signature: sig, AccountNumber: accNum, Sequence: seq, SignMode: mode}}
+	return NewStdTx(msgs, fee, sigs, "")
+}
+
+// Test the signatures made in each sign mode.
+func TestAnteHandlerSignModes(t *testing.T) {
+	// setup
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	cdc.RegisterInterface((*sdk.Msg)(nil), nil)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/TestMsg", nil)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	fee := newStdFee()
+
+	// each sign mode is accepted
+	tx := newSignModeTestTx(cdc, ctx, msgs, priv1, 0, 0, fee, sdk.SignModeJSON)
+	checkValidTx(t, anteHandler, ctx, tx)
+	tx = newSignModeTestTx(cdc, ctx, msgs, priv1, 0, 1, fee, sdk.SignModeBinary)
+	checkValidTx(t, anteHandler, ctx, tx)
+	tx = newSignModeTestTx(cdc, ctx, msgs, priv1, 0, 2, fee, sdk.SignModeTextual)
+	checkValidTx(t, anteHandler, ctx, tx)
+
+	// a signature is only valid in the mode it was made in
+	tx = newSignModeTestTx(cdc, ctx, msgs, priv1, 0, 3, fee, sdk.SignModeTextual)
+	tx.Signatures[0].SignMode = sdk.SignModeJSON
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+
+	// unknown sign modes are rejected
+	tx = newSignModeTestTx(cdc, ctx, msgs, priv1, 0, 3, fee, sdk.SignModeJSON)
+	tx.Signatures[0].SignMode = sdk.SignMode(0xff)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+}
+
+func newUnorderedTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, timeoutHeight, nonce int64, fee StdFee) StdTx {
+	sigs := make([]StdSignature, len(privs))
+	for i, priv := range privs {
+		signBytes := UnorderedStdSignBytes(ctx.ChainID(), accNums[i], timeoutHeight, nonce, fee, msgs, "")
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		sigs[i] = StdSignature{PubKey: priv.PubKey(), Signature: sig, AccountNumber: accNums[i]}
+	}
+	tx := NewStdTx(msgs, fee, sigs, "")
+	tx.TimeoutHeight = timeoutHeight
+	tx.Nonce = nonce
+	return tx
+}
+
+func TestAnteHandlerUnordered(t *testing.T) {
+	// setup
+	ms, capKey, capKey2, capKey3 := setupReplayMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	rk := NewReplayKeeper(cdc, capKey3)
+	anteHandler := NewReplayAnteHandler(mapper, feeCollector, nil, rk, nil)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid", Height: 10}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums := []crypto.PrivKey{priv1}, []int64{0}
+	fee := newStdFee()
+	msgs := []sdk.Msg{msg}
+
+	// the account didn't opt into unordered txs
+	tx = newUnorderedTestTx(ctx, msgs, privs, accnums, 20, 1, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+
+	// unordered txs are not supported by the default AnteHandler
+	rk.SetUnordered(ctx, addr1, true)
+	checkInvalidTx(t, NewAnteHandler(mapper, feeCollector), ctx, tx, sdk.CodeUnauthorized)
+
+	// the sequence isn't used
+	checkValidTx(t, anteHandler, ctx, tx)
+	require.Equal(t, int64(0), mapper.GetAccount(ctx, addr1).GetSequence())
+
+	// replays are rejected, even with different signatures
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeInvalidSequence)
+	replay := tx.(StdTx)
+	replay.Signatures = []StdSignature{{Signature: replay.Signatures[0].Signature}}
+	checkInvalidTx(t, anteHandler, ctx, replay, sdk.CodeInvalidSequence)
+
+	// another nonce makes another tx, sequential txs are still accepted
+	tx = newUnorderedTestTx(ctx, msgs, privs, accnums, 20, 2, fee)
+	checkValidTx(t, anteHandler, ctx, tx)
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{0}, fee)
+	checkValidTx(t, anteHandler, ctx, tx)
+
+	// timed out or too far in the future
+	tx = newUnorderedTestTx(ctx, msgs, privs, accnums, 9, 3, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeInvalidSequence)
+	tx = newUnorderedTestTx(ctx, msgs, privs, accnums, 10+MaxTimeoutHeightDelta+1, 3, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeInvalidSequence)
+
+	// the sign bytes include the timeout height
+	tx = newUnorderedTestTx(ctx, msgs, privs, accnums, 20, 4, fee)
+	badTx := tx.(StdTx)
+	badTx.TimeoutHeight = 30
+	checkInvalidTx(t, anteHandler, ctx, badTx, sdk.CodeUnauthorized)
+}
+
+// accepts the coins of a single denomination
+type denomValidator string
+
+func (denom denomValidator) ValidateDenom(ctx sdk.Context, d string) sdk.Error {
+	if d != string(denom) {
+		return sdk.ErrInvalidCoins(d)
+	}
+	return nil
+}
+
+func (denom denomValidator) ValidateCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		if coin.Denom != string(denom) {
+			return sdk.ErrInvalidCoins(coins.String())
+		}
+	}
+	return nil
+}
+
+func TestAnteHandlerFeeDenoms(t *testing.T) {
+	// setup
+	ms, capKey, capKey2, capKey3 := setupReplayMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	rk := NewReplayKeeper(cdc, capKey3)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	priv1, addr1 := privAndAddr()
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	privs, accnums := []crypto.PrivKey{priv1}, []int64{0}
+
+	// the fee denomination doesn't match the format
+	tx := newTestTx(ctx, msgs, privs, accnums, []int64{0}, newStdFee())
+	checkInvalidTx(t, NewReplayAnteHandler(mapper, feeCollector, nil, rk, denomValidator("btc")), ctx, tx, sdk.CodeInvalidCoins)
+
+	// txs without fees aren't checked
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{0}, NewStdFee(5000))
+	checkValidTx(t, NewReplayAnteHandler(mapper, feeCollector, nil, rk, denomValidator("btc")), ctx, tx)
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{1}, newStdFee())
+	checkValidTx(t, NewReplayAnteHandler(mapper, feeCollector, nil, rk, denomValidator("atom")), ctx, tx)
+
+	// a minimum gas price in a denomination which doesn't match the format
+	// can't be paid
+	checkCtx := ctx.WithMinimumGasPrices(sdk.GasPrices{{"btc", sdk.NewRat(1, 1000)}})
+	tx = newTestTx(ctx, msgs, privs, accnums, []int64{2}, newStdFee())
+	checkInvalidTx(t, NewReplayAnteHandler(mapper, feeCollector, nil, rk, denomValidator("atom")), checkCtx, tx, sdk.CodeInvalidCoins)
+}
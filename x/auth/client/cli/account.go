@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
+const flagPubKey = "pubkey"
+
 // GetAccountCmd for the auth.BaseAccount type
 func GetAccountCmdDefault(storeName string, cdc *wire.Codec) *cobra.Command {
 	return GetAccountCmd(storeName, cdc, GetAccountDecoder(cdc))
@@ -30,13 +33,22 @@ func GetAccountDecoder(cdc *wire.Codec) auth.AccountDecoder {
 }
 
 // GetAccountCmd returns a query account that will display the
-// state of the account at a given address
+// state of the account at a given address, or with a given pubkey
 func GetAccountCmd(storeName string, cdc *wire.Codec, decoder auth.AccountDecoder) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "account [address]",
 		Short: "Query account balance",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if pubKey := viper.GetString(flagPubKey); pubKey != "" {
+				if len(args) != 0 {
+					return errors.New("specify either an address or a pubkey")
+				}
+				return queryAccountByPubKey(pubKey, cdc)
+			}
+			if len(args) != 1 {
+				return errors.New("an address or a pubkey is required")
+			}
 
 			// find the key to look up the account
 			addr := args[0]
@@ -74,4 +86,36 @@ func GetAccountCmd(storeName string, cdc *wire.Codec, decoder auth.AccountDecode
 			return nil
 		},
 	}
+	cmd.Flags().String(flagPubKey, "", "Bech32 pubkey of the account to query instead of its address")
+	return cmd
+}
+
+// query the account with the pubkey through the account index of the node
+func queryAccountByPubKey(bech32PubKey string, cdc *wire.Codec) error {
+	pubKey, err := sdk.GetAccPubKeyBech32(bech32PubKey)
+	if err != nil {
+		return err
+	}
+	params, err := cdc.MarshalJSON(auth.QueryPubKeyParams{PubKey: pubKey})
+	if err != nil {
+		return err
+	}
+
+	ctx := context.NewCoreContextFromViper()
+	res, err := ctx.QueryWithData(fmt.Sprintf("/custom/auth/%s", auth.QueryAccountByPubKey), params)
+	if err != nil {
+		return err
+	}
+
+	var account auth.Account
+	err = cdc.UnmarshalJSON(res, &account)
+	if err != nil {
+		return err
+	}
+	output, err := wire.MarshalJSONIndent(cdc, account)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
 }
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// GetBroadcastCommand returns the broadcast command, which broadcasts a
+// signed transaction read from a JSON file
+func GetBroadcastCommand(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast <file>",
+		Short: "Broadcast transactions signed offline",
+		Long: `Broadcast a transaction signed with the sign or multisign
+commands to the node.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := readStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			txBytes, err := cdc.MarshalBinary(stdTx)
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper()
+			return ctx.BroadcastAndPrint(txBytes, cdc)
+		},
+	}
+	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().Bool(client.FlagAsync, false, "broadcast transactions asynchronously")
+	cmd.Flags().Bool(client.FlagJson, false, "return output in json format")
+	cmd.Flags().Bool(client.FlagPrintResponse, false, "return tx response (only works with async = false)")
+	return cmd
+}
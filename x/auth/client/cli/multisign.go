@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GetMultiSignCommand returns the multisign command, which combines the
+// signatures produced by `sign --multisig` into a signature of the multisig key
+func GetMultiSignCommand(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign <file> <multisig-name> <signature>...",
+		Short: "Combine the signatures of a multisig key",
+		Long: `Combine the signature files generated with sign --multisig=<multisig-name>
+into a single signature of the multisig key, append it to the transaction
+and print the result to STDOUT.`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := readStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			kb, err := keys.GetKeyBase()
+			if err != nil {
+				return err
+			}
+			multisigInfo, err := kb.Get(args[1])
+			if err != nil {
+				return err
+			}
+			multisigPub, ok := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
+			if !ok {
+				return errors.Errorf("%s is not a multisig key", args[1])
+			}
+
+			ctx := context.NewCoreContextFromViper()
+			if ctx.ChainID == "" {
+				return errors.New("chain ID required but not specified")
+			}
+
+			var accnum, sequence int64
+			var mode sdk.SignMode
+			multisigSig := multisig.NewSignatureMultisig(len(multisigPub.PubKeys))
+			for i, filename := range args[2:] {
+				sig, err := readStdSignatureFromFile(cdc, filename)
+				if err != nil {
+					return err
+				}
+				if i == 0 {
+					accnum, sequence, mode = sig.AccountNumber, sig.Sequence, sig.SignMode
+				} else if sig.AccountNumber != accnum || sig.Sequence != sequence || sig.SignMode != mode {
+					return errors.Errorf("signature %s was made with a different account number, sequence or sign mode", filename)
+				}
+
+				signBytes, err := auth.StdSignMsg{
+					ChainID:       ctx.ChainID,
+					AccountNumber: accnum,
+					Sequence:      sequence,
+					Fee:           stdTx.Fee,
+					Msgs:          stdTx.GetMsgs(),
+					Memo:          stdTx.GetMemo(),
+					TimeoutHeight: stdTx.TimeoutHeight,
+					Nonce:         stdTx.Nonce,
+				}.SignBytes(cdc, mode)
+				if err != nil {
+					return err
+				}
+				if !sig.PubKey.VerifyBytes(signBytes, sig.Signature) {
+					return errors.Errorf("signature %s is invalid", filename)
+				}
+				if err = multisigSig.AddSignatureFromPubKey(sig.Signature, sig.PubKey, multisigPub.PubKeys); err != nil {
+					return err
+				}
+			}
+
+			stdTx.Signatures = append(stdTx.Signatures, auth.StdSignature{
+				PubKey:        multisigPub,
+				Signature:     multisigSig,
+				AccountNumber: accnum,
+				Sequence:      sequence,
+				SignMode:      mode,
+			})
+			json, err := wire.MarshalJSONIndent(cdc, stdTx)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(json))
+			return nil
+		},
+	}
+	cmd.Flags().String(client.FlagChainID, "", "Chain ID of tendermint node")
+	return cmd
+}
+
+// read and decode a JSON encoded StdSignature from a file
+func readStdSignatureFromFile(cdc *wire.Codec, filename string) (sig auth.StdSignature, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	err = cdc.UnmarshalJSON(bz, &sig)
+	return
+}
@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+const (
+	flagMultisig = "multisig"
+	flagOffline  = "offline"
+)
+
+// GetSignCommand returns the sign command, which signs a transaction
+// generated with --generate-only and writes the result to STDOUT
+func GetSignCommand(cdc *wire.Codec, decoder auth.AccountDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign <file>",
+		Short: "Sign transactions generated offline",
+		Long: `Sign a transaction created with the --generate-only flag.
+The signed transaction is printed to STDOUT. With --multisig=<name> only the
+signature is printed, it must then be combined with the signatures of the other
+parties of the multisig key with the multisign command.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := readStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper().WithDecoder(decoder)
+			name := ctx.FromAddressName
+			if name == "" {
+				return errors.New("must provide the name of the signing key with --from")
+			}
+
+			// the account signing is the multisig account when signing on its behalf
+			signerName := name
+			multisigName := viper.GetString(flagMultisig)
+			if multisigName != "" {
+				signerName = multisigName
+			}
+			ctx, err = ensureSignerInfo(ctx.WithFromAddressName(signerName))
+			if err != nil {
+				return err
+			}
+
+			signMsg := auth.StdSignMsg{
+				ChainID:       ctx.ChainID,
+				AccountNumber: ctx.AccountNumber,
+				Sequence:      ctx.Sequence,
+				Fee:           stdTx.Fee,
+				Msgs:          stdTx.GetMsgs(),
+				Memo:          stdTx.GetMemo(),
+				TimeoutHeight: stdTx.TimeoutHeight,
+				Nonce:         stdTx.Nonce,
+			}
+			if signMsg.ChainID == "" {
+				return errors.New("chain ID required but not specified")
+			}
+
+			if multisigName != "" {
+				if err = ensureMultisigMember(multisigName, name); err != nil {
+					return err
+				}
+			}
+
+			passphrase, err := ctx.GetPassphraseFromStdin(name)
+			if err != nil {
+				return err
+			}
+			mode, err := sdk.ParseSignMode(viper.GetString(client.FlagSignMode))
+			if err != nil {
+				return err
+			}
+			sig, err := context.MakeSignature(name, passphrase, signMsg, mode, cdc)
+			if err != nil {
+				return err
+			}
+
+			var json []byte
+			if multisigName != "" {
+				json, err = wire.MarshalJSONIndent(cdc, sig)
+			} else {
+				stdTx.Signatures = append(stdTx.Signatures, sig)
+				json, err = wire.MarshalJSONIndent(cdc, stdTx)
+			}
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(json))
+			return nil
+		},
+	}
+	cmd.Flags().String(client.FlagFrom, "", "Name of private key with which to sign")
+	cmd.Flags().String(client.FlagName, "", "DEPRECATED - Name of private key with which to sign")
+	cmd.Flags().Int64(client.FlagAccountNumber, 0, "AccountNumber number to sign the tx")
+	cmd.Flags().Int64(client.FlagSequence, 0, "Sequence number to sign the tx")
+	cmd.Flags().String(client.FlagChainID, "", "Chain ID of tendermint node")
+	cmd.Flags().String(client.FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
+	cmd.Flags().Bool(client.FlagTrustNode, true, "Don't verify proofs for responses")
+	cmd.Flags().String(client.FlagSignMode, "json", "Encoding of the signed transaction: json, binary or textual")
+	cmd.Flags().String(flagMultisig, "", "Name of the multisig key on behalf of which the signature is generated")
+	cmd.Flags().Bool(flagOffline, false, "Don't query the account number and sequence, they must be provided by flags")
+	return cmd
+}
+
+// fill in the account number and sequence of the signing account if they were
+// not provided by flags
+func ensureSignerInfo(ctx context.CoreContext) (context.CoreContext, error) {
+	// Should be viper.IsSet, but this does not work - https://github.com/spf13/viper/pull/331
+	if viper.GetBool(flagOffline) ||
+		(viper.GetInt64(client.FlagAccountNumber) != 0 && viper.GetInt64(client.FlagSequence) != 0) {
+		return ctx, nil
+	}
+
+	from, err := ctx.GetFromAddress()
+	if err != nil {
+		return ctx, err
+	}
+	if viper.GetInt64(client.FlagAccountNumber) == 0 {
+		accnum, err := ctx.GetAccountNumber(from)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = ctx.WithAccountNumber(accnum)
+	}
+	if viper.GetInt64(client.FlagSequence) == 0 {
+		seq, err := ctx.NextSequence(from)
+		if err != nil {
+			return ctx, err
+		}
+		ctx = ctx.WithSequence(seq)
+	}
+	return ctx, nil
+}
+
+// ensure that the named key is one of the keys of the named multisig key
+func ensureMultisigMember(multisigName, name string) error {
+	kb, err := keys.GetKeyBase()
+	if err != nil {
+		return err
+	}
+	multisigInfo, err := kb.Get(multisigName)
+	if err != nil {
+		return err
+	}
+	multisigPub, ok := multisigInfo.GetPubKey().(multisig.PubKeyMultisigThreshold)
+	if !ok {
+		return errors.Errorf("%s is not a multisig key", multisigName)
+	}
+	info, err := kb.Get(name)
+	if err != nil {
+		return err
+	}
+	for _, pk := range multisigPub.PubKeys {
+		if pk.Equals(info.GetPubKey()) {
+			return nil
+		}
+	}
+	return errors.Errorf("%s is not a key of the multisig key %s", name, multisigName)
+}
+
+// read and decode a JSON encoded StdTx from a file
+func readStdTxFromFile(cdc *wire.Codec, filename string) (stdTx auth.StdTx, err error) {
+	bz, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return
+	}
+	err = cdc.UnmarshalJSON(bz, &stdTx)
+	return
+}
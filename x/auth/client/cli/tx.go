@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// GetSetUnorderedCmd returns the command to set whether the account accepts
+// unordered txs
+func GetSetUnorderedCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-unordered [true|false]",
+		Short: "Set whether your account accepts unordered transactions",
+		Long: `Set whether your account accepts unordered transactions. Instead of the
+sequence of the account they are protected from replays by a unique nonce until
+their --timeout-height, so that they can be submitted in parallel and a dropped
+transaction doesn't block the following ones.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(GetAccountDecoder(cdc))
+
+			unordered, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+			addr, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgSetUnordered(addr, unordered)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// GetChangePubKeyCmd returns the command to replace the pubkey of the account
+func GetChangePubKeyCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-pubkey [new-key-name]",
+		Short: "Replace the pubkey of your account with the one of another key",
+		Long: `Replace the pubkey of your account with the one of another key, keeping its
+address, coins and delegations. The transaction is signed with the current key,
+the following ones must be signed with the new key. The pubkey can't be changed
+again for a day.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(GetAccountDecoder(cdc))
+
+			kb, err := keys.GetKeyBase()
+			if err != nil {
+				return err
+			}
+			info, err := kb.Get(args[0])
+			if err != nil {
+				return err
+			}
+			addr, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := auth.NewMsgChangePubKey(addr, info.GetPubKey())
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
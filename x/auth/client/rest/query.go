@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/gorilla/mux"
 
@@ -15,6 +16,10 @@ import (
 
 // register REST routes
 func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, storeName string) {
+	r.HandleFunc(
+		"/accounts",
+		QueryAccountsRequestHandlerFn(cdc, ctx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/accounts/{address}",
 		QueryAccountRequestHandlerFn(storeName, cdc, authcmd.GetAccountDecoder(cdc), ctx),
@@ -66,3 +71,68 @@ func QueryAccountRequestHandlerFn(storeName string, cdc *wire.Codec, decoder aut
 		w.Write(output)
 	}
 }
+
+// QueryAccountsRequestHandlerFn returns the REST handler querying the
+// accounts through the account indexes of the node, the account with the
+// "pubkey" query param if set, else the page "page" of the accounts in order
+// of account number with at most "limit" accounts
+func QueryAccountsRequestHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var endpoint string
+		var params interface{}
+		if bech32PubKey := r.URL.Query().Get("pubkey"); bech32PubKey != "" {
+			pubKey, err := sdk.GetAccPubKeyBech32(bech32PubKey)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			endpoint = auth.QueryAccountByPubKey
+			params = auth.QueryPubKeyParams{PubKey: pubKey}
+		} else {
+			page, err := parseIntParam(r, "page", 1)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			limit, err := parseIntParam(r, "limit", 0)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(err.Error()))
+				return
+			}
+			endpoint = auth.QueryAccounts
+			params = auth.QueryAccountsParams{Page: page, Limit: limit}
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		res, err := ctx.QueryWithData(fmt.Sprintf("/custom/auth/%s", endpoint), bz)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query accounts. Error: %s", err.Error())))
+			return
+		}
+
+		// the querier returns the accounts JSON encoded
+		w.Write(res)
+	}
+}
+
+// parse the integer query param, the default if it isn't set
+func parseIntParam(r *http.Request, name string, defaultValue int) (int, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't parse %s: %s", name, err.Error())
+	}
+	return n, nil
+}
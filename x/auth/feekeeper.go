@@ -18,6 +18,9 @@ type FeeCollectionKeeper struct {
 
 	// The wire codec for binary encoding/decoding of accounts.
 	cdc *wire.Codec
+
+	// The mapper of the fee collector module account holding the fees, if set.
+	am *AccountMapper
 }
 
 // NewFeeKeeper returns a new FeeKeeper
@@ -28,8 +31,24 @@ func NewFeeCollectionKeeper(cdc *wire.Codec, key sdk.StoreKey) FeeCollectionKeep
 	}
 }
 
+// WithModuleAccount returns the FeeCollectionKeeper holding the collected
+// fees on the fee collector module account instead of in its store
+func (fck FeeCollectionKeeper) WithModuleAccount(am AccountMapper) FeeCollectionKeeper {
+	fck.am = &am
+	return fck
+}
+
 // Adds to Collected Fee Pool
 func (fck FeeCollectionKeeper) GetCollectedFees(ctx sdk.Context) sdk.Coins {
+	if fck.am != nil {
+		// read the account without creating it
+		acc := fck.am.GetAccount(ctx, NewModuleAddress(FeeCollectorName))
+		if acc == nil || acc.GetCoins() == nil {
+			return sdk.Coins{}
+		}
+		return acc.GetCoins()
+	}
+
 	store := ctx.KVStore(fck.key)
 	bz := store.Get(collectedFeesKey)
 	if bz == nil {
@@ -43,13 +62,20 @@ func (fck FeeCollectionKeeper) GetCollectedFees(ctx sdk.Context) sdk.Coins {
 
 // Sets to Collected Fee Pool
 func (fck FeeCollectionKeeper) setCollectedFees(ctx sdk.Context, coins sdk.Coins) {
+	if fck.am != nil {
+		macc := fck.am.GetModuleAccount(ctx, FeeCollectorName)
+		macc.Coins = coins
+		fck.am.SetAccount(ctx, macc)
+		return
+	}
+
 	bz := fck.cdc.MustMarshalBinary(coins)
 	store := ctx.KVStore(fck.key)
 	store.Set(collectedFeesKey, bz)
 }
 
 // Adds to Collected Fee Pool
-func (fck FeeCollectionKeeper) addCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
+func (fck FeeCollectionKeeper) AddCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
 	newCoins := fck.GetCollectedFees(ctx).Plus(coins)
 	fck.setCollectedFees(ctx, newCoins)
 
@@ -49,11 +49,11 @@ func TestFeeCollectionKeeperAdd(t *testing.T) {
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
 
 	// add oneCoin and check that pool is now oneCoin
-	fck.addCollectedFees(ctx, oneCoin)
+	fck.AddCollectedFees(ctx, oneCoin)
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(oneCoin))
 
 	// add oneCoin again and check that pool is now twoCoins
-	fck.addCollectedFees(ctx, oneCoin)
+	fck.AddCollectedFees(ctx, oneCoin)
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(twoCoins))
 }
 
@@ -73,3 +73,25 @@ func TestFeeCollectionKeeperClear(t *testing.T) {
 	fck.ClearCollectedFees(ctx)
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
 }
+
+func TestFeeCollectionKeeperModuleAccount(t *testing.T) {
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	// make context and keeper
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	am := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	fck := NewFeeCollectionKeeper(cdc, capKey2).WithModuleAccount(am)
+	feeCollector := NewModuleAddress(FeeCollectorName)
+
+	// reading the fees doesn't create the fee collector
+	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
+	require.Nil(t, am.GetAccount(ctx, feeCollector))
+
+	// the fees are held by the fee collector
+	fck.AddCollectedFees(ctx, oneCoin)
+	require.True(t, fck.GetCollectedFees(ctx).IsEqual(oneCoin))
+	require.True(t, am.GetAccount(ctx, feeCollector).GetCoins().IsEqual(oneCoin))
+}
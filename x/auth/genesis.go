@@ -0,0 +1,86 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the accounts which opted into unordered txs and the times
+// the pubkeys of the accounts were last changed at genesis, the accounts
+// themselves are set by the application
+type GenesisState struct {
+	UnorderedAccounts []sdk.AccAddress   `json:"unordered_accounts"`
+	PubKeyChangeTimes []PubKeyChangeTime `json:"pubkey_change_times"`
+}
+
+// PubKeyChangeTime - the block time the pubkey of the account was last
+// changed at
+type PubKeyChangeTime struct {
+	Address sdk.AccAddress `json:"address"`
+	Time    int64          `json:"time"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis checks the addresses are set and not duplicated
+func ValidateGenesis(data GenesisState) error {
+	unordered := make(map[string]bool, len(data.UnorderedAccounts))
+	for _, addr := range data.UnorderedAccounts {
+		if len(addr) == 0 || unordered[string(addr)] {
+			return fmt.Errorf("invalid or duplicate unordered account %s", addr)
+		}
+		unordered[string(addr)] = true
+	}
+	changed := make(map[string]bool, len(data.PubKeyChangeTimes))
+	for _, change := range data.PubKeyChangeTimes {
+		if len(change.Address) == 0 || changed[string(change.Address)] {
+			return fmt.Errorf("invalid or duplicate pubkey change time of account %s", change.Address)
+		}
+		changed[string(change.Address)] = true
+	}
+	return nil
+}
+
+// InitGenesis - set the accounts which accept unordered txs and the pubkey
+// change times
+func InitGenesis(ctx sdk.Context, am AccountMapper, rk ReplayKeeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	for _, addr := range data.UnorderedAccounts {
+		rk.SetUnordered(ctx, addr, true)
+	}
+	for _, change := range data.PubKeyChangeTimes {
+		am.setPubKeyChangeTime(ctx, change.Address, change.Time)
+	}
+	return nil
+}
+
+// WriteGenesis - output the accounts which accept unordered txs and the
+// pubkey change times
+func WriteGenesis(ctx sdk.Context, am AccountMapper, rk ReplayKeeper) GenesisState {
+	var unordered []sdk.AccAddress
+	rk.IterateUnorderedAccounts(ctx, func(addr sdk.AccAddress) bool {
+		unordered = append(unordered, addr)
+		return false
+	})
+
+	var changes []PubKeyChangeTime
+	prefix := []byte("pubKeyChangeTime:")
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(am.key), prefix)
+	for ; iter.Valid(); iter.Next() {
+		change := PubKeyChangeTime{Address: sdk.AccAddress(iter.Key()[len(prefix):])}
+		am.cdc.MustUnmarshalBinary(iter.Value(), &change.Time)
+		changes = append(changes, change)
+	}
+	iter.Close()
+
+	return GenesisState{
+		UnorderedAccounts: unordered,
+		PubKeyChangeTimes: changes,
+	}
+}
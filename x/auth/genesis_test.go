@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	ms, capKey, _, capKey3 := setupReplayMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	rk := NewReplayKeeper(cdc, capKey3)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	rk.SetUnordered(ctx, addr1, true)
+	mapper.setPubKeyChangeTime(ctx, addr2, 1000)
+
+	genesis := WriteGenesis(ctx, mapper, rk)
+	require.Nil(t, ValidateGenesis(genesis))
+	require.Equal(t, []sdk.AccAddress{addr1}, genesis.UnorderedAccounts)
+	require.Equal(t, []PubKeyChangeTime{{addr2, 1000}}, genesis.PubKeyChangeTimes)
+
+	// the same state is exported on a new chain
+	ms2, capKey, _, capKey3 := setupReplayMultiStore()
+	mapper2 := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	rk2 := NewReplayKeeper(cdc, capKey3)
+	ctx2 := sdk.NewContext(ms2, abci.Header{}, false, log.NewNopLogger())
+	require.Nil(t, InitGenesis(ctx2, mapper2, rk2, genesis))
+	require.Equal(t, genesis, WriteGenesis(ctx2, mapper2, rk2))
+	require.True(t, rk2.IsUnordered(ctx2, addr1))
+
+	// the addresses can't be duplicated
+	genesis.UnorderedAccounts = append(genesis.UnorderedAccounts, addr1)
+	require.NotNil(t, ValidateGenesis(genesis))
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// PubKeyChangeCooldown is the time in seconds after a pubkey change
+	// before the pubkey of the account may be changed again
+	PubKeyChangeCooldown int64 = 60 * 60 * 24
+
+	changePubKeyCost sdk.Gas = 10000
+)
+
+// NewHandler returns a handler for "auth" type messages
+func NewHandler(am AccountMapper, rk ReplayKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSetUnordered:
+			return handleMsgSetUnordered(ctx, msg, rk)
+		case MsgChangePubKey:
+			return handleMsgChangePubKey(ctx, msg, am)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in auth module").Result()
+		}
+	}
+}
+
+func handleMsgSetUnordered(ctx sdk.Context, msg MsgSetUnordered, rk ReplayKeeper) sdk.Result {
+	rk.SetUnordered(ctx, msg.Address, msg.Unordered)
+
+	tags := sdk.NewTags(
+		"action", []byte("setUnordered"),
+		"address", []byte(msg.Address.String()),
+		"unordered", []byte(strconv.FormatBool(msg.Unordered)),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgChangePubKey(ctx sdk.Context, msg MsgChangePubKey, am AccountMapper) sdk.Result {
+	ctx.GasMeter().ConsumeGas(changePubKeyCost, "change pubkey")
+
+	acc := am.GetAccount(ctx, msg.Address)
+	if acc == nil {
+		return sdk.ErrUnknownAddress(msg.Address.String()).Result()
+	}
+	if acc.GetPubKey() != nil && bytes.Equal(acc.GetPubKey().Bytes(), msg.PubKey.Bytes()) {
+		return sdk.ErrInvalidPubKey("the new pubkey is the current pubkey").Result()
+	}
+
+	// the index can only hold one account per pubkey
+	if other := am.GetAccountByPubKey(ctx, msg.PubKey); other != nil {
+		return sdk.ErrInvalidPubKey(fmt.Sprintf("the pubkey is the pubkey of account %s", other.GetAddress())).Result()
+	}
+
+	blockTime := ctx.BlockHeader().Time
+	if changeTime, found := am.GetPubKeyChangeTime(ctx, msg.Address); found && blockTime < changeTime+PubKeyChangeCooldown {
+		return sdk.ErrUnauthorized(fmt.Sprintf(
+			"the pubkey was changed at %d, it can't be changed again before %d", changeTime, changeTime+PubKeyChangeCooldown)).Result()
+	}
+
+	oldPubKey := acc.GetPubKey()
+	err := acc.SetPubKey(msg.PubKey)
+	if err != nil {
+		return sdk.ErrInternal("setting PubKey on account").Result()
+	}
+	am.SetAccount(ctx, acc)
+	am.setPubKeyChangeTime(ctx, msg.Address, blockTime)
+	if oldPubKey != nil {
+		am.deletePubKeyIndex(ctx, oldPubKey)
+	}
+	am.setPubKeyIndex(ctx, msg.PubKey, msg.Address)
+
+	pubKey, err := sdk.Bech32ifyAccPub(msg.PubKey)
+	if err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	tags := sdk.NewTags(
+		"action", []byte("changePubKey"),
+		"address", []byte(msg.Address.String()),
+		"pubkey", []byte(pubKey),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
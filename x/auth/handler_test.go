@@ -0,0 +1,94 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestHandlerChangePubKey(t *testing.T) {
+	// setup
+	ms, capKey, capKey2, capKey3 := setupReplayMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	handler := NewHandler(mapper, NewReplayKeeper(cdc, capKey3))
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid", Time: 1000}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	priv2 := crypto.GenPrivKeyEd25519()
+	priv3 := crypto.GenPrivKeyEd25519()
+
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	acc1.SetPubKey(priv1.PubKey())
+	mapper.SetAccount(ctx, acc1)
+
+	// the new pubkey must differ from the current one
+	res := handler(ctx, NewMsgChangePubKey(addr1, priv1.PubKey()))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidPubKey), res.Code)
+
+	// the address and coins are kept
+	res = handler(ctx, NewMsgChangePubKey(addr1, priv2.PubKey()))
+	require.True(t, res.IsOK())
+	acc := mapper.GetAccount(ctx, addr1)
+	require.Equal(t, priv2.PubKey(), acc.GetPubKey())
+	require.True(t, acc.GetCoins().IsEqual(newCoins()))
+	changeTime, found := mapper.GetPubKeyChangeTime(ctx, addr1)
+	require.True(t, found)
+	require.Equal(t, int64(1000), changeTime)
+
+	// txs must be signed with the new key
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	tx := newTestTx(ctx, msgs, []crypto.PrivKey{priv1}, []int64{0}, []int64{0}, newStdFee())
+	checkInvalidTx(t, anteHandler, ctx, tx, sdk.CodeUnauthorized)
+	tx = newTestTx(ctx, msgs, []crypto.PrivKey{priv2}, []int64{0}, []int64{0}, newStdFee())
+	checkValidTx(t, anteHandler, ctx, tx)
+
+	// the pubkey can't be changed again before the cooldown
+	res = handler(ctx.WithBlockHeader(abci.Header{Time: 1000 + PubKeyChangeCooldown - 1}), NewMsgChangePubKey(addr1, priv3.PubKey()))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnauthorized), res.Code)
+	res = handler(ctx.WithBlockHeader(abci.Header{Time: 1000 + PubKeyChangeCooldown}), NewMsgChangePubKey(addr1, priv3.PubKey()))
+	require.True(t, res.IsOK())
+	require.Equal(t, priv3.PubKey(), mapper.GetAccount(ctx, addr1).GetPubKey())
+}
+
+func TestHandlerChangePubKeyIndex(t *testing.T) {
+	// setup
+	ms, capKey, _, capKey3 := setupReplayMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount).WithIndexes()
+	handler := NewHandler(mapper, NewReplayKeeper(cdc, capKey3))
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid", Time: 1000}, false, log.NewNopLogger())
+
+	priv1, addr1 := privAndAddr()
+	priv2, addr2 := privAndAddr()
+	priv3 := crypto.GenPrivKeyEd25519()
+
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetPubKey(priv1.PubKey())
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetPubKey(priv2.PubKey())
+	mapper.SetAccount(ctx, acc2)
+
+	// the pubkey of another account can't be taken
+	res := handler(ctx, NewMsgChangePubKey(addr1, priv2.PubKey()))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidPubKey), res.Code)
+
+	// the index follows the change
+	res = handler(ctx, NewMsgChangePubKey(addr1, priv3.PubKey()))
+	require.True(t, res.IsOK())
+	require.Nil(t, mapper.GetAccountByPubKey(ctx, priv1.PubKey()))
+	require.Equal(t, addr1, mapper.GetAccountByPubKey(ctx, priv3.PubKey()).GetAddress())
+}
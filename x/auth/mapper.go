@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"encoding/binary"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
 	"github.com/tendermint/tendermint/crypto"
@@ -20,6 +22,9 @@ type AccountMapper struct {
 
 	// The wire codec for binary encoding/decoding of accounts.
 	cdc *wire.Codec
+
+	// Whether the accounts are indexed by pubkey and by account number.
+	indexed bool
 }
 
 // NewAccountMapper returns a new sdk.AccountMapper that
@@ -33,6 +38,16 @@ func NewAccountMapper(cdc *wire.Codec, key sdk.StoreKey, proto func() Account) A
 	}
 }
 
+// WithIndexes returns the AccountMapper maintaining indexes of the accounts
+// by pubkey and by account number, which serve the account queries. The
+// accounts are indexed when they are created, and by pubkey once their pubkey
+// is set by a signature or changed, the indexes don't cover the accounts set
+// before they were enabled.
+func (am AccountMapper) WithIndexes() AccountMapper {
+	am.indexed = true
+	return am
+}
+
 // Implaements sdk.AccountMapper.
 func (am AccountMapper) NewAccountWithAddress(ctx sdk.Context, addr sdk.AccAddress) Account {
 	acc := am.proto()
@@ -79,6 +94,12 @@ func (am AccountMapper) GetAccount(ctx sdk.Context, addr sdk.AccAddress) Account
 func (am AccountMapper) SetAccount(ctx sdk.Context, acc Account) {
 	addr := acc.GetAddress()
 	store := ctx.KVStore(am.key)
+	if am.indexed && !store.Has(AddressStoreKey(addr)) {
+		store.Set(AccountNumberIndexKey(acc.GetAccountNumber()), addr)
+		if acc.GetPubKey() != nil {
+			store.Set(PubKeyIndexKey(acc.GetPubKey()), addr)
+		}
+	}
 	bz := am.encodeAccount(acc)
 	store.Set(AddressStoreKey(addr), bz)
 }
@@ -100,6 +121,71 @@ func (am AccountMapper) IterateAccounts(ctx sdk.Context, process func(Account) (
 	}
 }
 
+// IterateAccountsByNumber iterates over the accounts in order of account
+// number, starting at the account number start. It requires the indexes.
+func (am AccountMapper) IterateAccountsByNumber(ctx sdk.Context, start int64, process func(Account) (stop bool)) {
+	store := ctx.KVStore(am.key)
+	iter := store.Iterator(AccountNumberIndexKey(start), sdk.PrefixEndBytes([]byte("accountNumberIndex:")))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		acc := am.GetAccount(ctx, iter.Value())
+		if acc == nil {
+			continue
+		}
+		if process(acc) {
+			return
+		}
+	}
+}
+
+// GetAccountByPubKey returns the account whose pubkey is the pubkey, nil if
+// there is none. It requires the indexes.
+func (am AccountMapper) GetAccountByPubKey(ctx sdk.Context, pubKey crypto.PubKey) Account {
+	store := ctx.KVStore(am.key)
+	addr := store.Get(PubKeyIndexKey(pubKey))
+	if addr == nil {
+		return nil
+	}
+	// the index may be stale if the pubkey wasn't saved, e.g. if the fees of
+	// the tx setting it couldn't be paid
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil || acc.GetPubKey() == nil || !acc.GetPubKey().Equals(pubKey) {
+		return nil
+	}
+	return acc
+}
+
+// AccountNumberIndexKey turns an account number to the key of the address
+// of its account in the index
+func AccountNumberIndexKey(accNumber int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(accNumber))
+	return append([]byte("accountNumberIndex:"), bz...)
+}
+
+// PubKeyIndexKey turns a pubkey to the key of the address of its account in
+// the index
+func PubKeyIndexKey(pubKey crypto.PubKey) []byte {
+	return append([]byte("pubKeyIndex:"), pubKey.Bytes()...)
+}
+
+// index the account of the address by the pubkey, if the indexes are enabled
+func (am AccountMapper) setPubKeyIndex(ctx sdk.Context, pubKey crypto.PubKey, addr sdk.AccAddress) {
+	if !am.indexed {
+		return
+	}
+	store := ctx.KVStore(am.key)
+	store.Set(PubKeyIndexKey(pubKey), addr)
+}
+
+func (am AccountMapper) deletePubKeyIndex(ctx sdk.Context, pubKey crypto.PubKey) {
+	if !am.indexed {
+		return
+	}
+	store := ctx.KVStore(am.key)
+	store.Delete(PubKeyIndexKey(pubKey))
+}
+
 // Returns the PubKey of the account at address
 func (am AccountMapper) GetPubKey(ctx sdk.Context, addr sdk.AccAddress) (crypto.PubKey, sdk.Error) {
 	acc := am.GetAccount(ctx, addr)
@@ -109,6 +195,28 @@ func (am AccountMapper) GetPubKey(ctx sdk.Context, addr sdk.AccAddress) (crypto.
 	return acc.GetPubKey(), nil
 }
 
+// Turn an address to the key of the time its pubkey last changed
+func pubKeyChangeTimeStoreKey(addr sdk.AccAddress) []byte {
+	return append([]byte("pubKeyChangeTime:"), addr.Bytes()...)
+}
+
+// GetPubKeyChangeTime returns the block time the pubkey of the account at
+// address was last changed at, if it was
+func (am AccountMapper) GetPubKeyChangeTime(ctx sdk.Context, addr sdk.AccAddress) (changeTime int64, found bool) {
+	store := ctx.KVStore(am.key)
+	bz := store.Get(pubKeyChangeTimeStoreKey(addr))
+	if bz == nil {
+		return 0, false
+	}
+	am.cdc.MustUnmarshalBinary(bz, &changeTime)
+	return changeTime, true
+}
+
+func (am AccountMapper) setPubKeyChangeTime(ctx sdk.Context, addr sdk.AccAddress, changeTime int64) {
+	store := ctx.KVStore(am.key)
+	store.Set(pubKeyChangeTimeStoreKey(addr), am.cdc.MustMarshalBinary(changeTime))
+}
+
 // Returns the Sequence of the account at address
 func (am AccountMapper) GetSequence(ctx sdk.Context, addr sdk.AccAddress) (int64, sdk.Error) {
 	acc := am.GetAccount(ctx, addr)
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	dbm "github.com/tendermint/tendermint/libs/db"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -60,3 +61,49 @@ func TestAccountMapperGetSet(t *testing.T) {
 	require.NotNil(t, acc)
 	require.Equal(t, newSequence, acc.GetSequence())
 }
+
+func TestAccountMapperIndexes(t *testing.T) {
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount).WithIndexes()
+	anteHandler := NewAnteHandler(mapper, NewFeeCollectionKeeper(cdc, capKey2))
+
+	priv1, addr1 := privAndAddr()
+	priv2, addr2 := privAndAddr()
+	_, addr3 := privAndAddr()
+
+	// an account set with a pubkey is indexed by it
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	acc1.SetPubKey(priv1.PubKey())
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc2)
+	acc3 := mapper.NewAccountWithAddress(ctx, addr3)
+	mapper.SetAccount(ctx, acc3)
+	require.Equal(t, addr1, mapper.GetAccountByPubKey(ctx, priv1.PubKey()).GetAddress())
+	require.Nil(t, mapper.GetAccountByPubKey(ctx, priv2.PubKey()))
+
+	// an account is indexed by the pubkey its first signature sets
+	tx := newTestTx(ctx, []sdk.Msg{newTestMsg(addr2)}, []crypto.PrivKey{priv2}, []int64{1}, []int64{0}, newStdFee())
+	checkValidTx(t, anteHandler, ctx, tx)
+	require.Equal(t, addr2, mapper.GetAccountByPubKey(ctx, priv2.PubKey()).GetAddress())
+
+	// the accounts are iterated in order of account number
+	var addrs []sdk.AccAddress
+	mapper.IterateAccountsByNumber(ctx, 1, func(acc Account) bool {
+		addrs = append(addrs, acc.GetAddress())
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addr2, addr3}, addrs)
+
+	// a mapper without indexes leaves no index
+	ms, capKey, _ = setupMultiStore()
+	ctx = sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	mapper = NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	mapper.SetAccount(ctx, acc1)
+	require.Nil(t, mapper.GetAccountByPubKey(ctx, priv1.PubKey()))
+}
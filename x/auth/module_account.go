@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// permissions of the module accounts
+const (
+	// the module may mint coins into its account
+	Minter = "minter"
+	// the module may burn coins of its account
+	Burner = "burner"
+	// the module may hold the coins delegated by vesting accounts, which
+	// remain locked when they are returned
+	Staking = "staking"
+)
+
+// FeeCollectorName is the name of the module account holding the collected
+// fees when the FeeCollectionKeeper uses one
+const FeeCollectorName = "fee_collector"
+
+var _ Account = (*ModuleAccount)(nil)
+
+// ModuleAccount is the account of a module, e.g. holding the coins escrowed
+// by the module. Its address is derived from the name of the module and it
+// has no pubkey, so it can't sign txs and only the module moves its coins.
+type ModuleAccount struct {
+	*BaseAccount
+
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// NewModuleAddress returns the address of the module account of the name
+func NewModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(tmhash.Sum([]byte(name)))
+}
+
+// NewModuleAccount returns the module account of the name with the
+// permissions
+func NewModuleAccount(name string, permissions ...string) *ModuleAccount {
+	baseAcc := NewBaseAccountWithAddress(NewModuleAddress(name))
+	return &ModuleAccount{
+		BaseAccount: &baseAcc,
+		Name:        name,
+		Permissions: permissions,
+	}
+}
+
+// HasPermission returns whether the module account has the permission
+func (macc ModuleAccount) HasPermission(permission string) bool {
+	for _, perm := range macc.Permissions {
+		if perm == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements sdk.Account. Module accounts have no pubkey.
+func (macc *ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
+	return errors.New("module accounts can't have a pubkey")
+}
+
+// Validate checks that the address of the module account is derived from its
+// name
+func (macc ModuleAccount) Validate() error {
+	if macc.Name == "" {
+		return errors.New("module account name can't be empty")
+	}
+	if !bytes.Equal(NewModuleAddress(macc.Name), macc.Address) {
+		return fmt.Errorf("address %s isn't the address of the module account %s", macc.Address, macc.Name)
+	}
+	if macc.PubKey != nil {
+		return errors.New("module accounts can't have a pubkey")
+	}
+	return nil
+}
+
+// GetModuleAccount returns the module account of the name, creating it with
+// the permissions if it doesn't exist. An ordinary account at the address of
+// the module, e.g. created by coins sent to it, becomes the module account
+// keeping its coins and account number.
+func (am AccountMapper) GetModuleAccount(ctx sdk.Context, name string, permissions ...string) *ModuleAccount {
+	addr := NewModuleAddress(name)
+	acc := am.GetAccount(ctx, addr)
+	if macc, ok := acc.(*ModuleAccount); ok {
+		return macc
+	}
+
+	macc := NewModuleAccount(name, permissions...)
+	if acc != nil {
+		macc.Coins = acc.GetCoins()
+		macc.AccountNumber = acc.GetAccountNumber()
+		macc.Sequence = acc.GetSequence()
+	} else {
+		macc.AccountNumber = am.GetNextAccountNumber(ctx)
+	}
+	am.SetAccount(ctx, macc)
+	return macc
+}
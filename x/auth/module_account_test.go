@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestModuleAccount(t *testing.T) {
+	macc := NewModuleAccount("gov", Burner)
+	require.Equal(t, NewModuleAddress("gov"), macc.GetAddress())
+	require.NotEqual(t, NewModuleAddress("gov"), NewModuleAddress("stake"))
+	require.True(t, macc.HasPermission(Burner))
+	require.False(t, macc.HasPermission(Minter))
+	require.Nil(t, macc.Validate())
+
+	// module accounts can't have a pubkey
+	require.NotNil(t, macc.SetPubKey(crypto.GenPrivKeyEd25519().PubKey()))
+	require.Nil(t, macc.GetPubKey())
+
+	// the address must be derived from the name
+	macc.Name = "stake"
+	require.NotNil(t, macc.Validate())
+}
+
+func TestGetModuleAccount(t *testing.T) {
+	ms, capKey, capKey2 := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+
+	// an ordinary account at the module address becomes the module account
+	acc := mapper.NewAccountWithAddress(ctx, NewModuleAddress("gov"))
+	acc.SetCoins(oneCoin)
+	mapper.SetAccount(ctx, acc)
+	macc := mapper.GetModuleAccount(ctx, "gov", Burner)
+	require.Equal(t, "gov", macc.Name)
+	require.Equal(t, []string{Burner}, macc.Permissions)
+	require.Equal(t, acc.GetAccountNumber(), macc.GetAccountNumber())
+	require.True(t, macc.GetCoins().IsEqual(oneCoin))
+	_, ok := mapper.GetAccount(ctx, macc.Address).(*ModuleAccount)
+	require.True(t, ok)
+
+	// the module account keeps its permissions
+	macc = mapper.GetModuleAccount(ctx, "gov", Minter)
+	require.Equal(t, []string{Burner}, macc.Permissions)
+
+	// the fee collector module account holds the collected fees
+	fck := NewFeeCollectionKeeper(cdc, capKey2).WithModuleAccount(mapper)
+	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
+	fck.AddCollectedFees(ctx, twoCoins)
+	require.True(t, fck.GetCollectedFees(ctx).IsEqual(twoCoins))
+	require.True(t, mapper.GetAccount(ctx, NewModuleAddress(FeeCollectorName)).GetCoins().IsEqual(twoCoins))
+	fck.ClearCollectedFees(ctx)
+	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "auth"
+
+// verify interface at compile time
+var _, _ sdk.Msg = MsgSetUnordered{}, MsgChangePubKey{}
+
+// MsgSetUnordered - sets whether the account accepts unordered txs, which are
+// protected from replays by their timeout height and nonce rather than by the
+// sequence of the account
+type MsgSetUnordered struct {
+	Address   sdk.AccAddress `json:"address"`
+	Unordered bool           `json:"unordered"`
+}
+
+func NewMsgSetUnordered(addr sdk.AccAddress, unordered bool) MsgSetUnordered {
+	return MsgSetUnordered{
+		Address:   addr,
+		Unordered: unordered,
+	}
+}
+
+//nolint
+func (msg MsgSetUnordered) Type() string                 { return MsgType }
+func (msg MsgSetUnordered) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Address} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetUnordered) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSetUnordered) ValidateBasic() sdk.Error {
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgChangePubKey - replaces the pubkey of the account, keeping its address,
+// coins and delegations. It is signed with the current pubkey.
+type MsgChangePubKey struct {
+	Address sdk.AccAddress `json:"address"`
+	PubKey  crypto.PubKey  `json:"pub_key"`
+}
+
+func NewMsgChangePubKey(addr sdk.AccAddress, pubKey crypto.PubKey) MsgChangePubKey {
+	return MsgChangePubKey{
+		Address: addr,
+		PubKey:  pubKey,
+	}
+}
+
+//nolint
+func (msg MsgChangePubKey) Type() string                 { return MsgType }
+func (msg MsgChangePubKey) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Address} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgChangePubKey) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgChangePubKey) ValidateBasic() sdk.Error {
+	if len(msg.Address) == 0 {
+		return sdk.ErrInvalidAddress("missing address")
+	}
+	if msg.PubKey == nil {
+		return sdk.ErrInvalidPubKey("missing pubkey")
+	}
+	return nil
+}
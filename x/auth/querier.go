@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the auth querier
+const (
+	QueryAccountByPubKey = "pubkey"
+	QueryAccounts        = "accounts"
+
+	// DefaultQueryAccountsLimit is the number of accounts in a page when no
+	// limit is requested, and the largest limit allowed
+	DefaultQueryAccountsLimit = 100
+)
+
+// QueryPubKeyParams are the params of the query of the account of a pubkey
+type QueryPubKeyParams struct {
+	PubKey crypto.PubKey `json:"pubkey"`
+}
+
+// QueryAccountsParams are the params of the query of a page of the accounts
+// in order of account number, page n holds the account numbers from
+// (n-1)*limit to n*limit excluded
+type QueryAccountsParams struct {
+	Page  int `json:"page"`
+	Limit int `json:"limit"`
+}
+
+// NewQuerier returns the querier of the account queries, it requires an
+// AccountMapper with indexes
+func NewQuerier(am AccountMapper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if !am.indexed {
+			return nil, sdk.ErrUnknownRequest("the accounts aren't indexed")
+		}
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no auth query endpoint specified")
+		}
+		switch path[0] {
+		case QueryAccountByPubKey:
+			return queryAccountByPubKey(ctx, req, am)
+		case QueryAccounts:
+			return queryAccounts(ctx, req, am)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown auth query endpoint %s", path[0]))
+		}
+	}
+}
+
+func queryAccountByPubKey(ctx sdk.Context, req abci.RequestQuery, am AccountMapper) ([]byte, sdk.Error) {
+	var params QueryPubKeyParams
+	err := am.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err.Error()))
+	}
+	if params.PubKey == nil {
+		return nil, sdk.ErrInvalidPubKey("no pubkey specified")
+	}
+
+	acc := am.GetAccountByPubKey(ctx, params.PubKey)
+	if acc == nil {
+		return nil, sdk.ErrUnknownAddress(fmt.Sprintf("no account with pubkey %X", params.PubKey.Bytes()))
+	}
+	bz, err := am.cdc.MarshalJSON(acc)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func queryAccounts(ctx sdk.Context, req abci.RequestQuery, am AccountMapper) ([]byte, sdk.Error) {
+	params := QueryAccountsParams{Page: 1}
+	if len(req.Data) != 0 {
+		err := am.cdc.UnmarshalJSON(req.Data, &params)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err.Error()))
+		}
+	}
+	if params.Page < 1 {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid page %d, pages start at 1", params.Page))
+	}
+	if params.Limit < 0 || params.Limit > DefaultQueryAccountsLimit {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid limit %d, it must be at most %d", params.Limit, DefaultQueryAccountsLimit))
+	}
+	if params.Limit == 0 {
+		params.Limit = DefaultQueryAccountsLimit
+	}
+
+	// a page holds the accounts of a range of account numbers, which has
+	// fewer accounts than the limit if some numbers have none
+	accounts := []Account{}
+	start := int64(params.Page-1) * int64(params.Limit)
+	end := start + int64(params.Limit)
+	am.IterateAccountsByNumber(ctx, start, func(acc Account) bool {
+		if acc.GetAccountNumber() >= end {
+			return true
+		}
+		accounts = append(accounts, acc)
+		return false
+	})
+	bz, err := am.cdc.MarshalJSON(accounts)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestQuerier(t *testing.T) {
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount).WithIndexes()
+	querier := NewQuerier(mapper)
+
+	var privs []crypto.PrivKey
+	var addrs []sdk.AccAddress
+	for i := 0; i < 5; i++ {
+		priv, addr := privAndAddr()
+		acc := mapper.NewAccountWithAddress(ctx, addr)
+		acc.SetPubKey(priv.PubKey())
+		mapper.SetAccount(ctx, acc)
+		privs = append(privs, priv)
+		addrs = append(addrs, addr)
+	}
+	query := func(path string, params interface{}) ([]byte, sdk.Error) {
+		return querier(ctx, []string{path}, abci.RequestQuery{Data: cdc.MustMarshalJSON(params)})
+	}
+
+	// the account of a pubkey
+	bz, err := query(QueryAccountByPubKey, QueryPubKeyParams{PubKey: privs[1].PubKey()})
+	require.Nil(t, err)
+	var acc Account
+	require.Nil(t, cdc.UnmarshalJSON(bz, &acc))
+	require.Equal(t, addrs[1], acc.GetAddress())
+	_, err = query(QueryAccountByPubKey, QueryPubKeyParams{PubKey: crypto.GenPrivKeyEd25519().PubKey()})
+	require.Equal(t, sdk.CodeUnknownAddress, err.Code())
+
+	// pages of the accounts in order of account number
+	pageAddrs := func(page, limit int) []sdk.AccAddress {
+		bz, err := query(QueryAccounts, QueryAccountsParams{Page: page, Limit: limit})
+		require.Nil(t, err)
+		var accs []Account
+		require.Nil(t, cdc.UnmarshalJSON(bz, &accs))
+		addrs := []sdk.AccAddress{}
+		for _, acc := range accs {
+			addrs = append(addrs, acc.GetAddress())
+		}
+		return addrs
+	}
+	require.Equal(t, addrs[:2], pageAddrs(1, 2))
+	require.Equal(t, addrs[2:4], pageAddrs(2, 2))
+	require.Equal(t, addrs[4:], pageAddrs(3, 2))
+	require.Equal(t, []sdk.AccAddress{}, pageAddrs(4, 2))
+	require.Equal(t, addrs, pageAddrs(1, 0))
+	_, err = query(QueryAccounts, QueryAccountsParams{Page: 0})
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	_, err = query(QueryAccounts, QueryAccountsParams{Page: 1, Limit: DefaultQueryAccountsLimit + 1})
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+
+	// unknown endpoints, and a mapper without indexes, are rejected
+	_, err = query("unknown", nil)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	_, err = NewQuerier(NewAccountMapper(cdc, capKey, ProtoBaseAccount))(ctx, []string{QueryAccounts}, abci.RequestQuery{})
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"encoding/binary"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+// MaxTimeoutHeightDelta is how far in the future the timeout height of an
+// unordered tx may be, which bounds how long its hash is kept.
+const MaxTimeoutHeightDelta int64 = 1000
+
+var (
+	unorderedAccountKeyPrefix = []byte{0x00} // prefix for the accounts which opted into unordered txs
+	seenTxKeyPrefix           = []byte{0x01} // prefix for the hashes of unordered txs
+	seenTxTimeoutKeyPrefix    = []byte{0x02} // prefix for the hashes of unordered txs by timeout height
+)
+
+func unorderedAccountKey(addr sdk.AccAddress) []byte {
+	return append(unorderedAccountKeyPrefix, addr.Bytes()...)
+}
+
+func seenTxKey(hash []byte) []byte {
+	return append(seenTxKeyPrefix, hash...)
+}
+
+// the timeout height is big endian encoded so that the keys are sorted by it
+func seenTxTimeoutKey(timeoutHeight int64, hash []byte) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(timeoutHeight))
+	return append(append(seenTxTimeoutKeyPrefix, bz...), hash...)
+}
+
+// UnorderedTxHash returns the hash identifying an unordered tx, which
+// covers all of its content but the signatures so that it can't be replayed
+// with different signatures.
+func UnorderedTxHash(chainID string, tx StdTx) []byte {
+	return tmhash.Sum(UnorderedStdSignBytes(chainID, 0, tx.TimeoutHeight, tx.Nonce, tx.Fee, tx.Msgs, tx.Memo))
+}
+
+// ReplayKeeper records the accounts which opted into unordered txs and the
+// hashes of the unordered txs seen until they time out.
+type ReplayKeeper struct {
+
+	// The (unexposed) key used to access the replay store from the Context.
+	key sdk.StoreKey
+
+	// The wire codec for binary encoding/decoding.
+	cdc *wire.Codec
+}
+
+// NewReplayKeeper returns a new ReplayKeeper
+func NewReplayKeeper(cdc *wire.Codec, key sdk.StoreKey) ReplayKeeper {
+	return ReplayKeeper{
+		key: key,
+		cdc: cdc,
+	}
+}
+
+// IsUnordered returns true if the account accepts unordered txs
+func (rk ReplayKeeper) IsUnordered(ctx sdk.Context, addr sdk.AccAddress) bool {
+	return ctx.KVStore(rk.key).Has(unorderedAccountKey(addr))
+}
+
+// SetUnordered sets whether the account accepts unordered txs
+func (rk ReplayKeeper) SetUnordered(ctx sdk.Context, addr sdk.AccAddress, unordered bool) {
+	store := ctx.KVStore(rk.key)
+	if unordered {
+		store.Set(unorderedAccountKey(addr), []byte{0x01})
+	} else {
+		store.Delete(unorderedAccountKey(addr))
+	}
+}
+
+// IterateUnorderedAccounts iterates over the accounts which accept unordered
+// txs, stopping when fn returns true
+func (rk ReplayKeeper) IterateUnorderedAccounts(ctx sdk.Context, fn func(addr sdk.AccAddress) (stop bool)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(rk.key), unorderedAccountKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if fn(sdk.AccAddress(iter.Key()[len(unorderedAccountKeyPrefix):])) {
+			break
+		}
+	}
+}
+
+// HasSeenTx returns true if the unordered tx with the hash was already seen
+func (rk ReplayKeeper) HasSeenTx(ctx sdk.Context, hash []byte) bool {
+	return ctx.KVStore(rk.key).Has(seenTxKey(hash))
+}
+
+// records the hash of an unordered tx until its timeout height
+func (rk ReplayKeeper) setSeenTx(ctx sdk.Context, hash []byte, timeoutHeight int64) {
+	store := ctx.KVStore(rk.key)
+	store.Set(seenTxKey(hash), rk.cdc.MustMarshalBinary(timeoutHeight))
+	store.Set(seenTxTimeoutKey(timeoutHeight, hash), []byte{})
+}
+
+// PruneExpiredTxs removes the hashes of the unordered txs which timed out
+// before the current block, they can't be included anymore.
+func (rk ReplayKeeper) PruneExpiredTxs(ctx sdk.Context) {
+	store := ctx.KVStore(rk.key)
+	end := seenTxTimeoutKey(ctx.BlockHeight(), nil)
+	iter := store.Iterator(seenTxTimeoutKeyPrefix, end)
+	var expired [][]byte
+	for ; iter.Valid(); iter.Next() {
+		expired = append(expired, iter.Key())
+	}
+	iter.Close()
+
+	// the keys are deleted once the iterator is closed
+	for _, key := range expired {
+		hash := key[len(seenTxTimeoutKeyPrefix)+8:]
+		store.Delete(seenTxKey(hash))
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func setupReplayMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.KVStoreKey) {
+	db := dbm.NewMemDB()
+	capKey := sdk.NewKVStoreKey("capkey")
+	capKey2 := sdk.NewKVStoreKey("capkey2")
+	capKey3 := sdk.NewKVStoreKey("capkey3")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(capKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(capKey2, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(capKey3, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+	return ms, capKey, capKey2, capKey3
+}
+
+func TestReplayKeeper(t *testing.T) {
+	ms, _, _, capKey3 := setupReplayMultiStore()
+	cdc := wire.NewCodec()
+	rk := NewReplayKeeper(cdc, capKey3)
+	ctx := sdk.NewContext(ms, abci.Header{Height: 10}, false, log.NewNopLogger())
+
+	// opting in and out
+	addr := sdk.AccAddress([]byte("addr"))
+	require.False(t, rk.IsUnordered(ctx, addr))
+	rk.SetUnordered(ctx, addr, true)
+	require.True(t, rk.IsUnordered(ctx, addr))
+	var addrs []sdk.AccAddress
+	rk.IterateUnorderedAccounts(ctx, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	require.Equal(t, []sdk.AccAddress{addr}, addrs)
+	rk.SetUnordered(ctx, addr, false)
+	require.False(t, rk.IsUnordered(ctx, addr))
+
+	// the hashes are kept until their timeout height
+	hash1, hash2 := []byte("hash1"), []byte("hash2")
+	rk.setSeenTx(ctx, hash1, 12)
+	rk.setSeenTx(ctx, hash2, 300)
+
+	rk.PruneExpiredTxs(ctx.WithBlockHeight(12))
+	require.True(t, rk.HasSeenTx(ctx, hash1))
+	rk.PruneExpiredTxs(ctx.WithBlockHeight(13))
+	require.False(t, rk.HasSeenTx(ctx, hash1))
+	require.True(t, rk.HasSeenTx(ctx, hash2))
+}
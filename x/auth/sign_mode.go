@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// SignModeHandler renders the document to sign for a transaction in a sign
+// mode. The codec encodes the msgs of binary documents.
+type SignModeHandler func(cdc *wire.Codec, msg StdSignMsg) ([]byte, error)
+
+var signModeHandlers = map[sdk.SignMode]SignModeHandler{
+	sdk.SignModeJSON:    jsonSignBytes,
+	sdk.SignModeBinary:  binarySignBytes,
+	sdk.SignModeTextual: textualSignBytes,
+}
+
+// RegisterSignModeHandler sets the handler of a sign mode, overriding any
+// previous handler
+func RegisterSignModeHandler(mode sdk.SignMode, handler SignModeHandler) {
+	signModeHandlers[mode] = handler
+}
+
+// SignBytes returns the bytes to sign for the message in the sign mode
+func (msg StdSignMsg) SignBytes(cdc *wire.Codec, mode sdk.SignMode) ([]byte, error) {
+	handler, ok := signModeHandlers[mode]
+	if !ok {
+		return nil, fmt.Errorf("unsupported sign mode %s", mode)
+	}
+	return handler(cdc, msg)
+}
+
+// the sorted JSON StdSignDoc
+func jsonSignBytes(_ *wire.Codec, msg StdSignMsg) ([]byte, error) {
+	return msg.Bytes(), nil
+}
+
+// binarySignDoc is the document signed in binary mode, the msgs are amino
+// encoded rather than rendered to JSON
+type binarySignDoc struct {
+	AccountNumber int64
+	ChainID       string
+	Fee           StdFee
+	Memo          string
+	Msgs          []sdk.Msg
+	Sequence      int64
+	TimeoutHeight int64
+	Nonce         int64
+}
+
+// the amino binary encoding of the binarySignDoc
+func binarySignBytes(cdc *wire.Codec, msg StdSignMsg) ([]byte, error) {
+	doc := binarySignDoc{
+		AccountNumber: msg.AccountNumber,
+		ChainID:       msg.ChainID,
+		Fee:           msg.Fee,
+		Memo:          msg.Memo,
+		Msgs:          msg.Msgs,
+		TimeoutHeight: msg.TimeoutHeight,
+		Nonce:         msg.Nonce,
+	}
+	// the sequence of unordered txs isn't signed
+	if msg.TimeoutHeight == 0 {
+		doc.Sequence = msg.Sequence
+	}
+	return cdc.MarshalBinaryBare(doc)
+}
+
+// a human-readable rendering of the transaction, one field per line and the
+// msgs as indented JSON
+func textualSignBytes(_ *wire.Codec, msg StdSignMsg) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Chain ID: %s\n", msg.ChainID)
+	fmt.Fprintf(&buf, "Account number: %d\n", msg.AccountNumber)
+	if msg.TimeoutHeight != 0 {
+		fmt.Fprintf(&buf, "Timeout height: %d\n", msg.TimeoutHeight)
+		fmt.Fprintf(&buf, "Nonce: %d\n", msg.Nonce)
+	} else {
+		fmt.Fprintf(&buf, "Sequence: %d\n", msg.Sequence)
+	}
+	fmt.Fprintf(&buf, "Fee: %s\n", msg.Fee.Amount)
+	fmt.Fprintf(&buf, "Gas: %d\n", msg.Fee.Gas)
+	if len(msg.Fee.Granter) != 0 {
+		fmt.Fprintf(&buf, "Fee granter: %s\n", msg.Fee.Granter)
+	}
+	// the memo is quoted so that it can't fake other lines
+	if msg.Memo != "" {
+		fmt.Fprintf(&buf, "Memo: %q\n", msg.Memo)
+	}
+	for i, m := range msg.Msgs {
+		fmt.Fprintf(&buf, "Message %d/%d: %s\n  ", i+1, len(msg.Msgs), m.Type())
+		if err := json.Indent(&buf, m.GetSignBytes(), "  ", "  "); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\n")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestSignModeSignBytes(t *testing.T) {
+	cdc := wire.NewCodec()
+	cdc.RegisterInterface((*sdk.Msg)(nil), nil)
+	cdc.RegisterConcrete(&sdk.TestMsg{}, "cosmos-sdk/TestMsg", nil)
+
+	priv := crypto.GenPrivKeyEd25519()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	signMsg := StdSignMsg{
+		ChainID:       "1234",
+		AccountNumber: 3,
+		Sequence:      6,
+		Fee:           newStdFee(),
+		Msgs:          []sdk.Msg{sdk.NewTestMsg(addr)},
+		Memo:          "memo",
+	}
+
+	// json is the sorted StdSignDoc
+	bz, err := signMsg.SignBytes(cdc, sdk.SignModeJSON)
+	require.Nil(t, err)
+	require.Equal(t, signMsg.Bytes(), bz)
+
+	// binary is deterministic and covers the sequence
+	bz, err = signMsg.SignBytes(cdc, sdk.SignModeBinary)
+	require.Nil(t, err)
+	bz2, err := signMsg.SignBytes(cdc, sdk.SignModeBinary)
+	require.Nil(t, err)
+	require.Equal(t, bz, bz2)
+	other := signMsg
+	other.Sequence = 7
+	bz2, err = other.SignBytes(cdc, sdk.SignModeBinary)
+	require.Nil(t, err)
+	require.NotEqual(t, bz, bz2)
+
+	// textual renders one field per line
+	bz, err = signMsg.SignBytes(cdc, sdk.SignModeTextual)
+	require.Nil(t, err)
+	require.Equal(t, fmt.Sprintf("Chain ID: 1234\nAccount number: 3\nSequence: 6\nFee: 150atom\nGas: 5000\nMemo: \"memo\"\nMessage 1/1: TestMsg\n  [\n    \"%s\"\n  ]\n", addr), string(bz))
+
+	signMsg.TimeoutHeight = 100
+	signMsg.Nonce = 42
+	bz, err = signMsg.SignBytes(cdc, sdk.SignModeTextual)
+	require.Nil(t, err)
+	require.Contains(t, string(bz), "Timeout height: 100\nNonce: 42\n")
+	require.NotContains(t, string(bz), "Sequence")
+
+	// unknown modes have no handler
+	_, err = signMsg.SignBytes(cdc, sdk.SignMode(0xff))
+	require.NotNil(t, err)
+}
@@ -11,11 +11,15 @@ var _ sdk.Tx = (*StdTx)(nil)
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the FeePayer (Signatures must not be nil).
+// A tx with a TimeoutHeight is unordered: instead of the sequences of its
+// signers it is protected from replays by its unique Nonce until it times out.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg"`
-	Fee        StdFee         `json:"fee"`
-	Signatures []StdSignature `json:"signatures"`
-	Memo       string         `json:"memo"`
+	Msgs          []sdk.Msg      `json:"msg"`
+	Fee           StdFee         `json:"fee"`
+	Signatures    []StdSignature `json:"signatures"`
+	Memo          string         `json:"memo"`
+	TimeoutHeight int64          `json:"timeout_height,omitempty"`
+	Nonce         int64          `json:"nonce,omitempty"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -52,6 +56,10 @@ func (tx StdTx) GetSigners() []sdk.AccAddress {
 //nolint
 func (tx StdTx) GetMemo() string { return tx.Memo }
 
+// IsUnordered returns true if the tx is protected from replays by its
+// timeout height and nonce rather than by sequences.
+func (tx StdTx) IsUnordered() bool { return tx.TimeoutHeight != 0 }
+
 // Signatures returns the signature of signers who signed the Msg.
 // GetSignatures returns the signature of signers who signed the Msg.
 // CONTRACT: Length returned is same as length of
@@ -74,9 +82,12 @@ func FeePayer(tx sdk.Tx) sdk.AccAddress {
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+// If Granter is set, the fee is paid by the granter from the fee allowance it
+// granted to the fee payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount"`
-	Gas    int64     `json:"gas"`
+	Amount  sdk.Coins      `json:"amount"`
+	Gas     int64          `json:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty"`
 }
 
 func NewStdFee(gas int64, amount ...sdk.Coin) StdFee {
@@ -108,7 +119,8 @@ func (fee StdFee) Bytes() []byte {
 // It includes the result of msg.GetSignBytes(),
 // as well as the ChainID (prevent cross chain replay)
 // and the Sequence numbers for each signature (prevent
-// inchain replay and enforce tx ordering per account).
+// inchain replay and enforce tx ordering per account),
+// or the TimeoutHeight and Nonce of unordered txs.
 type StdSignDoc struct {
 	AccountNumber int64             `json:"account_number"`
 	ChainID       string            `json:"chain_id"`
@@ -116,22 +128,39 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo"`
 	Msgs          []json.RawMessage `json:"msgs"`
 	Sequence      int64             `json:"sequence"`
+	TimeoutHeight int64             `json:"timeout_height,omitempty"`
+	Nonce         int64             `json:"nonce,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		Sequence:      sequence,
+	}, fee, msgs, memo)
+}
+
+// UnorderedStdSignBytes returns the bytes to sign for an unordered
+// transaction, which don't depend on the sequence.
+func UnorderedStdSignBytes(chainID string, accnum int64, timeoutHeight int64, nonce int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(StdSignDoc{
+		AccountNumber: accnum,
+		ChainID:       chainID,
+		TimeoutHeight: timeoutHeight,
+		Nonce:         nonce,
+	}, fee, msgs, memo)
+}
+
+func stdSignBytes(doc StdSignDoc, fee StdFee, msgs []sdk.Msg, memo string) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
 	}
-	bz, err := msgCdc.MarshalJSON(StdSignDoc{
-		AccountNumber: accnum,
-		ChainID:       chainID,
-		Fee:           json.RawMessage(fee.Bytes()),
-		Memo:          memo,
-		Msgs:          msgsBytes,
-		Sequence:      sequence,
-	})
+	doc.Fee = json.RawMessage(fee.Bytes())
+	doc.Memo = memo
+	doc.Msgs = msgsBytes
+	bz, err := msgCdc.MarshalJSON(doc)
 	if err != nil {
 		panic(err)
 	}
@@ -148,10 +177,15 @@ type StdSignMsg struct {
 	Fee           StdFee
 	Msgs          []sdk.Msg
 	Memo          string
+	TimeoutHeight int64
+	Nonce         int64
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
+	if msg.TimeoutHeight != 0 {
+		return UnorderedStdSignBytes(msg.ChainID, msg.AccountNumber, msg.TimeoutHeight, msg.Nonce, msg.Fee, msg.Msgs, msg.Memo)
+	}
 	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
 }
 
@@ -159,6 +193,7 @@ func (msg StdSignMsg) Bytes() []byte {
 type StdSignature struct {
 	crypto.PubKey    `json:"pub_key"` // optional
 	crypto.Signature `json:"signature"`
-	AccountNumber    int64 `json:"account_number"`
-	Sequence         int64 `json:"sequence"`
+	AccountNumber    int64        `json:"account_number"`
+	Sequence         int64        `json:"sequence"`
+	SignMode         sdk.SignMode `json:"sign_mode,omitempty"` // encoding of the signed document
 }
@@ -38,6 +38,13 @@ func TestStdSignBytes(t *testing.T) {
 		fee,
 		msgs,
 		"memo",
+		0,
+		0,
 	}
 	require.Equal(t, fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"5000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"sequence\":\"6\"}", addr), string(signMsg.Bytes()))
+
+	// unordered txs sign their timeout height and nonce instead of the sequence
+	signMsg.TimeoutHeight = 100
+	signMsg.Nonce = 42
+	require.Equal(t, fmt.Sprintf("{\"account_number\":\"3\",\"chain_id\":\"1234\",\"fee\":{\"amount\":[{\"amount\":\"150\",\"denom\":\"atom\"}],\"gas\":\"5000\"},\"memo\":\"memo\",\"msgs\":[[\"%s\"]],\"nonce\":\"42\",\"sequence\":\"0\",\"timeout_height\":\"100\"}", addr), string(signMsg.Bytes()))
 }
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TagTransfer is the tag of the coin movements, whose value is the JSON
+// encoded TransferEvent. Every movement of coins of the accounts is tagged,
+// including the fees, the minted and the burned coins.
+const TagTransfer = "transfer"
+
+// TransferEvent is a movement of coins of a denomination. The sender is empty
+// when the coins are minted, the recipient when they are burned. The msg
+// index is -1 for the movements of the AnteHandler, e.g. the fees, and of
+// the block hooks.
+type TransferEvent struct {
+	MsgIndex  int            `json:"msg_index"`
+	Sender    sdk.AccAddress `json:"sender,omitempty"`
+	Recipient sdk.AccAddress `json:"recipient,omitempty"`
+	Denom     string         `json:"denom"`
+	Amount    sdk.Int        `json:"amount"`
+}
+
+// TransferTags returns the tags of the transfers of the coins from the
+// sender to the recipient, one per denomination
+func TransferTags(msgIndex int, sender, recipient sdk.AccAddress, amt sdk.Coins) sdk.Tags {
+	tags := sdk.EmptyTags()
+	for _, coin := range amt {
+		if coin.IsZero() {
+			continue
+		}
+		bz, err := json.Marshal(TransferEvent{
+			MsgIndex:  msgIndex,
+			Sender:    sender,
+			Recipient: recipient,
+			Denom:     coin.Denom,
+			Amount:    coin.Amount,
+		})
+		if err != nil {
+			panic(err)
+		}
+		tags = tags.AppendTag(TagTransfer, bz)
+	}
+	return tags
+}
+
+// EmitTransfers emits the tags of the transfers of the coins from the sender
+// to the recipient with the context
+func EmitTransfers(ctx sdk.Context, sender, recipient sdk.AccAddress, amt sdk.Coins) {
+	ctx.EmitTags(TransferTags(ctx.MsgIndex(), sender, recipient, amt))
+}
+
+// ParseTransferTags returns the transfers of the transfer tags in the tags,
+// skipping the other tags
+func ParseTransferTags(tags sdk.Tags) ([]TransferEvent, error) {
+	var events []TransferEvent
+	for _, tag := range tags {
+		if string(tag.Key) != TagTransfer {
+			continue
+		}
+		var event TransferEvent
+		err := json.Unmarshal(tag.Value, &event)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
@@ -0,0 +1,273 @@
+package auth
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingAccount is an account whose original vesting coins unlock over time.
+// Vesting coins can't be spent but they can be delegated, the amounts
+// delegated are tracked so that unbonded coins are locked or spendable again
+// as appropriate.
+type VestingAccount interface {
+	Account
+
+	// coins which can be spent at the given block time
+	SpendableCoins(blockTime int64) sdk.Coins
+
+	// track the delegation and undelegation of coins of the account
+	TrackDelegation(blockTime int64, amount sdk.Coins)
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime int64) sdk.Coins
+	GetVestingCoins(blockTime int64) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// SpendableCoins returns the coins of the account which can be spent at the
+// given block time, all of them unless the account is a vesting account.
+func SpendableCoins(acc Account, blockTime int64) sdk.Coins {
+	if vacc, ok := acc.(VestingAccount); ok {
+		return vacc.SpendableCoins(blockTime)
+	}
+	return acc.GetCoins()
+}
+
+//-----------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount implements the tracking common to all vesting accounts.
+// OriginalVesting is the amount locked at creation, DelegatedFree and
+// DelegatedVesting are the vested and vesting amounts currently delegated.
+type BaseVestingAccount struct {
+	*BaseAccount
+
+	OriginalVesting  sdk.Coins `json:"original_vesting"`
+	DelegatedFree    sdk.Coins `json:"delegated_free"`
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"`
+
+	EndTime int64 `json:"end_time"` // when the coins are fully vested
+}
+
+// spendable coins given the coins still vesting, per denomination this is
+// min((coins + delegated vesting) - vesting, coins) as vesting coins which
+// are delegated are no longer held by the account.
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendable sdk.Coins
+	for _, coin := range bva.Coins {
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		amt := sdk.MinInt(coin.Amount.Add(delVestingAmt).Sub(vestingAmt), coin.Amount)
+		if amt.Sign() > 0 {
+			spendable = spendable.Plus(sdk.Coins{{Denom: coin.Denom, Amount: amt}})
+		}
+	}
+	return spendable
+}
+
+// track a delegation given the coins still vesting, the delegated amount is
+// accounted as vesting up to the vesting coins which are not delegated yet,
+// the rest as free.
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	for _, coin := range amount {
+		if coin.Amount.Sign() <= 0 || bva.Coins.AmountOf(coin.Denom).LT(coin.Amount) {
+			panic("invalid delegation amount")
+		}
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		x := sdk.MinInt(maxInt(vestingAmt.Sub(delVestingAmt), sdk.ZeroInt()), coin.Amount)
+		y := coin.Amount.Sub(x)
+		if !x.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Plus(sdk.Coins{{Denom: coin.Denom, Amount: x}})
+		}
+		if !y.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Plus(sdk.Coins{{Denom: coin.Denom, Amount: y}})
+		}
+	}
+}
+
+// TrackUndelegation tracks coins returned to the account by unbonding. Free
+// coins are undelegated first, so that coins slashed while bonded are
+// deducted from the vesting coins.
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		if coin.Amount.Sign() < 0 {
+			panic("invalid undelegation amount")
+		}
+		delFreeAmt := bva.DelegatedFree.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		x := sdk.MinInt(delFreeAmt, coin.Amount)
+		y := sdk.MinInt(coin.Amount.Sub(x), delVestingAmt)
+		if !x.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Minus(sdk.Coins{{Denom: coin.Denom, Amount: x}})
+		}
+		if !y.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Minus(sdk.Coins{{Denom: coin.Denom, Amount: y}})
+		}
+	}
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// Implements VestingAccount
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+func newBaseVestingAccount(acc *BaseAccount, endTime int64) (*BaseVestingAccount, error) {
+	if !acc.Coins.IsNotNegative() {
+		return nil, errors.New("cannot vest negative coins")
+	}
+	return &BaseVestingAccount{
+		BaseAccount:     acc,
+		OriginalVesting: acc.Coins,
+		EndTime:         endTime,
+	}, nil
+}
+
+func maxInt(i1, i2 sdk.Int) sdk.Int {
+	if i1.GT(i2) {
+		return i1
+	}
+	return i2
+}
+
+//-----------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ VestingAccount = (*ContinuousVestingAccount)(nil)
+
+// ContinuousVestingAccount vests its coins linearly from StartTime to EndTime.
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime int64 `json:"start_time"` // when the coins start to vest
+}
+
+// NewContinuousVestingAccount returns an account vesting all of its coins
+// linearly between the start and end times.
+func NewContinuousVestingAccount(acc *BaseAccount, startTime, endTime int64) (*ContinuousVestingAccount, error) {
+	if startTime >= endTime {
+		return nil, errors.New("vesting start time must be before the end time")
+	}
+	bva, err := newBaseVestingAccount(acc, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: bva,
+		StartTime:          startTime,
+	}, nil
+}
+
+// Implements VestingAccount
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime int64) sdk.Coins {
+	if blockTime <= cva.StartTime {
+		return nil
+	}
+	if blockTime >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+
+	elapsed := sdk.NewInt(blockTime - cva.StartTime)
+	duration := sdk.NewInt(cva.EndTime - cva.StartTime)
+	var vested sdk.Coins
+	for _, coin := range cva.OriginalVesting {
+		amt := coin.Amount.Mul(elapsed).Div(duration)
+		if !amt.IsZero() {
+			vested = vested.Plus(sdk.Coins{{Denom: coin.Denom, Amount: amt}})
+		}
+	}
+	return vested
+}
+
+// Implements VestingAccount
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime int64) sdk.Coins {
+	return cva.OriginalVesting.Minus(cva.GetVestedCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime int64) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime int64, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// Implements VestingAccount
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+//-----------------------------------------------------------
+// DelayedVestingAccount
+
+var _ VestingAccount = (*DelayedVestingAccount)(nil)
+
+// DelayedVestingAccount vests all of its coins at once at EndTime.
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccount returns an account vesting all of its coins at the
+// end time.
+func NewDelayedVestingAccount(acc *BaseAccount, endTime int64) (*DelayedVestingAccount, error) {
+	bva, err := newBaseVestingAccount(acc, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return &DelayedVestingAccount{bva}, nil
+}
+
+// Implements VestingAccount
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime int64) sdk.Coins {
+	if blockTime >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+	return nil
+}
+
+// Implements VestingAccount
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime int64) sdk.Coins {
+	return dva.OriginalVesting.Minus(dva.GetVestedCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (dva DelayedVestingAccount) SpendableCoins(blockTime int64) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// Implements VestingAccount
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime int64, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// Implements VestingAccount
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
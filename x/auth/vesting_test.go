@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestContinuousVestingAccountVesting(t *testing.T) {
+	_, _, addr := keyPubAddr()
+	baseAcc := NewBaseAccountWithAddress(addr)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("fee", 1000), sdk.NewCoin("steak", 100)})
+
+	_, err := NewContinuousVestingAccount(&baseAcc, 200, 100)
+	require.NotNil(t, err)
+
+	cva, err := NewContinuousVestingAccount(&baseAcc, 100, 200)
+	require.Nil(t, err)
+	require.True(t, cva.GetOriginalVesting().IsEqual(baseAcc.Coins))
+
+	// nothing is vested before the start time
+	require.Nil(t, cva.GetVestedCoins(100))
+	require.True(t, cva.GetVestingCoins(50).IsEqual(cva.GetOriginalVesting()))
+	require.True(t, cva.SpendableCoins(100).IsZero())
+
+	// coins vest linearly
+	require.True(t, cva.GetVestedCoins(125).IsEqual(sdk.Coins{sdk.NewCoin("fee", 250), sdk.NewCoin("steak", 25)}))
+	require.True(t, cva.GetVestingCoins(125).IsEqual(sdk.Coins{sdk.NewCoin("fee", 750), sdk.NewCoin("steak", 75)}))
+	require.True(t, cva.SpendableCoins(125).IsEqual(sdk.Coins{sdk.NewCoin("fee", 250), sdk.NewCoin("steak", 25)}))
+
+	// everything is vested at the end time
+	require.True(t, cva.GetVestedCoins(200).IsEqual(cva.GetOriginalVesting()))
+	require.True(t, cva.GetVestingCoins(300).IsZero())
+	require.True(t, cva.SpendableCoins(200).IsEqual(cva.GetCoins()))
+
+	// received coins can be spent right away
+	cva.SetCoins(cva.GetCoins().Plus(sdk.Coins{sdk.NewCoin("steak", 50)}))
+	require.True(t, cva.SpendableCoins(125).IsEqual(sdk.Coins{sdk.NewCoin("fee", 250), sdk.NewCoin("steak", 75)}))
+}
+
+func TestDelayedVestingAccountVesting(t *testing.T) {
+	_, _, addr := keyPubAddr()
+	baseAcc := NewBaseAccountWithAddress(addr)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("steak", 100)})
+
+	dva, err := NewDelayedVestingAccount(&baseAcc, 200)
+	require.Nil(t, err)
+
+	require.Nil(t, dva.GetVestedCoins(199))
+	require.True(t, dva.SpendableCoins(199).IsZero())
+	require.True(t, dva.GetVestedCoins(200).IsEqual(dva.GetOriginalVesting()))
+	require.True(t, dva.SpendableCoins(200).IsEqual(sdk.Coins{sdk.NewCoin("steak", 100)}))
+}
+
+func TestVestingAccountTrackDelegation(t *testing.T) {
+	_, _, addr := keyPubAddr()
+	baseAcc := NewBaseAccountWithAddress(addr)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("steak", 100)})
+	cva, err := NewContinuousVestingAccount(&baseAcc, 100, 200)
+	require.Nil(t, err)
+
+	// delegating locked coins tracks them as delegated vesting
+	cva.TrackDelegation(150, sdk.Coins{sdk.NewCoin("steak", 40)})
+	cva.SetCoins(sdk.Coins{sdk.NewCoin("steak", 60)})
+	require.True(t, cva.GetDelegatedVesting().IsEqual(sdk.Coins{sdk.NewCoin("steak", 40)}))
+	require.Nil(t, cva.GetDelegatedFree())
+
+	// the vested coins can still be spent
+	require.True(t, cva.SpendableCoins(150).IsEqual(sdk.Coins{sdk.NewCoin("steak", 50)}))
+
+	// delegating more than the remaining vesting coins tracks the rest as free
+	cva.TrackDelegation(150, sdk.Coins{sdk.NewCoin("steak", 20)})
+	cva.SetCoins(sdk.Coins{sdk.NewCoin("steak", 40)})
+	require.True(t, cva.GetDelegatedVesting().IsEqual(sdk.Coins{sdk.NewCoin("steak", 50)}))
+	require.True(t, cva.GetDelegatedFree().IsEqual(sdk.Coins{sdk.NewCoin("steak", 10)}))
+	require.True(t, cva.SpendableCoins(150).IsEqual(sdk.Coins{sdk.NewCoin("steak", 40)}))
+
+	// free coins are undelegated first
+	cva.TrackUndelegation(sdk.Coins{sdk.NewCoin("steak", 30)})
+	cva.SetCoins(sdk.Coins{sdk.NewCoin("steak", 70)})
+	require.True(t, cva.GetDelegatedFree().IsZero())
+	require.True(t, cva.GetDelegatedVesting().IsEqual(sdk.Coins{sdk.NewCoin("steak", 30)}))
+	require.True(t, cva.SpendableCoins(150).IsEqual(sdk.Coins{sdk.NewCoin("steak", 50)}))
+
+	// can't delegate more coins than the account holds
+	require.Panics(t, func() {
+		cva.TrackDelegation(150, sdk.Coins{sdk.NewCoin("steak", 71)})
+	})
+}
+
+func TestVestingAccountSerialization(t *testing.T) {
+	_, _, addr := keyPubAddr()
+	baseAcc := NewBaseAccountWithAddress(addr)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("steak", 100)})
+	cva, err := NewContinuousVestingAccount(&baseAcc, 100, 200)
+	require.Nil(t, err)
+	cva.TrackDelegation(150, sdk.Coins{sdk.NewCoin("steak", 10)})
+
+	cdc := wire.NewCodec()
+	RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	var acc Account = cva
+	bz, err := cdc.MarshalBinaryBare(acc)
+	require.Nil(t, err)
+
+	var acc2 Account
+	err = cdc.UnmarshalBinaryBare(bz, &acc2)
+	require.Nil(t, err)
+	require.Equal(t, cva, acc2)
+
+	_, ok := acc2.(VestingAccount)
+	require.True(t, ok)
+}
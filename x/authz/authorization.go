@@ -0,0 +1,166 @@
+package authz
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// MsgName returns the name identifying the type of msg in authorizations,
+// made of its route and Go type name, eg. "bank/MsgSend".
+func MsgName(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Type(), reflect.Indirect(reflect.ValueOf(msg)).Type().Name())
+}
+
+// Authorization is a permission given by a granter to a grantee to execute a
+// type of Msg on its behalf.
+type Authorization interface {
+	// MsgName is the name of the type of Msg authorized, see MsgName
+	MsgName() string
+
+	// Accept checks the msg is within the limits of the authorization and
+	// deducts it from them, or returns an error if the msg isn't allowed. It
+	// returns remove if the authorization is used up and should be deleted.
+	Accept(msg sdk.Msg) (remove bool, err sdk.Error)
+
+	// ValidateBasic checks the authorization is well formed
+	ValidateBasic() sdk.Error
+}
+
+//-----------------------------------------------------------
+// GenericAuthorization
+
+var _ Authorization = (*GenericAuthorization)(nil)
+
+// GenericAuthorization allows any Msg of the given type, without limits.
+type GenericAuthorization struct {
+	Msg string `json:"msg"`
+}
+
+// Implements Authorization
+func (a GenericAuthorization) MsgName() string {
+	return a.Msg
+}
+
+// Implements Authorization
+func (a *GenericAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	return false, nil
+}
+
+// Implements Authorization
+func (a GenericAuthorization) ValidateBasic() sdk.Error {
+	if a.Msg == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing msg name")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------
+// SendAuthorization
+
+var _ Authorization = (*SendAuthorization)(nil)
+
+// SendAuthorization allows sending coins up to SpendLimit in total.
+type SendAuthorization struct {
+	SpendLimit sdk.Coins `json:"spend_limit"`
+}
+
+// Implements Authorization
+func (a SendAuthorization) MsgName() string {
+	return MsgName(bank.MsgSend{})
+}
+
+// Implements Authorization
+func (a *SendAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	send, ok := msg.(bank.MsgSend)
+	if !ok {
+		return false, ErrUnauthorized(DefaultCodespace, "msg is not a send")
+	}
+
+	var amount sdk.Coins
+	for _, in := range send.Inputs {
+		amount = amount.Plus(in.Coins)
+	}
+	left := a.SpendLimit.Minus(amount)
+	if !left.IsNotNegative() {
+		return false, ErrUnauthorized(DefaultCodespace, fmt.Sprintf("sending %s exceeds the spend limit %s", amount, a.SpendLimit))
+	}
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+// Implements Authorization
+func (a SendAuthorization) ValidateBasic() sdk.Error {
+	if !a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive() {
+		return ErrInvalidAuthorization(DefaultCodespace, "spend limit must be positive")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------
+// DelegateAuthorization
+
+var _ Authorization = (*DelegateAuthorization)(nil)
+
+// DelegateAuthorization allows delegating to the AllowedValidators, to any
+// validator if empty, up to MaxAmount in total, unlimited if nil.
+type DelegateAuthorization struct {
+	AllowedValidators []sdk.AccAddress `json:"allowed_validators"`
+	MaxAmount         sdk.Coins        `json:"max_amount"`
+}
+
+// Implements Authorization
+func (a DelegateAuthorization) MsgName() string {
+	return MsgName(stake.MsgDelegate{})
+}
+
+// Implements Authorization
+func (a *DelegateAuthorization) Accept(msg sdk.Msg) (bool, sdk.Error) {
+	delegate, ok := msg.(stake.MsgDelegate)
+	if !ok {
+		return false, ErrUnauthorized(DefaultCodespace, "msg is not a delegation")
+	}
+	if !a.isAllowed(delegate.ValidatorAddr) {
+		return false, ErrUnauthorized(DefaultCodespace, fmt.Sprintf("delegating to %s is not allowed", delegate.ValidatorAddr))
+	}
+	if a.MaxAmount == nil {
+		return false, nil
+	}
+
+	amount := sdk.Coins{delegate.Delegation}
+	left := a.MaxAmount.Minus(amount)
+	if !left.IsNotNegative() {
+		return false, ErrUnauthorized(DefaultCodespace, fmt.Sprintf("delegating %s exceeds the max amount %s", amount, a.MaxAmount))
+	}
+	a.MaxAmount = left
+	return left.IsZero(), nil
+}
+
+func (a DelegateAuthorization) isAllowed(validator sdk.AccAddress) bool {
+	if len(a.AllowedValidators) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowedValidators {
+		if bytes.Equal(allowed, validator) {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements Authorization
+func (a DelegateAuthorization) ValidateBasic() sdk.Error {
+	for _, validator := range a.AllowedValidators {
+		if len(validator) == 0 {
+			return ErrInvalidAuthorization(DefaultCodespace, "empty validator address")
+		}
+	}
+	if a.MaxAmount != nil && (!a.MaxAmount.IsValid() || !a.MaxAmount.IsPositive()) {
+		return ErrInvalidAuthorization(DefaultCodespace, "max amount must be positive")
+	}
+	return nil
+}
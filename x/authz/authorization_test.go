@@ -0,0 +1,79 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func newSend(from, to sdk.AccAddress, amt int64) bank.MsgSend {
+	coins := sdk.Coins{sdk.NewCoin("atom", amt)}
+	return bank.NewMsgSend([]bank.Input{bank.NewInput(from, coins)}, []bank.Output{bank.NewOutput(to, coins)})
+}
+
+func TestMsgName(t *testing.T) {
+	require.Equal(t, "bank/MsgSend", MsgName(bank.MsgSend{}))
+	require.Equal(t, "stake/MsgDelegate", MsgName(stake.MsgDelegate{}))
+	require.Equal(t, "authz/MsgExec", MsgName(MsgExec{}))
+}
+
+func TestSendAuthorization(t *testing.T) {
+	from := sdk.AccAddress([]byte("from"))
+	to := sdk.AccAddress([]byte("to"))
+
+	auth := &SendAuthorization{SpendLimit: sdk.Coins{sdk.NewCoin("atom", 100)}}
+	require.Nil(t, auth.ValidateBasic())
+
+	remove, err := auth.Accept(newSend(from, to, 40))
+	require.Nil(t, err)
+	require.False(t, remove)
+	require.True(t, auth.SpendLimit.IsEqual(sdk.Coins{sdk.NewCoin("atom", 60)}))
+
+	// over the limit
+	_, err = auth.Accept(newSend(from, to, 61))
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnauthorized, err.Code())
+	require.True(t, auth.SpendLimit.IsEqual(sdk.Coins{sdk.NewCoin("atom", 60)}))
+
+	// other msgs
+	_, err = auth.Accept(stake.NewMsgDelegate(from, to, sdk.NewCoin("atom", 1)))
+	require.NotNil(t, err)
+
+	// used up
+	remove, err = auth.Accept(newSend(from, to, 60))
+	require.Nil(t, err)
+	require.True(t, remove)
+
+	require.NotNil(t, SendAuthorization{}.ValidateBasic())
+}
+
+func TestDelegateAuthorization(t *testing.T) {
+	del := sdk.AccAddress([]byte("delegator"))
+	val1 := sdk.AccAddress([]byte("validator1"))
+	val2 := sdk.AccAddress([]byte("validator2"))
+
+	auth := &DelegateAuthorization{AllowedValidators: []sdk.AccAddress{val1}}
+	require.Nil(t, auth.ValidateBasic())
+
+	// any amount to allowed validators only
+	remove, err := auth.Accept(stake.NewMsgDelegate(del, val1, sdk.NewCoin("steak", 1000)))
+	require.Nil(t, err)
+	require.False(t, remove)
+	_, err = auth.Accept(stake.NewMsgDelegate(del, val2, sdk.NewCoin("steak", 1)))
+	require.NotNil(t, err)
+	require.Equal(t, CodeUnauthorized, err.Code())
+
+	// any validator up to the max amount
+	auth = &DelegateAuthorization{MaxAmount: sdk.Coins{sdk.NewCoin("steak", 10)}}
+	_, err = auth.Accept(stake.NewMsgDelegate(del, val2, sdk.NewCoin("steak", 11)))
+	require.NotNil(t, err)
+	remove, err = auth.Accept(stake.NewMsgDelegate(del, val2, sdk.NewCoin("steak", 10)))
+	require.Nil(t, err)
+	require.True(t, remove)
+
+	require.NotNil(t, DelegateAuthorization{AllowedValidators: []sdk.AccAddress{nil}}.ValidateBasic())
+}
@@ -0,0 +1,8 @@
+package cli
+
+// nolint
+const (
+	FlagSpendLimit = "spend-limit"
+	FlagValidators = "validators"
+	FlagExpiration = "expiration"
+)
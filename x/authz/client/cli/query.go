@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// GetCmdQueryAuthorization returns the command to query an authorization grant
+func GetCmdQueryAuthorization(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorization [granter] [grantee] [msg-name]",
+		Short: "Query the authorization granted by an account to another for a type of msg",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper()
+			res, err := ctx.QueryStore(authz.GetGrantKey(granter, grantee, args[2]), storeName)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.Errorf("%s is not authorized to execute %s for %s", grantee, args[2], granter)
+			}
+
+			var grant authz.AuthorizationGrant
+			cdc.MustUnmarshalBinary(res, &grant)
+			output, err := wire.MarshalJSONIndent(cdc, grant)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// GetCmdGrantAuthorization returns the command to grant an authorization
+func GetCmdGrantAuthorization(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [msg-name]",
+		Short: "Grant an account an authorization to execute a type of msg on your behalf",
+		Long: `Grant an account an authorization to execute a type of msg on your behalf,
+until the --expiration unix time if set. The msg name is made of the msg route
+and type, eg. bank/MsgSend or stake/MsgDelegate. Sends can be limited to
+--spend-limit in total, delegations to --spend-limit in total and to the
+--validators comma separated list of validator addresses.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
+			granter, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			authorization, err := buildAuthorization(ctx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgGrantAuthorization(granter, grantee, authorization, viper.GetInt64(FlagExpiration))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	cmd.Flags().String(FlagSpendLimit, "", "Total amount the grantee may send or delegate, unlimited if not set")
+	cmd.Flags().String(FlagValidators, "", "Comma separated validator addresses the grantee may delegate to, any if not set")
+	cmd.Flags().Int64(FlagExpiration, 0, "Unix time the authorization expires at, never if not set")
+	return cmd
+}
+
+// build the authorization of the msg type from the flags
+func buildAuthorization(ctx context.CoreContext, msgName string) (authz.Authorization, error) {
+	var spendLimit sdk.Coins
+	if limit := viper.GetString(FlagSpendLimit); limit != "" {
+		coins, err := ctx.ParseCoins(limit)
+		if err != nil {
+			return nil, err
+		}
+		spendLimit = coins
+	}
+	var validators []sdk.AccAddress
+	if vals := viper.GetString(FlagValidators); vals != "" {
+		for _, val := range strings.Split(vals, ",") {
+			addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(val))
+			if err != nil {
+				return nil, err
+			}
+			validators = append(validators, addr)
+		}
+	}
+
+	switch {
+	case msgName == authz.MsgName(bank.MsgSend{}) && spendLimit != nil:
+		return &authz.SendAuthorization{SpendLimit: spendLimit}, nil
+	case msgName == authz.MsgName(stake.MsgDelegate{}) && (spendLimit != nil || validators != nil):
+		return &authz.DelegateAuthorization{AllowedValidators: validators, MaxAmount: spendLimit}, nil
+	default:
+		return &authz.GenericAuthorization{Msg: msgName}, nil
+	}
+}
+
+// GetCmdRevokeAuthorization returns the command to revoke an authorization
+func GetCmdRevokeAuthorization(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-name]",
+		Short: "Revoke the authorization of an account to execute a type of msg on your behalf",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granter, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgRevokeAuthorization(granter, grantee, args[1])
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// GetCmdExec returns the command to execute msgs on behalf of other accounts
+func GetCmdExec(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [file]",
+		Short: "Execute the msgs of a transaction on behalf of their signers",
+		Long: `Execute the msgs of a transaction generated with --generate-only on behalf
+of their signers, which must have authorized you to execute them.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var stdTx auth.StdTx
+			err = cdc.UnmarshalJSON(bz, &stdTx)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := authz.NewMsgExec(grantee, stdTx.GetMsgs())
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,42 @@
+//nolint
+package authz
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default authz codespace
+	DefaultCodespace sdk.CodespaceType = 12
+
+	CodeInvalidAuthorization CodeType = 101
+	CodeNoAuthorization      CodeType = 102
+	CodeUnauthorized         CodeType = 103
+	CodeAuthorizationExpired CodeType = 104
+	CodeInvalidGrant         CodeType = 105
+	CodeNoMsgs               CodeType = 106
+)
+
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, msg)
+}
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress, msgName string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization, fmt.Sprintf("%s is not authorized to execute %s for %s", grantee, msgName, granter))
+}
+func ErrUnauthorized(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, msg)
+}
+func ErrAuthorizationExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAuthorizationExpired, "authorization expired")
+}
+func ErrInvalidGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGrant, msg)
+}
+func ErrNoMsgs(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoMsgs, "no messages to execute")
+}
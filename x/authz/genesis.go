@@ -0,0 +1,49 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the authorization grants at genesis
+type GenesisState struct {
+	Grants []AuthorizationGrant `json:"grants"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis checks the authorization grants as the msgs granting them
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.Grants {
+		msg := NewMsgGrantAuthorization(grant.Granter, grant.Grantee, grant.Authorization, grant.Expiration)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis - store the genesis authorization grants
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	for _, grant := range data.Grants {
+		k.Grant(ctx, grant)
+	}
+	return nil
+}
+
+// WriteGenesis - output the authorization grants
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var grants []AuthorizationGrant
+	k.IterateGrants(ctx, func(grant AuthorizationGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return GenesisState{
+		Grants: grants,
+	}
+}
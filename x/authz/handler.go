@@ -0,0 +1,70 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns a handler for "authz" type messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgGrantAuthorization:
+			return handleMsgGrantAuthorization(ctx, msg, k)
+		case MsgRevokeAuthorization:
+			return handleMsgRevokeAuthorization(ctx, msg, k)
+		case MsgExec:
+			return handleMsgExec(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in authz module").Result()
+		}
+	}
+}
+
+func handleMsgGrantAuthorization(ctx sdk.Context, msg MsgGrantAuthorization, k Keeper) sdk.Result {
+	k.Grant(ctx, AuthorizationGrant{
+		Granter:       msg.Granter,
+		Grantee:       msg.Grantee,
+		Authorization: msg.Authorization,
+		Expiration:    msg.Expiration,
+	})
+
+	tags := sdk.NewTags(
+		"action", []byte("grantAuthorization"),
+		"granter", []byte(msg.Granter.String()),
+		"grantee", []byte(msg.Grantee.String()),
+		"msg", []byte(msg.Authorization.MsgName()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgRevokeAuthorization(ctx sdk.Context, msg MsgRevokeAuthorization, k Keeper) sdk.Result {
+	err := k.Revoke(ctx, msg.Granter, msg.Grantee, msg.MsgName)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		"action", []byte("revokeAuthorization"),
+		"granter", []byte(msg.Granter.String()),
+		"grantee", []byte(msg.Grantee.String()),
+		"msg", []byte(msg.MsgName),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgExec(ctx sdk.Context, msg MsgExec, k Keeper) sdk.Result {
+	res := k.DispatchActions(ctx, msg.Grantee, msg.Msgs)
+	if !res.IsOK() {
+		return res
+	}
+
+	res.Tags = sdk.NewTags(
+		"action", []byte("exec"),
+		"grantee", []byte(msg.Grantee.String()),
+	).AppendTags(res.Tags)
+	return res
+}
@@ -0,0 +1,166 @@
+package authz
+
+import (
+	"bytes"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// nolint
+var (
+	GrantKeyPrefix = []byte{0x00} // prefix for each key to an authorization grant
+)
+
+// GetGrantKey returns the key of the grant from granter to grantee for the
+// msg type
+func GetGrantKey(granter, grantee sdk.AccAddress, msgName string) []byte {
+	return append(GetGranteeGrantsKey(granter, grantee), []byte(msgName)...)
+}
+
+// GetGranteeGrantsKey returns the prefix of the keys of all the grants from
+// granter to grantee
+func GetGranteeGrantsKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetGrantsKey(granter), grantee.Bytes()...)
+}
+
+// GetGrantsKey returns the prefix of the keys of all the grants of granter
+func GetGrantsKey(granter sdk.AccAddress) []byte {
+	return append(GrantKeyPrefix, granter.Bytes()...)
+}
+
+// AuthorizationGrant is an authorization given by a granter to a grantee
+// until the Expiration time, zero never expires.
+type AuthorizationGrant struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    int64          `json:"expiration"` // unix time
+}
+
+func (grant AuthorizationGrant) isExpired(blockTime int64) bool {
+	return grant.Expiration != 0 && blockTime >= grant.Expiration
+}
+
+// Keeper of the authz store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+	router   baseapp.Router
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates an authz keeper, the router dispatches the messages
+// executed on behalf of granters
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, router baseapp.Router, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		router:    router,
+		codespace: codespace,
+	}
+}
+
+// Grant sets the authorization of grantee from granter, overriding any
+// previous authorization for the same msg type
+func (k Keeper) Grant(ctx sdk.Context, grant AuthorizationGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinary(grant)
+	store.Set(GetGrantKey(grant.Granter, grant.Grantee, grant.Authorization.MsgName()), bz)
+}
+
+// Revoke removes the authorization of grantee from granter for the msg type
+func (k Keeper) Revoke(ctx sdk.Context, granter, grantee sdk.AccAddress, msgName string) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := GetGrantKey(granter, grantee, msgName)
+	if !store.Has(key) {
+		return ErrNoAuthorization(k.codespace, granter, grantee, msgName)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetGrant returns the grant from granter to grantee for the msg type
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress, msgName string) (grant AuthorizationGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetGrantKey(granter, grantee, msgName))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &grant)
+	return grant, true
+}
+
+// IterateGrants iterates over all the grants, stopping when fn returns true
+func (k Keeper) IterateGrants(ctx sdk.Context, fn func(grant AuthorizationGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, GrantKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant AuthorizationGrant
+		k.cdc.MustUnmarshalBinary(iter.Value(), &grant)
+		if fn(grant) {
+			break
+		}
+	}
+}
+
+// DispatchActions executes the msgs on behalf of their signers, which must
+// be the grantee or have authorized it to execute them. The authorizations
+// used up are removed.
+func (k Keeper) DispatchActions(ctx sdk.Context, grantee sdk.AccAddress, msgs []sdk.Msg) sdk.Result {
+	var data []byte
+	tags := sdk.EmptyTags()
+	for _, msg := range msgs {
+		for _, granter := range msg.GetSigners() {
+			if bytes.Equal(granter, grantee) {
+				continue
+			}
+			if err := k.useAuthorization(ctx, granter, grantee, msg); err != nil {
+				return err.Result()
+			}
+		}
+
+		handler := k.router.Route(msg.Type())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("Unrecognized Msg type: " + msg.Type()).Result()
+		}
+		res := handler(ctx, msg)
+		if !res.IsOK() {
+			return res
+		}
+		data = append(data, res.Data...)
+		tags = tags.AppendTags(res.Tags)
+	}
+	return sdk.Result{
+		Data: data,
+		Tags: tags,
+	}
+}
+
+// check the msg against the authorization of grantee from granter and
+// update or remove the authorization
+func (k Keeper) useAuthorization(ctx sdk.Context, granter, grantee sdk.AccAddress, msg sdk.Msg) sdk.Error {
+	msgName := MsgName(msg)
+	grant, found := k.GetGrant(ctx, granter, grantee, msgName)
+	if !found {
+		return ErrNoAuthorization(k.codespace, granter, grantee, msgName)
+	}
+	if grant.isExpired(ctx.BlockHeader().Time) {
+		return ErrAuthorizationExpired(k.codespace)
+	}
+
+	remove, err := grant.Authorization.Accept(msg)
+	if err != nil {
+		return err
+	}
+	if remove {
+		ctx.KVStore(k.storeKey).Delete(GetGrantKey(granter, grantee, msgName))
+	} else {
+		k.Grant(ctx, grant)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// setup a keeper routing bank msgs to a handler recording them
+func setupKeeper() (sdk.Context, Keeper, *[]sdk.Msg) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey("authz")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	cdc := wire.NewCodec()
+	RegisterWire(cdc)
+
+	var handled []sdk.Msg
+	router := baseapp.NewRouter()
+	router.AddRoute("bank", func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		handled = append(handled, msg)
+		return sdk.Result{Tags: sdk.NewTags("action", []byte("send"))}
+	})
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: 100}, false, log.NewNopLogger())
+	return ctx, NewKeeper(cdc, key, router, DefaultCodespace), &handled
+}
+
+func TestKeeperDispatchActions(t *testing.T) {
+	ctx, keeper, handled := setupKeeper()
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	other := sdk.AccAddress([]byte("other"))
+
+	// not authorized
+	res := keeper.DispatchActions(ctx, grantee, []sdk.Msg{newSend(granter, other, 10)})
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNoAuthorization), res.Code)
+	require.Len(t, *handled, 0)
+
+	keeper.Grant(ctx, AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: &SendAuthorization{SpendLimit: sdk.Coins{sdk.NewCoin("atom", 100)}},
+	})
+
+	// msgs signed by the grantee itself don't need an authorization
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{newSend(granter, other, 40), newSend(grantee, other, 500)})
+	require.True(t, res.IsOK())
+	require.Len(t, *handled, 2)
+	grant, found := keeper.GetGrant(ctx, granter, grantee, "bank/MsgSend")
+	require.True(t, found)
+	require.True(t, grant.Authorization.(*SendAuthorization).SpendLimit.IsEqual(sdk.Coins{sdk.NewCoin("atom", 60)}))
+
+	// over the limit
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{newSend(granter, other, 61)})
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeUnauthorized), res.Code)
+	require.Len(t, *handled, 2)
+
+	// used up authorizations are removed
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{newSend(granter, other, 60)})
+	require.True(t, res.IsOK())
+	_, found = keeper.GetGrant(ctx, granter, grantee, "bank/MsgSend")
+	require.False(t, found)
+
+	// expired
+	keeper.Grant(ctx, AuthorizationGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: &GenericAuthorization{Msg: MsgName(bank.MsgSend{})},
+		Expiration:    100,
+	})
+	res = keeper.DispatchActions(ctx, grantee, []sdk.Msg{newSend(granter, other, 1)})
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeAuthorizationExpired), res.Code)
+
+	// revoke
+	require.Nil(t, keeper.Revoke(ctx, granter, grantee, "bank/MsgSend"))
+	require.NotNil(t, keeper.Revoke(ctx, granter, grantee, "bank/MsgSend"))
+}
+
+func TestAuthzGenesis(t *testing.T) {
+	ctx, keeper, _ := setupKeeper()
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+
+	genesis := GenesisState{
+		Grants: []AuthorizationGrant{{
+			Granter:       granter,
+			Grantee:       grantee,
+			Authorization: &GenericAuthorization{Msg: "stake/MsgDelegate"},
+			Expiration:    1000,
+		}},
+	}
+	require.Nil(t, InitGenesis(ctx, keeper, genesis))
+	require.Equal(t, genesis, WriteGenesis(ctx, keeper))
+
+	// self grants are invalid
+	genesis.Grants[0].Grantee = granter
+	require.NotNil(t, InitGenesis(ctx, keeper, genesis))
+}
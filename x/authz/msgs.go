@@ -0,0 +1,167 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "authz"
+
+// verify interface at compile time
+var _, _, _ sdk.Msg = MsgGrantAuthorization{}, MsgRevokeAuthorization{}, MsgExec{}
+
+//______________________________________________________________________
+
+// MsgGrantAuthorization - grants the grantee an authorization to execute a
+// type of msg on behalf of the granter
+type MsgGrantAuthorization struct {
+	Granter       sdk.AccAddress `json:"granter"`
+	Grantee       sdk.AccAddress `json:"grantee"`
+	Authorization Authorization  `json:"authorization"`
+	Expiration    int64          `json:"expiration"`
+}
+
+func NewMsgGrantAuthorization(granter, grantee sdk.AccAddress, authorization Authorization, expiration int64) MsgGrantAuthorization {
+	return MsgGrantAuthorization{
+		Granter:       granter,
+		Grantee:       grantee,
+		Authorization: authorization,
+		Expiration:    expiration,
+	}
+}
+
+//nolint
+func (msg MsgGrantAuthorization) Type() string                 { return MsgType }
+func (msg MsgGrantAuthorization) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Granter} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	if len(msg.Granter) == 0 {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if len(msg.Grantee) == 0 {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if bytes.Equal(msg.Granter, msg.Grantee) {
+		return ErrInvalidGrant(DefaultCodespace, "cannot grant an authorization to self")
+	}
+	if msg.Expiration < 0 {
+		return ErrInvalidGrant(DefaultCodespace, "expiration must not be negative")
+	}
+	if msg.Authorization == nil {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing authorization")
+	}
+	return msg.Authorization.ValidateBasic()
+}
+
+//______________________________________________________________________
+
+// MsgRevokeAuthorization - revokes the authorization of the grantee to
+// execute a type of msg
+type MsgRevokeAuthorization struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgName string         `json:"msg_name"`
+}
+
+func NewMsgRevokeAuthorization(granter, grantee sdk.AccAddress, msgName string) MsgRevokeAuthorization {
+	return MsgRevokeAuthorization{
+		Granter: granter,
+		Grantee: grantee,
+		MsgName: msgName,
+	}
+}
+
+//nolint
+func (msg MsgRevokeAuthorization) Type() string                 { return MsgType }
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Granter} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if len(msg.Granter) == 0 {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if len(msg.Grantee) == 0 {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if msg.MsgName == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, "missing msg name")
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// MsgExec - executes the msgs on behalf of their signers, which have
+// authorized the grantee to
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs"`
+}
+
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+//nolint
+func (msg MsgExec) Type() string                 { return MsgType }
+func (msg MsgExec) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Grantee} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgExec) GetSignBytes() []byte {
+	var msgs []json.RawMessage
+	for _, m := range msg.Msgs {
+		msgs = append(msgs, m.GetSignBytes())
+	}
+	b, err := msgCdc.MarshalJSON(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{
+		Grantee: msg.Grantee,
+		Msgs:    msgs,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if len(msg.Grantee) == 0 {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrNoMsgs(DefaultCodespace)
+	}
+	for _, m := range msg.Msgs {
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+const flagMaxSupply = "max-supply"
+
+// an issuance with its supply
+type issuanceOutput struct {
+	Denom     string         `json:"denom"`
+	Issuer    sdk.AccAddress `json:"issuer"`
+	MaxSupply sdk.Int        `json:"max_supply"`
+	Supply    sdk.Int        `json:"supply"`
+}
+
+// GetCmdRegisterDenom returns the command to register a denomination issued
+// by the sender
+func GetCmdRegisterDenom(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [denom]",
+		Short: "Register a new denomination issued by you, up to --max-supply coins",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			issuer, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			maxSupply, ok := sdk.NewIntFromString(viper.GetString(flagMaxSupply))
+			if !ok {
+				return errors.Errorf("invalid max supply %s", viper.GetString(flagMaxSupply))
+			}
+
+			msg := bank.NewMsgRegisterDenom(issuer, args[0], maxSupply)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	cmd.Flags().String(flagMaxSupply, "0", "Max supply of the denomination, unlimited if 0")
+	return cmd
+}
+
+// GetCmdIssue returns the command to mint coins of denominations issued by
+// the sender
+func GetCmdIssue(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue [to] [amount]",
+		Short: "Mint coins of denominations issued by you to an account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
+			issuer, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coins, err := ctx.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := bank.NewMsgIssue(issuer, []bank.Output{bank.NewOutput(to, coins)})
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// GetCmdBurn returns the command to burn issued coins of the sender
+func GetCmdBurn(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn [amount]",
+		Short: "Burn coins of issued denominations you own",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
+			owner, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			coins, err := ctx.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := bank.NewMsgBurn(owner, coins)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// GetCmdTransferIssuer returns the command to hand the issuance of a
+// denomination to a new issuer
+func GetCmdTransferIssuer(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [denom] [new-issuer]",
+		Short: "Hand the issuance of a denomination issued by you to a new issuer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			issuer, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			newIssuer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := bank.NewMsgTransferIssuer(issuer, args[0], newIssuer)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryIssuance returns the command to query the issuance and supply
+// of an issued denomination
+func GetCmdQueryIssuance(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuance [denom]",
+		Short: "Query the issuer, max supply and supply of an issued denomination",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+
+			res, err := ctx.QueryStore(bank.IssuanceKey(args[0]), storeName)
+			if err != nil {
+				return err
+			}
+			if res == nil {
+				return errors.Errorf("denomination %s isn't issued", args[0])
+			}
+			var issuance bank.Issuance
+			cdc.MustUnmarshalBinary(res, &issuance)
+
+			supply := sdk.ZeroInt()
+			res, err = ctx.QueryStore(bank.SupplyKey(args[0]), storeName)
+			if err != nil {
+				return err
+			}
+			if res != nil {
+				cdc.MustUnmarshalBinary(res, &supply)
+			}
+
+			output, err := wire.MarshalJSONIndent(cdc, issuanceOutput{
+				Denom:     issuance.Denom,
+				Issuer:    issuance.Issuer,
+				MaxSupply: issuance.MaxSupply,
+				Supply:    supply,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	return cmd
+}
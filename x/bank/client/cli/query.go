@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
+)
+
+// GetCmdQueryDenoms returns the command to query the registered denominations
+func GetCmdQueryDenoms(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denoms",
+		Short: "Query the registered coin denominations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+			metadata, err := client.QueryDenomMetadata(ctx, cdc, storeName)
+			if err != nil {
+				return err
+			}
+
+			switch viper.Get(cli.OutputFlag) {
+			case "text":
+				for _, meta := range metadata {
+					fmt.Printf("%s: 1%s = 10^%d%s %s\n", meta.Display, meta.Display, meta.Exponent, meta.Base, meta.Description)
+				}
+			case "json":
+				output, err := wire.MarshalJSONIndent(cdc, metadata)
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(output))
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryBalance returns the command to query the balance of an account,
+// rendered in the display units of the registered denominations
+func GetCmdQueryBalance(storeName string, accStoreName string, cdc *wire.Codec, decoder auth.AccountDecoder) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance [address]",
+		Short: "Query account balance in display units",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper()
+			res, err := ctx.QueryStore(auth.AddressStoreKey(addr), accStoreName)
+			if err != nil {
+				return err
+			}
+			if res == nil {
+				return errors.Errorf("No account with address %s was found in the state.\nAre you sure there has been a transaction involving it?", addr)
+			}
+			account, err := decoder(res)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := client.QueryDenomMetadata(ctx, cdc, storeName)
+			if err != nil {
+				return err
+			}
+			fmt.Println(sdk.DisplayCoins(account.GetCoins(), metadata))
+			return nil
+		},
+	}
+	return cmd
+}
+
+// GetCmdQuerySupply returns the command to query the total and circulating
+// supply of the coins
+func GetCmdQuerySupply(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply",
+		Short: "Query the total and circulating supply of the coins",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper()
+			res, err := ctx.QueryWithData(fmt.Sprintf("/custom/bank/%s", bank.QuerySupply), nil)
+			if err != nil {
+				return err
+			}
+
+			var supply bank.SupplyResponse
+			err = cdc.UnmarshalJSON(res, &supply)
+			if err != nil {
+				return err
+			}
+			output, err := wire.MarshalJSONIndent(cdc, supply)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -24,7 +24,10 @@ func SendTxCmd(cdc *wire.Codec) *cobra.Command {
 		Use:   "send",
 		Short: "Create and sign a send tx",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
 
 			// get the from/to address
 			from, err := ctx.GetFromAddress()
@@ -50,7 +53,7 @@ func SendTxCmd(cdc *wire.Codec) *cobra.Command {
 			}
 			// parse coins trying to be sent
 			amount := viper.GetString(flagAmount)
-			coins, err := sdk.ParseCoins(amount)
+			coins, err := ctx.ParseCoins(amount)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,174 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
+)
+
+// RegisterQueryRoutes registers the bank query routes, storeName is the bank
+// store and accStoreName the account store
+func RegisterQueryRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec, storeName, accStoreName string) {
+	r.HandleFunc("/bank/denoms", DenomsRequestHandlerFn(storeName, cdc, ctx)).Methods("GET")
+	r.HandleFunc("/bank/balances/{address}", BalanceRequestHandlerFn(storeName, accStoreName, cdc, ctx)).Methods("GET")
+	r.HandleFunc("/bank/supply", SupplyRequestHandlerFn(ctx)).Methods("GET")
+	r.HandleFunc("/bank/history/{address}", HistoryRequestHandlerFn(ctx)).Methods("GET")
+}
+
+// balance of an account in base and display units
+type balance struct {
+	Coins   sdk.Coins `json:"coins"`
+	Display string    `json:"display"`
+}
+
+// DenomsRequestHandlerFn - http request handler to query the registered denominations
+func DenomsRequestHandlerFn(storeName string, cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		metadata, err := client.QueryDenomMetadata(ctx, cdc, storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query denominations. Error: %s", err.Error())))
+			return
+		}
+
+		output, err := cdc.MarshalJSON(metadata)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+// BalanceRequestHandlerFn - http request handler to query the balance of an
+// account in display units
+func BalanceRequestHandlerFn(storeName, accStoreName string, cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	decoder := authcmd.GetAccountDecoder(cdc)
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		res, err := ctx.QueryStore(auth.AddressStoreKey(addr), accStoreName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query account. Error: %s", err.Error())))
+			return
+		}
+
+		// the query will return empty if there is no data for this account
+		if len(res) == 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		account, err := decoder(res)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't parse query result. Result: %s. Error: %s", res, err.Error())))
+			return
+		}
+
+		metadata, err := client.QueryDenomMetadata(ctx, cdc, storeName)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query denominations. Error: %s", err.Error())))
+			return
+		}
+
+		coins := account.GetCoins()
+		output, err := cdc.MarshalJSON(balance{coins, sdk.DisplayCoins(coins, metadata)})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		w.Write(output)
+	}
+}
+
+// SupplyRequestHandlerFn - http request handler to query the total and
+// circulating supply of the coins
+func SupplyRequestHandlerFn(ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := ctx.QueryWithData(fmt.Sprintf("/custom/bank/%s", bank.QuerySupply), nil)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query supply. Error: %s", err.Error())))
+			return
+		}
+
+		// the querier returns the supply JSON encoded
+		w.Write(res)
+	}
+}
+
+// HistoryRequestHandlerFn returns the REST handler querying the page "page"
+// of the transfers from or to the address, newest first, with at most "limit"
+// transfers. It requires a node indexing the history.
+func HistoryRequestHandlerFn(ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		page, err := parseIntParam(r, "page", 1)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		limit, err := parseIntParam(r, "limit", 0)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
+
+		bz, err := json.Marshal(bank.QueryHistoryParams{Address: addr, Page: page, Limit: limit})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(err.Error()))
+			return
+		}
+		res, err := ctx.QueryWithData(fmt.Sprintf("/custom/history/%s", bank.QueryTransfers), bz)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(fmt.Sprintf("couldn't query history. Error: %s", err.Error())))
+			return
+		}
+
+		// the querier returns the history JSON encoded
+		w.Write(res)
+	}
+}
+
+// parse the integer query param, the default if it isn't set
+func parseIntParam(r *http.Request, name string, defaultValue int) (int, error) {
+	s := r.URL.Query().Get(name)
+	if s == "" {
+		return defaultValue, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't parse %s: %s", name, err.Error())
+	}
+	return n, nil
+}
@@ -28,7 +28,7 @@ type sendBody struct {
 	ChainID          string    `json:"chain_id"`
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
-	Gas              int64     `json:"gas"`
+	Gas              string    `json:"gas"`
 }
 
 var msgCdc = wire.NewCodec()
@@ -81,7 +81,12 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.CoreCont
 		}
 
 		// add gas to context
-		ctx = ctx.WithGas(m.Gas)
+		ctx, err = ctx.WithGasSetting(m.Gas)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
 		// add chain-id to context
 		ctx = ctx.WithChainID(m.ChainID)
 
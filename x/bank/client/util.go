@@ -1,7 +1,9 @@
 package client
 
 import (
+	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
 )
 
@@ -12,3 +14,19 @@ func BuildMsg(from sdk.AccAddress, to sdk.AccAddress, coins sdk.Coins) sdk.Msg {
 	msg := bank.NewMsgSend([]bank.Input{input}, []bank.Output{output})
 	return msg
 }
+
+// QueryDenomMetadata returns the metadata of all registered denominations
+func QueryDenomMetadata(ctx context.CoreContext, cdc *wire.Codec, storeName string) ([]sdk.DenomMetadata, error) {
+	resKVs, err := ctx.QuerySubspace(cdc, bank.DenomMetadataKeyPrefix, storeName)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make([]sdk.DenomMetadata, len(resKVs))
+	for i, kv := range resKVs {
+		err = cdc.UnmarshalBinary(kv.Value, &metadata[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return metadata, nil
+}
@@ -0,0 +1,146 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+var (
+	DenomFormatKey         = []byte("denomFormat")
+	DenomMetadataKeyPrefix = []byte("denomMetadata:")
+)
+
+// DenomMetadataKey returns the store key of the metadata of a base denomination
+func DenomMetadataKey(base string) []byte {
+	return append(DenomMetadataKeyPrefix, []byte(base)...)
+}
+
+// DenomKeeper manages the registry of denomination metadata and the
+// denomination format accepted by the chain
+type DenomKeeper struct {
+	key       sdk.StoreKey
+	cdc       *wire.Codec
+	codespace sdk.CodespaceType
+}
+
+// NewDenomKeeper returns a new DenomKeeper
+func NewDenomKeeper(cdc *wire.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) DenomKeeper {
+	return DenomKeeper{
+		key:       key,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+}
+
+// GetDenomFormat returns the regex denominations must match,
+// sdk.DefaultDenomRegex if none was set.
+func (keeper DenomKeeper) GetDenomFormat(ctx sdk.Context) string {
+	store := ctx.KVStore(keeper.key)
+	bz := store.Get(DenomFormatKey)
+	if bz == nil {
+		return sdk.DefaultDenomRegex
+	}
+	var format string
+	keeper.cdc.MustUnmarshalBinary(bz, &format)
+	return format
+}
+
+// SetDenomFormat sets the regex denominations must match
+func (keeper DenomKeeper) SetDenomFormat(ctx sdk.Context, format string) sdk.Error {
+	err := sdk.ValidateDenomFormat(format)
+	if err != nil {
+		return ErrInvalidDenom(keeper.codespace, err.Error())
+	}
+	store := ctx.KVStore(keeper.key)
+	store.Set(DenomFormatKey, keeper.cdc.MustMarshalBinary(format))
+	return nil
+}
+
+// ValidateDenom checks a denomination against the chain's denomination format
+func (keeper DenomKeeper) ValidateDenom(ctx sdk.Context, denom string) sdk.Error {
+	err := sdk.ValidateDenom(denom, keeper.GetDenomFormat(ctx))
+	if err != nil {
+		return ErrInvalidDenom(keeper.codespace, err.Error())
+	}
+	return nil
+}
+
+// ValidateCoins checks that coins are valid and that all of their
+// denominations match the chain's denomination format
+func (keeper DenomKeeper) ValidateCoins(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	if !coins.IsValid() {
+		return sdk.ErrInvalidCoins(coins.String())
+	}
+	for _, coin := range coins {
+		if err := keeper.ValidateDenom(ctx, coin.Denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDenomMetadata returns the metadata of a base denomination
+func (keeper DenomKeeper) GetDenomMetadata(ctx sdk.Context, base string) (meta sdk.DenomMetadata, found bool) {
+	store := ctx.KVStore(keeper.key)
+	bz := store.Get(DenomMetadataKey(base))
+	if bz == nil {
+		return meta, false
+	}
+	keeper.cdc.MustUnmarshalBinary(bz, &meta)
+	return meta, true
+}
+
+// SetDenomMetadata registers the metadata of a base denomination. The display
+// denomination must not be used by any other registered denomination.
+func (keeper DenomKeeper) SetDenomMetadata(ctx sdk.Context, meta sdk.DenomMetadata) sdk.Error {
+	err := meta.ValidateBasic(keeper.GetDenomFormat(ctx))
+	if err != nil {
+		return ErrInvalidDenom(keeper.codespace, err.Error())
+	}
+
+	var conflict string
+	keeper.IterateDenomMetadata(ctx, func(other sdk.DenomMetadata) (stop bool) {
+		if other.Base == meta.Base {
+			return false
+		}
+		if other.Base == meta.Display || other.Display == meta.Display || other.Display == meta.Base {
+			conflict = other.Base
+			return true
+		}
+		return false
+	})
+	if conflict != "" {
+		return ErrInvalidDenom(keeper.codespace,
+			fmt.Sprintf("denomination %s conflicts with registered denomination %s", meta.Base, conflict))
+	}
+
+	store := ctx.KVStore(keeper.key)
+	store.Set(DenomMetadataKey(meta.Base), keeper.cdc.MustMarshalBinary(meta))
+	return nil
+}
+
+// IterateDenomMetadata iterates over the registered denominations in order
+// of their base denomination, stopping when process returns true
+func (keeper DenomKeeper) IterateDenomMetadata(ctx sdk.Context, process func(sdk.DenomMetadata) (stop bool)) {
+	store := ctx.KVStore(keeper.key)
+	iter := sdk.KVStorePrefixIterator(store, DenomMetadataKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var meta sdk.DenomMetadata
+		keeper.cdc.MustUnmarshalBinary(iter.Value(), &meta)
+		if process(meta) {
+			return
+		}
+	}
+}
+
+// GetAllDenomMetadata returns the metadata of all registered denominations
+func (keeper DenomKeeper) GetAllDenomMetadata(ctx sdk.Context) (metadata []sdk.DenomMetadata) {
+	keeper.IterateDenomMetadata(ctx, func(meta sdk.DenomMetadata) (stop bool) {
+		metadata = append(metadata, meta)
+		return false
+	})
+	return metadata
+}
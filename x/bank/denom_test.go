@@ -0,0 +1,89 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func setupDenomKeeper() (sdk.Context, DenomKeeper) {
+	db := dbm.NewMemDB()
+	bankKey := sdk.NewKVStoreKey("bank")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	return ctx, NewDenomKeeper(wire.NewCodec(), bankKey, DefaultCodespace)
+}
+
+func TestDenomFormat(t *testing.T) {
+	ctx, keeper := setupDenomKeeper()
+
+	require.Equal(t, sdk.DefaultDenomRegex, keeper.GetDenomFormat(ctx))
+	require.Nil(t, keeper.ValidateDenom(ctx, "steak"))
+	require.NotNil(t, keeper.ValidateDenom(ctx, "st"))
+
+	require.NotNil(t, keeper.SetDenomFormat(ctx, "[a-z"))
+	require.Nil(t, keeper.SetDenomFormat(ctx, "u[a-z]{3,10}"))
+	require.Equal(t, "u[a-z]{3,10}", keeper.GetDenomFormat(ctx))
+	require.NotNil(t, keeper.ValidateDenom(ctx, "steak"))
+	require.Nil(t, keeper.ValidateDenom(ctx, "usteak"))
+
+	require.Nil(t, keeper.ValidateCoins(ctx, sdk.Coins{sdk.NewCoin("uatom", 1), sdk.NewCoin("usteak", 1)}))
+	require.NotNil(t, keeper.ValidateCoins(ctx, sdk.Coins{sdk.NewCoin("uatom", 1), sdk.NewCoin("steak", 1)}))
+	require.NotNil(t, keeper.ValidateCoins(ctx, sdk.Coins{sdk.NewCoin("usteak", 1), sdk.NewCoin("uatom", 1)}))
+}
+
+func TestDenomMetadata(t *testing.T) {
+	ctx, keeper := setupDenomKeeper()
+
+	_, found := keeper.GetDenomMetadata(ctx, "uatom")
+	require.False(t, found)
+
+	atom := sdk.NewDenomMetadata("uatom", "atom", 6, "the atom")
+	require.Nil(t, keeper.SetDenomMetadata(ctx, atom))
+	res, found := keeper.GetDenomMetadata(ctx, "uatom")
+	require.True(t, found)
+	require.Equal(t, atom, res)
+
+	// updating a registered denomination is allowed
+	atom.Description = "updated"
+	require.Nil(t, keeper.SetDenomMetadata(ctx, atom))
+	res, _ = keeper.GetDenomMetadata(ctx, "uatom")
+	require.Equal(t, "updated", res.Description)
+
+	// invalid or conflicting denominations are rejected
+	require.NotNil(t, keeper.SetDenomMetadata(ctx, sdk.NewDenomMetadata("usteak", "st", 6, "")))
+	require.NotNil(t, keeper.SetDenomMetadata(ctx, sdk.NewDenomMetadata("natom", "atom", 9, "")))
+	require.NotNil(t, keeper.SetDenomMetadata(ctx, sdk.NewDenomMetadata("atom", "katom", 3, "")))
+
+	steak := sdk.NewDenomMetadata("usteak", "steak", 6, "")
+	require.Nil(t, keeper.SetDenomMetadata(ctx, steak))
+	require.Equal(t, []sdk.DenomMetadata{atom, steak}, keeper.GetAllDenomMetadata(ctx))
+}
+
+func TestDenomGenesis(t *testing.T) {
+	ctx, _, ik := setupIssuanceKeeper()
+	keeper := ik.dk
+
+	require.Nil(t, InitGenesis(ctx, keeper, ik, GenesisState{}))
+	require.Equal(t, DefaultGenesisState(), WriteGenesis(ctx, keeper, ik))
+
+	genesis := NewGenesisState("[a-z]{3,10}", []sdk.DenomMetadata{
+		sdk.NewDenomMetadata("uatom", "atom", 6, ""),
+	})
+	require.Nil(t, InitGenesis(ctx, keeper, ik, genesis))
+	require.Equal(t, genesis, WriteGenesis(ctx, keeper, ik))
+
+	genesis.DenomMetadata = append(genesis.DenomMetadata, sdk.NewDenomMetadata("u1", "one", 0, ""))
+	require.NotNil(t, InitGenesis(ctx, keeper, ik, genesis))
+}
@@ -2,6 +2,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,8 +11,16 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 2
 
-	CodeInvalidInput  sdk.CodeType = 101
-	CodeInvalidOutput sdk.CodeType = 102
+	CodeInvalidInput      sdk.CodeType = 101
+	CodeInvalidOutput     sdk.CodeType = 102
+	CodeInvalidDenom      sdk.CodeType = 103
+	CodeDenomTaken        sdk.CodeType = 104
+	CodeUnknownIssuance   sdk.CodeType = 105
+	CodeInvalidIssuer     sdk.CodeType = 106
+	CodeInvalidMaxSupply  sdk.CodeType = 107
+	CodeMaxSupplyExceeded sdk.CodeType = 108
+	CodeSendDisabled      sdk.CodeType = 109
+	CodeBlockedRecipient  sdk.CodeType = 110
 )
 
 // NOTE: Don't stringer this, we'll put better messages in later.
@@ -20,6 +30,22 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid input coins"
 	case CodeInvalidOutput:
 		return "invalid output coins"
+	case CodeInvalidDenom:
+		return "invalid denomination"
+	case CodeDenomTaken:
+		return "denomination already taken"
+	case CodeUnknownIssuance:
+		return "unknown issued denomination"
+	case CodeInvalidIssuer:
+		return "invalid issuer"
+	case CodeInvalidMaxSupply:
+		return "invalid max supply"
+	case CodeMaxSupplyExceeded:
+		return "max supply exceeded"
+	case CodeSendDisabled:
+		return "sends disabled"
+	case CodeBlockedRecipient:
+		return "blocked recipient"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -44,6 +70,38 @@ func ErrNoOutputs(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidOutput, "")
 }
 
+func ErrInvalidDenom(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidDenom, msg)
+}
+
+func ErrDenomTaken(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeDenomTaken, fmt.Sprintf("denomination %s is already issued, reserved or registered", denom))
+}
+
+func ErrUnknownIssuance(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeUnknownIssuance, fmt.Sprintf("denomination %s isn't issued", denom))
+}
+
+func ErrInvalidIssuer(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuer, msg)
+}
+
+func ErrInvalidMaxSupply(codespace sdk.CodespaceType, maxSupply sdk.Int) sdk.Error {
+	return newError(codespace, CodeInvalidMaxSupply, fmt.Sprintf("max supply %s is negative", maxSupply))
+}
+
+func ErrMaxSupplyExceeded(codespace sdk.CodespaceType, denom string, maxSupply sdk.Int) sdk.Error {
+	return newError(codespace, CodeMaxSupplyExceeded, fmt.Sprintf("the supply of %s would exceed its max supply %s", denom, maxSupply))
+}
+
+func ErrSendDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeSendDisabled, fmt.Sprintf("sends of %s are disabled", denom))
+}
+
+func ErrBlockedRecipient(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return newError(codespace, CodeBlockedRecipient, fmt.Sprintf("%s can't receive sends", addr))
+}
+
 //----------------------------------------
 
 func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
@@ -0,0 +1,146 @@
+package bank
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the denomination format, registered denominations, the
+// issued and reserved denominations, the supply and whether sends are enabled
+type GenesisState struct {
+	DenomFormat    string              `json:"denom_format"`
+	DenomMetadata  []sdk.DenomMetadata `json:"denom_metadata"`
+	Issuances      []Issuance          `json:"issuances"`
+	ReservedDenoms []string            `json:"reserved_denoms"`
+	Supply         sdk.Coins           `json:"supply"`
+	SendEnabled    []SendEnabled       `json:"send_enabled"`
+}
+
+func NewGenesisState(denomFormat string, denomMetadata []sdk.DenomMetadata) GenesisState {
+	return GenesisState{
+		DenomFormat:   denomFormat,
+		DenomMetadata: denomMetadata,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		DenomFormat: sdk.DefaultDenomRegex,
+	}
+}
+
+// ValidateGenesis checks that the denomination format compiles, that the
+// registered and issued denominations match it, that no denomination is both
+// issued and reserved and that the supply is valid
+func ValidateGenesis(data GenesisState) error {
+	format := data.DenomFormat
+	if format == "" {
+		format = sdk.DefaultDenomRegex
+	}
+	if err := sdk.ValidateDenomFormat(format); err != nil {
+		return err
+	}
+	for _, meta := range data.DenomMetadata {
+		if err := meta.ValidateBasic(format); err != nil {
+			return err
+		}
+	}
+
+	issued := make(map[string]bool, len(data.Issuances))
+	for _, issuance := range data.Issuances {
+		if err := sdk.ValidateDenom(issuance.Denom, format); err != nil {
+			return err
+		}
+		if strings.Contains(issuance.Denom, IBCDenomSeparator) {
+			return fmt.Errorf("denomination %s is reserved for IBC vouchers", issuance.Denom)
+		}
+		if issued[issuance.Denom] {
+			return fmt.Errorf("duplicate issuance of %s", issuance.Denom)
+		}
+		issued[issuance.Denom] = true
+		if issuance.MaxSupply.IsNil() || issuance.MaxSupply.Sign() < 0 {
+			return fmt.Errorf("invalid max supply of %s", issuance.Denom)
+		}
+	}
+	for _, denom := range data.ReservedDenoms {
+		if issued[denom] {
+			return fmt.Errorf("denomination %s is both issued and reserved", denom)
+		}
+	}
+
+	if !data.Supply.IsValid() {
+		return fmt.Errorf("invalid supply %s", data.Supply)
+	}
+	return nil
+}
+
+// InitGenesis sets the denomination format, registers the denominations and
+// the issuances, sets the supply and whether sends are enabled. An empty
+// format falls back to sdk.DefaultDenomRegex.
+func InitGenesis(ctx sdk.Context, keeper DenomKeeper, issuanceKeeper IssuanceKeeper, data GenesisState) sdk.Error {
+	format := data.DenomFormat
+	if format == "" {
+		format = sdk.DefaultDenomRegex
+	}
+	err := keeper.SetDenomFormat(ctx, format)
+	if err != nil {
+		return err
+	}
+	for _, meta := range data.DenomMetadata {
+		err = keeper.SetDenomMetadata(ctx, meta)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, issuance := range data.Issuances {
+		err = issuanceKeeper.RegisterDenom(ctx, issuance)
+		if err != nil {
+			return err
+		}
+	}
+	for _, denom := range data.ReservedDenoms {
+		if _, found := issuanceKeeper.GetIssuance(ctx, denom); found {
+			return ErrDenomTaken(issuanceKeeper.codespace, denom)
+		}
+		issuanceKeeper.ReserveDenom(ctx, denom)
+	}
+
+	if !data.Supply.IsValid() {
+		return sdk.ErrInvalidCoins(data.Supply.String())
+	}
+	for _, coin := range data.Supply {
+		issuance, found := issuanceKeeper.GetIssuance(ctx, coin.Denom)
+		if found && !issuance.MaxSupply.IsZero() && coin.Amount.GT(issuance.MaxSupply) {
+			return ErrMaxSupplyExceeded(issuanceKeeper.codespace, coin.Denom, issuance.MaxSupply)
+		}
+	}
+	issuanceKeeper.sk.SetTotalSupply(ctx, data.Supply)
+
+	if len(data.SendEnabled) > 0 && issuanceKeeper.ck.params == nil {
+		return sdk.ErrInternal("the bank params aren't enabled")
+	}
+	for _, se := range data.SendEnabled {
+		issuanceKeeper.ck.SetSendEnabled(ctx, se)
+	}
+	return nil
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper
+func WriteGenesis(ctx sdk.Context, keeper DenomKeeper, issuanceKeeper IssuanceKeeper) GenesisState {
+	supply := issuanceKeeper.sk.GetTotalSupply(ctx)
+	if supply.IsZero() {
+		supply = nil
+	}
+	return GenesisState{
+		DenomFormat:    keeper.GetDenomFormat(ctx),
+		DenomMetadata:  keeper.GetAllDenomMetadata(ctx),
+		Issuances:      issuanceKeeper.GetAllIssuances(ctx),
+		ReservedDenoms: issuanceKeeper.GetReservedDenoms(ctx),
+		Supply:         supply,
+		SendEnabled:    issuanceKeeper.ck.GetSendEnabled(ctx),
+	}
+}
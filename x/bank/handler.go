@@ -6,14 +6,45 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// NewHandler returns a handler for "bank" type messages.
+// NewHandler returns a handler for "bank" type messages. The messages of the
+// issued denominations require the handler of NewIssuanceHandler.
 func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case MsgSend:
 			return handleMsgSend(ctx, k, msg)
+		case MsgIssue, MsgRegisterDenom, MsgBurn, MsgTransferIssuer:
+			return sdk.ErrUnknownRequest("token issuance isn't enabled").Result()
+		default:
+			errMsg := "Unrecognized bank Msg type: " + reflect.TypeOf(msg).Name()
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// NewIssuanceHandler returns a handler for "bank" type messages, including
+// the messages of the issued denominations. The coins sent must match the
+// chain's denomination format.
+func NewIssuanceHandler(ik IssuanceKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSend:
+			// the outputs send the same coins as the inputs
+			for _, in := range msg.Inputs {
+				err := ik.dk.ValidateCoins(ctx, in.Coins)
+				if err != nil {
+					return err.Result()
+				}
+			}
+			return handleMsgSend(ctx, ik.ck, msg)
 		case MsgIssue:
-			return handleMsgIssue(ctx, k, msg)
+			return handleMsgIssue(ctx, ik, msg)
+		case MsgRegisterDenom:
+			return handleMsgRegisterDenom(ctx, ik, msg)
+		case MsgBurn:
+			return handleMsgBurn(ctx, ik, msg)
+		case MsgTransferIssuer:
+			return handleMsgTransferIssuer(ctx, ik, msg)
 		default:
 			errMsg := "Unrecognized bank Msg type: " + reflect.TypeOf(msg).Name()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -36,6 +67,61 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 }
 
 // Handle MsgIssue.
-func handleMsgIssue(ctx sdk.Context, k Keeper, msg MsgIssue) sdk.Result {
-	panic("not implemented yet")
+func handleMsgIssue(ctx sdk.Context, ik IssuanceKeeper, msg MsgIssue) sdk.Result {
+	tags, err := ik.Issue(ctx, msg.Banker, msg.Outputs)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", []byte("issue"),
+			"issuer", []byte(msg.Banker.String()),
+		).AppendTags(tags),
+	}
+}
+
+// Handle MsgRegisterDenom.
+func handleMsgRegisterDenom(ctx sdk.Context, ik IssuanceKeeper, msg MsgRegisterDenom) sdk.Result {
+	err := ik.RegisterDenom(ctx, NewIssuance(msg.Denom, msg.Issuer, msg.MaxSupply))
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", []byte("register-denom"),
+			"issuer", []byte(msg.Issuer.String()),
+			"denom", []byte(msg.Denom),
+		),
+	}
+}
+
+// Handle MsgBurn.
+func handleMsgBurn(ctx sdk.Context, ik IssuanceKeeper, msg MsgBurn) sdk.Result {
+	tags, err := ik.Burn(ctx, msg.Owner, msg.Coins)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags("action", []byte("burn")).AppendTags(tags),
+	}
+}
+
+// Handle MsgTransferIssuer.
+func handleMsgTransferIssuer(ctx sdk.Context, ik IssuanceKeeper, msg MsgTransferIssuer) sdk.Result {
+	err := ik.TransferIssuer(ctx, msg.Issuer, msg.Denom, msg.NewIssuer)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: sdk.NewTags(
+			"action", []byte("transfer-issuer"),
+			"issuer", []byte(msg.Issuer.String()),
+			"denom", []byte(msg.Denom),
+			"new-issuer", []byte(msg.NewIssuer.String()),
+		),
+	}
 }
@@ -0,0 +1,194 @@
+package bank
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// query endpoints supported by the history querier
+const (
+	QueryTransfers = "transfers"
+
+	// DefaultQueryHistoryLimit is the number of transfers in a page when no
+	// limit is requested, and the largest limit allowed
+	DefaultQueryHistoryLimit = 100
+)
+
+// HistoryEntry is a transfer in the history of its sender and recipient
+type HistoryEntry struct {
+	Height   int64              `json:"height"`
+	TxHash   string             `json:"tx_hash,omitempty"` // empty for the transfers of the block hooks
+	Transfer auth.TransferEvent `json:"transfer"`
+}
+
+// QueryHistoryParams are the params of the query of a page of the history of
+// an address, newest transfers first
+type QueryHistoryParams struct {
+	Address sdk.AccAddress `json:"address"`
+	Page    int            `json:"page"`
+	Limit   int            `json:"limit"`
+}
+
+// HistoryIndexer indexes the transfer tags of the blocks by the addresses of
+// the senders and the recipients. It runs on a node, outside of the state of
+// the application, and records the transfers of a block when it is
+// committed. The transfers of the msgs of a failed tx are skipped, as their
+// changes are discarded, while its fee is still recorded.
+type HistoryIndexer struct {
+	db     dbm.DB
+	batch  dbm.Batch
+	height int64
+	seq    uint64
+}
+
+// NewHistoryIndexer returns a new HistoryIndexer storing the index in the db
+func NewHistoryIndexer(db dbm.DB) *HistoryIndexer {
+	return &HistoryIndexer{db: db}
+}
+
+// key prefix of the history of the address
+func historyPrefix(addr sdk.AccAddress) []byte {
+	return append([]byte{byte(len(addr))}, addr...)
+}
+
+// key of a transfer in the history of the address, in order of height and of
+// transfer in the block
+func historyKey(addr sdk.AccAddress, height int64, seq uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key, uint64(height))
+	binary.BigEndian.PutUint64(key[8:], seq)
+	return append(historyPrefix(addr), key...)
+}
+
+// BeginBlock starts indexing the block of the height with the transfers of
+// its BeginBlocker
+func (hi *HistoryIndexer) BeginBlock(height int64, tags sdk.Tags) error {
+	hi.batch = hi.db.NewBatch()
+	hi.height = height
+	hi.seq = 0
+	return hi.index("", tags, true)
+}
+
+// DeliverTx indexes the transfers of a tx of the block
+func (hi *HistoryIndexer) DeliverTx(txBytes []byte, res abci.ResponseDeliverTx) error {
+	txHash := cmn.HexBytes(tmhash.Sum(txBytes)).String()
+	return hi.index(txHash, res.Tags, res.Code == uint32(sdk.ABCICodeOK))
+}
+
+// EndBlock indexes the transfers of the EndBlocker of the block
+func (hi *HistoryIndexer) EndBlock(tags sdk.Tags) error {
+	return hi.index("", tags, true)
+}
+
+// Commit writes the index of the block
+func (hi *HistoryIndexer) Commit() {
+	if hi.batch == nil {
+		return
+	}
+	hi.batch.WriteSync()
+	hi.batch = nil
+}
+
+// index the transfers of the tags, only those of the AnteHandler if the msgs
+// failed
+func (hi *HistoryIndexer) index(txHash string, tags sdk.Tags, ok bool) error {
+	if hi.batch == nil {
+		return fmt.Errorf("no block is being indexed")
+	}
+	events, err := auth.ParseTransferTags(tags)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		if !ok && event.MsgIndex >= 0 {
+			continue
+		}
+		bz, err := json.Marshal(HistoryEntry{
+			Height:   hi.height,
+			TxHash:   txHash,
+			Transfer: event,
+		})
+		if err != nil {
+			return err
+		}
+		if len(event.Sender) != 0 {
+			hi.batch.Set(historyKey(event.Sender, hi.height, hi.seq), bz)
+		}
+		if len(event.Recipient) != 0 {
+			hi.batch.Set(historyKey(event.Recipient, hi.height, hi.seq), bz)
+		}
+		hi.seq++
+	}
+	return nil
+}
+
+// GetHistory returns the page of the transfers from or to the address, newest
+// first, page n holding the transfers from (n-1)*limit to n*limit excluded
+func (hi *HistoryIndexer) GetHistory(addr sdk.AccAddress, page, limit int) ([]HistoryEntry, error) {
+	prefix := historyPrefix(addr)
+	iter := hi.db.ReverseIterator(prefix, sdk.PrefixEndBytes(prefix))
+	defer iter.Close()
+
+	entries := []HistoryEntry{}
+	skip := (page - 1) * limit
+	for ; iter.Valid() && len(entries) < limit; iter.Next() {
+		if skip > 0 {
+			skip--
+			continue
+		}
+		var entry HistoryEntry
+		err := json.Unmarshal(iter.Value(), &entry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// NewHistoryQuerier returns the querier of the history of the addresses
+// indexed by the HistoryIndexer
+func NewHistoryQuerier(hi *HistoryIndexer) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 || path[0] != QueryTransfers {
+			return nil, sdk.ErrUnknownRequest("unknown history query endpoint")
+		}
+
+		params := QueryHistoryParams{Page: 1}
+		err := json.Unmarshal(req.Data, &params)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err.Error()))
+		}
+		if len(params.Address) == 0 {
+			return nil, sdk.ErrInvalidAddress("no address specified")
+		}
+		if params.Page < 1 {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid page %d, pages start at 1", params.Page))
+		}
+		if params.Limit < 0 || params.Limit > DefaultQueryHistoryLimit {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid limit %d, it must be at most %d", params.Limit, DefaultQueryHistoryLimit))
+		}
+		if params.Limit == 0 {
+			params.Limit = DefaultQueryHistoryLimit
+		}
+
+		entries, err := hi.GetHistory(params.Address, params.Page, params.Limit)
+		if err != nil {
+			return nil, sdk.ErrInternal(err.Error())
+		}
+		bz, err := json.Marshal(entries)
+		if err != nil {
+			return nil, sdk.ErrInternal(err.Error())
+		}
+		return bz, nil
+	}
+}
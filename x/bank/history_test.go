@@ -0,0 +1,171 @@
+package bank
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestTransferTags(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	tc := sdk.NewTagCollector()
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger()).
+		WithMsgIndex(1).
+		WithTagCollector(tc)
+	am := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	keeper := NewKeeper(am)
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	addr3 := sdk.AccAddress([]byte("addr3"))
+	transfer := func(sender, recipient sdk.AccAddress, denom string, amount int64) auth.TransferEvent {
+		return auth.TransferEvent{MsgIndex: 1, Sender: sender, Recipient: recipient, Denom: denom, Amount: sdk.NewInt(amount)}
+	}
+	keeper.SetCoins(ctx, addr1, sdk.Coins{sdk.NewCoin("foocoin", 100), sdk.NewCoin("steak", 100)})
+	keeper.SetCoins(ctx, addr2, sdk.Coins{sdk.NewCoin("steak", 100)})
+
+	// failed sends emit nothing
+	_, err := keeper.SendCoins(ctx, addr1, addr2, sdk.Coins{sdk.NewCoin("steak", 200)})
+	require.NotNil(t, err)
+	require.Empty(t, tc.Tags())
+
+	_, err = keeper.SendCoins(ctx, addr1, addr2, sdk.Coins{sdk.NewCoin("foocoin", 10), sdk.NewCoin("steak", 5)})
+	require.Nil(t, err)
+	events, err2 := auth.ParseTransferTags(tc.Tags())
+	require.Nil(t, err2)
+	require.Equal(t, []auth.TransferEvent{
+		transfer(addr1, addr2, "foocoin", 10),
+		transfer(addr1, addr2, "steak", 5),
+	}, events)
+
+	// the inputs are paid to the outputs in order
+	tc = sdk.NewTagCollector()
+	ctx = ctx.WithTagCollector(tc)
+	inputs := []Input{
+		NewInput(addr1, sdk.Coins{sdk.NewCoin("steak", 30)}),
+		NewInput(addr2, sdk.Coins{sdk.NewCoin("steak", 20)}),
+	}
+	outputs := []Output{
+		NewOutput(addr3, sdk.Coins{sdk.NewCoin("steak", 40)}),
+		NewOutput(addr1, sdk.Coins{sdk.NewCoin("steak", 10)}),
+	}
+	_, err = keeper.InputOutputCoins(ctx, inputs, outputs)
+	require.Nil(t, err)
+	events, err2 = auth.ParseTransferTags(tc.Tags())
+	require.Nil(t, err2)
+	require.Equal(t, []auth.TransferEvent{
+		transfer(addr1, addr3, "steak", 30),
+		transfer(addr2, addr3, "steak", 10),
+		transfer(addr2, addr1, "steak", 10),
+	}, events)
+
+	// delegated coins go to the module account
+	tc = sdk.NewTagCollector()
+	ctx = ctx.WithTagCollector(tc)
+	keeper = keeper.WithModuleAccounts(map[string][]string{"stake": {auth.Staking}})
+	_, err = keeper.DelegateCoinsFromAccountToModule(ctx, addr3, "stake", sdk.Coins{sdk.NewCoin("steak", 40)})
+	require.Nil(t, err)
+	events, err2 = auth.ParseTransferTags(tc.Tags())
+	require.Nil(t, err2)
+	require.Equal(t, []auth.TransferEvent{transfer(addr3, auth.NewModuleAddress("stake"), "steak", 40)}, events)
+}
+
+func TestHistoryIndexer(t *testing.T) {
+	hi := NewHistoryIndexer(dbm.NewMemDB())
+
+	addr1 := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	feeCollector := auth.NewModuleAddress(auth.FeeCollectorName)
+	steak := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewCoin("steak", amount)} }
+
+	// nothing is indexed outside of a block
+	require.NotNil(t, hi.EndBlock(nil))
+
+	// a block minting to addr1, where addr1 pays a fee and sends to addr2
+	require.Nil(t, hi.BeginBlock(1, auth.TransferTags(-1, nil, addr1, steak(100))))
+	res := abci.ResponseDeliverTx{
+		Tags: auth.TransferTags(-1, addr1, feeCollector, steak(1)).
+			AppendTags(auth.TransferTags(0, addr1, addr2, steak(10))),
+	}
+	require.Nil(t, hi.DeliverTx([]byte("tx1"), res))
+
+	// the history is written on commit
+	history, err := hi.GetHistory(addr1, 1, 10)
+	require.Nil(t, err)
+	require.Empty(t, history)
+	hi.Commit()
+
+	// a block where the send of addr2 fails, its fee is still paid
+	require.Nil(t, hi.BeginBlock(2, nil))
+	res = abci.ResponseDeliverTx{
+		Code: 1,
+		Tags: auth.TransferTags(-1, addr2, feeCollector, steak(1)).
+			AppendTags(auth.TransferTags(0, addr2, addr1, steak(5))),
+	}
+	require.Nil(t, hi.DeliverTx([]byte("tx2"), res))
+	require.Nil(t, hi.EndBlock(nil))
+	hi.Commit()
+
+	history, err = hi.GetHistory(addr2, 1, 10)
+	require.Nil(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, int64(2), history[0].Height)
+	require.Equal(t, addr2, history[0].Transfer.Sender)
+	require.Equal(t, feeCollector, history[0].Transfer.Recipient)
+	require.Equal(t, int64(1), history[1].Height)
+	require.Equal(t, addr1, history[1].Transfer.Sender)
+	require.NotEmpty(t, history[1].TxHash)
+
+	// addr1 received the minted coins, paid a fee and sent to addr2, newest
+	// first
+	history, err = hi.GetHistory(addr1, 1, 2)
+	require.Nil(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, addr2, history[0].Transfer.Recipient)
+	require.Equal(t, feeCollector, history[1].Transfer.Recipient)
+	history, err = hi.GetHistory(addr1, 2, 2)
+	require.Nil(t, err)
+	require.Len(t, history, 1)
+	require.Empty(t, history[0].Transfer.Sender)
+	require.Empty(t, history[0].TxHash)
+
+	// the querier pages the history
+	querier := NewHistoryQuerier(hi)
+	query := func(params QueryHistoryParams) ([]HistoryEntry, sdk.Error) {
+		bz, err := json.Marshal(params)
+		require.Nil(t, err)
+		res, sdkErr := querier(sdk.Context{}, []string{QueryTransfers}, abci.RequestQuery{Data: bz})
+		if sdkErr != nil {
+			return nil, sdkErr
+		}
+		var entries []HistoryEntry
+		require.Nil(t, json.Unmarshal(res, &entries))
+		return entries, nil
+	}
+	entries, sdkErr := query(QueryHistoryParams{Address: addr1, Page: 1})
+	require.Nil(t, sdkErr)
+	require.Len(t, entries, 3)
+	entries, sdkErr = query(QueryHistoryParams{Address: addr1, Page: 3, Limit: 1})
+	require.Nil(t, sdkErr)
+	require.Len(t, entries, 1)
+	_, sdkErr = query(QueryHistoryParams{Address: addr1, Page: 0})
+	require.NotNil(t, sdkErr)
+	_, sdkErr = query(QueryHistoryParams{Address: addr1, Page: 1, Limit: DefaultQueryHistoryLimit + 1})
+	require.NotNil(t, sdkErr)
+	_, sdkErr = query(QueryHistoryParams{Page: 1})
+	require.NotNil(t, sdkErr)
+}
@@ -0,0 +1,244 @@
+package bank
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+var (
+	IssuanceKeyPrefix      = []byte("issuance:")
+	ReservedDenomKeyPrefix = []byte("reservedDenom:")
+)
+
+// IBCDenomSeparator separates the path from the base denomination of IBC
+// vouchers, denominations containing it can't be registered for issuance.
+// Any other free denomination is registered by whoever registers it first.
+const IBCDenomSeparator = "/"
+
+// IssuanceKey returns the store key of the issuance of a denomination
+func IssuanceKey(denom string) []byte {
+	return append([]byte("issuance:"), []byte(denom)...)
+}
+
+// ReservedDenomKey returns the store key marking a denomination as reserved
+func ReservedDenomKey(denom string) []byte {
+	return append([]byte("reservedDenom:"), []byte(denom)...)
+}
+
+// Issuance is a denomination issued by an issuer, who alone may mint its
+// coins, up to its max supply. A zero max supply doesn't cap the supply.
+type Issuance struct {
+	Denom     string         `json:"denom"`
+	Issuer    sdk.AccAddress `json:"issuer"`
+	MaxSupply sdk.Int        `json:"max_supply"`
+}
+
+// NewIssuance creates a new Issuance
+func NewIssuance(denom string, issuer sdk.AccAddress, maxSupply sdk.Int) Issuance {
+	return Issuance{
+		Denom:     denom,
+		Issuer:    issuer,
+		MaxSupply: maxSupply,
+	}
+}
+
+// IssuanceKeeper manages the denominations issued on the chain: their
+// registration, the minting and burning of their coins, and their supply
+type IssuanceKeeper struct {
+	key       sdk.StoreKey
+	cdc       *wire.Codec
+	ck        Keeper
+	dk        DenomKeeper
+	sk        SupplyKeeper
+	codespace sdk.CodespaceType
+}
+
+// NewIssuanceKeeper returns a new IssuanceKeeper, which records the supply
+// of the issued denominations in the store of the key
+func NewIssuanceKeeper(cdc *wire.Codec, key sdk.StoreKey, ck Keeper, dk DenomKeeper, codespace sdk.CodespaceType) IssuanceKeeper {
+	return IssuanceKeeper{
+		key:       key,
+		cdc:       cdc,
+		ck:        ck,
+		dk:        dk,
+		sk:        NewSupplyKeeper(cdc, key),
+		codespace: codespace,
+	}
+}
+
+// GetIssuance returns the issuance of a denomination
+func (keeper IssuanceKeeper) GetIssuance(ctx sdk.Context, denom string) (issuance Issuance, found bool) {
+	store := ctx.KVStore(keeper.key)
+	bz := store.Get(IssuanceKey(denom))
+	if bz == nil {
+		return issuance, false
+	}
+	keeper.cdc.MustUnmarshalBinary(bz, &issuance)
+	return issuance, true
+}
+
+func (keeper IssuanceKeeper) setIssuance(ctx sdk.Context, issuance Issuance) {
+	store := ctx.KVStore(keeper.key)
+	store.Set(IssuanceKey(issuance.Denom), keeper.cdc.MustMarshalBinary(issuance))
+}
+
+// IterateIssuances iterates over the issuances in order of denomination,
+// stopping when process returns true
+func (keeper IssuanceKeeper) IterateIssuances(ctx sdk.Context, process func(Issuance) (stop bool)) {
+	store := ctx.KVStore(keeper.key)
+	iter := sdk.KVStorePrefixIterator(store, IssuanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var issuance Issuance
+		keeper.cdc.MustUnmarshalBinary(iter.Value(), &issuance)
+		if process(issuance) {
+			return
+		}
+	}
+}
+
+// GetAllIssuances returns all the issuances
+func (keeper IssuanceKeeper) GetAllIssuances(ctx sdk.Context) (issuances []Issuance) {
+	keeper.IterateIssuances(ctx, func(issuance Issuance) (stop bool) {
+		issuances = append(issuances, issuance)
+		return false
+	})
+	return issuances
+}
+
+// GetSupply returns the supply of an issued denomination, the coins minted
+// less the coins burned
+func (keeper IssuanceKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	return keeper.sk.GetSupply(ctx, denom)
+}
+
+// ReserveDenom reserves a denomination which isn't issued, e.g. the native
+// denominations of the chain, so that it can't be registered for issuance
+func (keeper IssuanceKeeper) ReserveDenom(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(keeper.key)
+	store.Set(ReservedDenomKey(denom), []byte{})
+}
+
+// IsDenomReserved returns whether the denomination is reserved
+func (keeper IssuanceKeeper) IsDenomReserved(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(keeper.key)
+	return store.Has(ReservedDenomKey(denom))
+}
+
+// GetReservedDenoms returns the reserved denominations in order
+func (keeper IssuanceKeeper) GetReservedDenoms(ctx sdk.Context) (denoms []string) {
+	store := ctx.KVStore(keeper.key)
+	iter := sdk.KVStorePrefixIterator(store, ReservedDenomKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()[len(ReservedDenomKeyPrefix):]))
+	}
+	return denoms
+}
+
+// RegisterDenom registers a new denomination issued by the issuer. The
+// denomination must match the denomination format, must not be an IBC
+// voucher and must not be issued, reserved or registered in the
+// denomination registry.
+func (keeper IssuanceKeeper) RegisterDenom(ctx sdk.Context, issuance Issuance) sdk.Error {
+	err := keeper.dk.ValidateDenom(ctx, issuance.Denom)
+	if err != nil {
+		return err
+	}
+	if strings.Contains(issuance.Denom, IBCDenomSeparator) {
+		return ErrInvalidDenom(keeper.codespace, fmt.Sprintf("%s is reserved for IBC vouchers", issuance.Denom))
+	}
+	if issuance.MaxSupply.IsNil() {
+		return sdk.ErrInvalidCoins("no max supply")
+	}
+	if issuance.MaxSupply.Sign() < 0 {
+		return ErrInvalidMaxSupply(keeper.codespace, issuance.MaxSupply)
+	}
+	if _, found := keeper.GetIssuance(ctx, issuance.Denom); found {
+		return ErrDenomTaken(keeper.codespace, issuance.Denom)
+	}
+	if keeper.IsDenomReserved(ctx, issuance.Denom) {
+		return ErrDenomTaken(keeper.codespace, issuance.Denom)
+	}
+	taken := false
+	keeper.dk.IterateDenomMetadata(ctx, func(meta sdk.DenomMetadata) (stop bool) {
+		taken = meta.Base == issuance.Denom || meta.Display == issuance.Denom
+		return taken
+	})
+	if taken {
+		return ErrDenomTaken(keeper.codespace, issuance.Denom)
+	}
+
+	keeper.setIssuance(ctx, issuance)
+	return nil
+}
+
+// Issue mints the coins of the outputs, the issuer must be the issuer of
+// all of their denominations and their supply must not exceed the max supply
+func (keeper IssuanceKeeper) Issue(ctx sdk.Context, issuer sdk.AccAddress, outputs []Output) (sdk.Tags, sdk.Error) {
+	var total sdk.Coins
+	for _, out := range outputs {
+		total = total.Plus(out.Coins)
+	}
+	for _, coin := range total {
+		issuance, found := keeper.GetIssuance(ctx, coin.Denom)
+		if !found {
+			return nil, ErrUnknownIssuance(keeper.codespace, coin.Denom)
+		}
+		if !bytes.Equal(issuance.Issuer, issuer) {
+			return nil, ErrInvalidIssuer(keeper.codespace,
+				fmt.Sprintf("%s isn't the issuer of %s", issuer, coin.Denom))
+		}
+		supply := keeper.GetSupply(ctx, coin.Denom).Add(coin.Amount)
+		if !issuance.MaxSupply.IsZero() && supply.GT(issuance.MaxSupply) {
+			return nil, ErrMaxSupplyExceeded(keeper.codespace, coin.Denom, issuance.MaxSupply)
+		}
+	}
+	keeper.sk.Inflate(ctx, total)
+
+	allTags := sdk.EmptyTags()
+	for _, out := range outputs {
+		_, tags, err := keeper.ck.AddCoins(ctx, out.Address, out.Coins)
+		if err != nil {
+			return nil, err
+		}
+		allTags = allTags.AppendTags(tags)
+	}
+	return allTags, nil
+}
+
+// Burn burns issued coins of the owner, reducing their supply
+func (keeper IssuanceKeeper) Burn(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins) (sdk.Tags, sdk.Error) {
+	for _, coin := range coins {
+		if _, found := keeper.GetIssuance(ctx, coin.Denom); !found {
+			return nil, ErrUnknownIssuance(keeper.codespace, coin.Denom)
+		}
+	}
+	_, tags, err := keeper.ck.SubtractCoins(ctx, owner, coins)
+	if err != nil {
+		return nil, err
+	}
+	err = keeper.sk.Deflate(ctx, coins)
+	if err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// TransferIssuer hands the issuance of a denomination to a new issuer
+func (keeper IssuanceKeeper) TransferIssuer(ctx sdk.Context, issuer sdk.AccAddress, denom string, newIssuer sdk.AccAddress) sdk.Error {
+	issuance, found := keeper.GetIssuance(ctx, denom)
+	if !found {
+		return ErrUnknownIssuance(keeper.codespace, denom)
+	}
+	if !bytes.Equal(issuance.Issuer, issuer) {
+		return ErrInvalidIssuer(keeper.codespace, fmt.Sprintf("%s isn't the issuer of %s", issuer, denom))
+	}
+	issuance.Issuer = newIssuer
+	keeper.setIssuance(ctx, issuance)
+	return nil
+}
@@ -0,0 +1,182 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func setupIssuanceKeeper() (sdk.Context, auth.AccountMapper, IssuanceKeeper) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	bankKey := sdk.NewKVStoreKey("bank")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	am := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	dk := NewDenomKeeper(cdc, bankKey, DefaultCodespace)
+	ik := NewIssuanceKeeper(cdc, bankKey, NewKeeper(am), dk, DefaultCodespace)
+	return ctx, am, ik
+}
+
+func TestIssuance(t *testing.T) {
+	ctx, _, ik := setupIssuanceKeeper()
+	handler := NewIssuanceHandler(ik)
+
+	issuer := sdk.AccAddress([]byte("issuer"))
+	issuer2 := sdk.AccAddress([]byte("issuer2"))
+	holder := sdk.AccAddress([]byte("holder"))
+	code := func(code sdk.CodeType) sdk.ABCICodeType {
+		return sdk.ToABCICode(DefaultCodespace, code)
+	}
+
+	// register a denomination, which can't be registered again
+	res := handler(ctx, NewMsgRegisterDenom(issuer, "usdx", sdk.NewInt(100)))
+	require.True(t, res.IsOK(), res.Log)
+	issuance, found := ik.GetIssuance(ctx, "usdx")
+	require.True(t, found)
+	require.Equal(t, NewIssuance("usdx", issuer, sdk.NewInt(100)), issuance)
+	res = handler(ctx, NewMsgRegisterDenom(issuer2, "usdx", sdk.NewInt(100)))
+	require.Equal(t, code(CodeDenomTaken), res.Code)
+
+	// reserved and registered denominations can't be registered
+	ik.ReserveDenom(ctx, "steak")
+	res = handler(ctx, NewMsgRegisterDenom(issuer, "steak", sdk.ZeroInt()))
+	require.Equal(t, code(CodeDenomTaken), res.Code)
+	require.Nil(t, ik.dk.SetDenomMetadata(ctx, sdk.NewDenomMetadata("uatom", "atom", 6, "")))
+	res = handler(ctx, NewMsgRegisterDenom(issuer, "atom", sdk.ZeroInt()))
+	require.Equal(t, code(CodeDenomTaken), res.Code)
+	res = handler(ctx, NewMsgRegisterDenom(issuer, "a", sdk.ZeroInt()))
+	require.Equal(t, code(CodeInvalidDenom), res.Code)
+
+	// IBC vouchers can't be registered and the max supply must be set
+	msg := NewMsgRegisterDenom(issuer, "transfer/channel/atom", sdk.ZeroInt())
+	require.Equal(t, code(CodeInvalidDenom), msg.ValidateBasic().ABCICode())
+	res = handler(ctx, msg)
+	require.Equal(t, code(CodeInvalidDenom), res.Code)
+	msg = MsgRegisterDenom{Issuer: issuer, Denom: "eurx"}
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidCoins), msg.ValidateBasic().ABCICode())
+	res = handler(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInvalidCoins), res.Code)
+
+	// only the issuer mints, up to the max supply
+	usdx := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewCoin("usdx", amount)} }
+	res = handler(ctx, NewMsgIssue(issuer2, []Output{NewOutput(holder, usdx(10))}))
+	require.Equal(t, code(CodeInvalidIssuer), res.Code)
+	res = handler(ctx, NewMsgIssue(issuer, []Output{NewOutput(holder, usdx(60)), NewOutput(issuer, usdx(50))}))
+	require.Equal(t, code(CodeMaxSupplyExceeded), res.Code)
+	res = handler(ctx, NewMsgIssue(issuer, []Output{NewOutput(holder, usdx(60)), NewOutput(issuer, usdx(40))}))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, ik.ck.GetCoins(ctx, holder).IsEqual(usdx(60)))
+	require.Equal(t, sdk.NewInt(100), ik.GetSupply(ctx, "usdx"))
+	res = handler(ctx, NewMsgIssue(issuer, []Output{NewOutput(holder, sdk.Coins{sdk.NewCoin("steak", 1)})}))
+	require.Equal(t, code(CodeUnknownIssuance), res.Code)
+
+	// burning frees supply
+	res = handler(ctx, NewMsgBurn(holder, usdx(70)))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeInsufficientCoins), res.Code)
+	res = handler(ctx, NewMsgBurn(holder, usdx(20)))
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, ik.ck.GetCoins(ctx, holder).IsEqual(usdx(40)))
+	require.Equal(t, sdk.NewInt(80), ik.GetSupply(ctx, "usdx"))
+
+	// the new issuer mints after a transfer
+	res = handler(ctx, NewMsgTransferIssuer(issuer2, "usdx", issuer2))
+	require.Equal(t, code(CodeInvalidIssuer), res.Code)
+	res = handler(ctx, NewMsgTransferIssuer(issuer, "usdx", issuer2))
+	require.True(t, res.IsOK(), res.Log)
+	res = handler(ctx, NewMsgIssue(issuer, []Output{NewOutput(holder, usdx(10))}))
+	require.Equal(t, code(CodeInvalidIssuer), res.Code)
+	res = handler(ctx, NewMsgIssue(issuer2, []Output{NewOutput(holder, usdx(20))}))
+	require.True(t, res.IsOK(), res.Log)
+	require.Equal(t, sdk.NewInt(100), ik.GetSupply(ctx, "usdx"))
+
+	// the issuance msgs require an issuance handler
+	res = NewHandler(ik.ck)(ctx, NewMsgBurn(holder, usdx(10)))
+	require.Equal(t, sdk.ToABCICode(sdk.CodespaceRoot, sdk.CodeUnknownRequest), res.Code)
+
+	// the coins sent must match the denomination format
+	send := NewMsgSend([]Input{NewInput(holder, usdx(1))}, []Output{NewOutput(issuer, usdx(1))})
+	require.Nil(t, ik.dk.SetDenomFormat(ctx, "x[a-z]+"))
+	res = handler(ctx, send)
+	require.Equal(t, code(CodeInvalidDenom), res.Code)
+	require.Nil(t, ik.dk.SetDenomFormat(ctx, sdk.DefaultDenomRegex))
+	res = handler(ctx, send)
+	require.True(t, res.IsOK(), res.Log)
+}
+
+func TestIssuanceGenesis(t *testing.T) {
+	ctx, _, ik := setupIssuanceKeeper()
+
+	issuer := sdk.AccAddress([]byte("issuer"))
+	genesis := DefaultGenesisState()
+	genesis.Issuances = []Issuance{
+		NewIssuance("eurx", issuer, sdk.NewInt(1000)),
+		NewIssuance("usdx", issuer, sdk.NewInt(100)),
+	}
+	genesis.Supply = sdk.Coins{sdk.NewCoin("steak", 500), sdk.NewCoin("usdx", 50)}
+	genesis.ReservedDenoms = []string{"steak"}
+	require.Nil(t, InitGenesis(ctx, ik.dk, ik, genesis))
+	require.Equal(t, genesis, WriteGenesis(ctx, ik.dk, ik))
+
+	// the supply can't exceed the max supply, and issued denominations can't
+	// be reserved
+	ctx, _, ik = setupIssuanceKeeper()
+	genesis.Supply = sdk.Coins{sdk.NewCoin("usdx", 150)}
+	require.NotNil(t, InitGenesis(ctx, ik.dk, ik, genesis))
+	ctx, _, ik = setupIssuanceKeeper()
+	genesis.Supply = nil
+	genesis.ReservedDenoms = []string{"usdx"}
+	require.NotNil(t, InitGenesis(ctx, ik.dk, ik, genesis))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	issuer := sdk.AccAddress([]byte("issuer"))
+	valid := func() GenesisState {
+		genesis := DefaultGenesisState()
+		genesis.DenomMetadata = []sdk.DenomMetadata{sdk.NewDenomMetadata("uatom", "atom", 6, "")}
+		genesis.Issuances = []Issuance{NewIssuance("usdx", issuer, sdk.NewInt(100))}
+		genesis.ReservedDenoms = []string{"steak"}
+		genesis.Supply = sdk.Coins{sdk.NewCoin("steak", 500), sdk.NewCoin("usdx", 50)}
+		return genesis
+	}
+	require.Nil(t, ValidateGenesis(valid()))
+
+	genesis := valid()
+	genesis.DenomFormat = "[a-z"
+	require.NotNil(t, ValidateGenesis(genesis))
+
+	genesis = valid()
+	genesis.DenomMetadata = []sdk.DenomMetadata{sdk.NewDenomMetadata("uatom", "at", 6, "")}
+	require.NotNil(t, ValidateGenesis(genesis))
+
+	genesis = valid()
+	genesis.Issuances = append(genesis.Issuances, NewIssuance("usdx", issuer, sdk.NewInt(10)))
+	require.NotNil(t, ValidateGenesis(genesis))
+
+	genesis = valid()
+	genesis.Issuances = []Issuance{{Denom: "usdx", Issuer: issuer}}
+	require.NotNil(t, ValidateGenesis(genesis))
+
+	genesis = valid()
+	genesis.ReservedDenoms = []string{"usdx"}
+	require.NotNil(t, ValidateGenesis(genesis))
+
+	genesis = valid()
+	genesis.Supply = sdk.Coins{sdk.NewCoin("usdx", 50), sdk.NewCoin("steak", 500)}
+	require.NotNil(t, ValidateGenesis(genesis))
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 const (
@@ -13,11 +14,24 @@ const (
 	costSetCoins      sdk.Gas = 100
 	costSubtractCoins sdk.Gas = 10
 	costAddCoins      sdk.Gas = 10
+	costDelegateCoins sdk.Gas = 10
 )
 
 // Keeper manages transfers between accounts
 type Keeper struct {
 	am auth.AccountMapper
+
+	// records the supply if set
+	sk *SupplyKeeper
+
+	// the permissions of the module accounts by module name
+	modulePerms map[string][]string
+
+	// whether sends are enabled if set
+	params *params.Setter
+
+	// the addresses which can't receive sends
+	blockedAddrs map[string]bool
 }
 
 // NewKeeper returns a new Keeper
@@ -42,24 +56,69 @@ func (keeper Keeper) HasCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coin
 
 // SubtractCoins subtracts amt from the coins at the addr.
 func (keeper Keeper) SubtractCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
-	return subtractCoins(ctx, keeper.am, addr, amt)
+	newCoins, tags, err := subtractCoins(ctx, keeper.am, addr, amt)
+	if err == nil {
+		auth.EmitTransfers(ctx, addr, nil, amt)
+	}
+	return newCoins, tags, err
 }
 
 // AddCoins adds amt to the coins at the addr.
 func (keeper Keeper) AddCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
-	return addCoins(ctx, keeper.am, addr, amt)
+	newCoins, tags, err := addCoins(ctx, keeper.am, addr, amt)
+	if err == nil {
+		auth.EmitTransfers(ctx, nil, addr, amt)
+	}
+	return newCoins, tags, err
 }
 
-// SendCoins moves coins from one account to another
+// SendCoins moves coins from one account to another, the coins must be
+// enabled for sends and the recipient must not be blocked
 func (keeper Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	err := keeper.checkSendEnabled(ctx, amt)
+	if err != nil {
+		return nil, err
+	}
+	if keeper.IsBlockedAddr(toAddr) {
+		return nil, ErrBlockedRecipient(DefaultCodespace, toAddr)
+	}
 	return sendCoins(ctx, keeper.am, fromAddr, toAddr, amt)
 }
 
-// InputOutputCoins handles a list of inputs and outputs
+// InputOutputCoins handles a list of inputs and outputs, the coins must be
+// enabled for sends and the recipients must not be blocked
 func (keeper Keeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
+	for _, out := range outputs {
+		err := keeper.checkSendEnabled(ctx, out.Coins)
+		if err != nil {
+			return nil, err
+		}
+		if keeper.IsBlockedAddr(out.Address) {
+			return nil, ErrBlockedRecipient(DefaultCodespace, out.Address)
+		}
+	}
 	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
 }
 
+// WithBlockedAddrs returns the Keeper rejecting the sends to the addresses,
+// e.g. to the module accounts
+func (keeper Keeper) WithBlockedAddrs(addrs ...sdk.AccAddress) Keeper {
+	blocked := make(map[string]bool, len(keeper.blockedAddrs)+len(addrs))
+	for addr := range keeper.blockedAddrs {
+		blocked[addr] = true
+	}
+	for _, addr := range addrs {
+		blocked[addr.String()] = true
+	}
+	keeper.blockedAddrs = blocked
+	return keeper
+}
+
+// IsBlockedAddr returns whether the address can't receive sends
+func (keeper Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
+	return keeper.blockedAddrs[addr.String()]
+}
+
 //______________________________________________________________________________________________
 
 // SendKeeper only allows transfers between accounts, without the possibility of creating coins
@@ -147,13 +206,14 @@ func hasCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt s
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
+// Only the coins of vesting accounts which are unlocked can be subtracted.
 func subtractCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
 	ctx.GasMeter().ConsumeGas(costSubtractCoins, "subtractCoins")
-	oldCoins := getCoins(ctx, am, addr)
-	newCoins := oldCoins.Minus(amt)
-	if !newCoins.IsNotNegative() {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	oldCoins, spendableCoins := getSpendableCoins(ctx, am, addr)
+	if !spendableCoins.Minus(amt).IsNotNegative() {
+		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", spendableCoins, amt))
 	}
+	newCoins := oldCoins.Minus(amt)
 	err := setCoins(ctx, am, addr, newCoins)
 	tags := sdk.NewTags("sender", []byte(addr.String()))
 	return newCoins, tags, err
@@ -172,6 +232,60 @@ func addCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt s
 	return newCoins, tags, err
 }
 
+// get the coins at the addr and those of them which can be spent
+func getSpendableCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress) (coins, spendable sdk.Coins) {
+	ctx.GasMeter().ConsumeGas(costGetCoins, "getCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.Coins{}, sdk.Coins{}
+	}
+	return acc.GetCoins(), auth.SpendableCoins(acc, ctx.BlockHeader().Time)
+}
+
+// delegate coins of the addr, tracking the delegation by vesting accounts
+func delegateCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	ctx.GasMeter().ConsumeGas(costDelegateCoins, "delegateCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdk.ErrUnknownAddress(addr.String())
+	}
+	oldCoins := acc.GetCoins()
+	newCoins := oldCoins.Minus(amt)
+	if !newCoins.IsNotNegative() {
+		return nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	}
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	}
+	err := setAccountCoins(ctx, am, acc, newCoins)
+	return sdk.NewTags("sender", []byte(addr.String())), err
+}
+
+// undelegate coins to the addr, tracking the undelegation by vesting accounts
+func undelegateCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	ctx.GasMeter().ConsumeGas(costDelegateCoins, "undelegateCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = am.NewAccountWithAddress(ctx, addr)
+	}
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+	}
+	err := setAccountCoins(ctx, am, acc, acc.GetCoins().Plus(amt))
+	return sdk.NewTags("recipient", []byte(addr.String())), err
+}
+
+func setAccountCoins(ctx sdk.Context, am auth.AccountMapper, acc auth.Account, amt sdk.Coins) sdk.Error {
+	ctx.GasMeter().ConsumeGas(costSetCoins, "setCoins")
+	err := acc.SetCoins(amt)
+	if err != nil {
+		// Handle w/ #870
+		panic(err)
+	}
+	am.SetAccount(ctx, acc)
+	return nil
+}
+
 // SendCoins moves coins from one account to another
 // NOTE: Make sure to revert state changes from tx on error
 func sendCoins(ctx sdk.Context, am auth.AccountMapper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
@@ -185,6 +299,7 @@ func sendCoins(ctx sdk.Context, am auth.AccountMapper, fromAddr sdk.AccAddress,
 		return nil, err
 	}
 
+	auth.EmitTransfers(ctx, fromAddr, toAddr, amt)
 	return subTags.AppendTags(addTags), nil
 }
 
@@ -209,5 +324,70 @@ func inputOutputCoins(ctx sdk.Context, am auth.AccountMapper, inputs []Input, ou
 		allTags = allTags.AppendTags(tags)
 	}
 
+	ctx.EmitTags(inputOutputTransferTags(ctx.MsgIndex(), inputs, outputs))
 	return allTags, nil
 }
+
+// inputOutputTransferTags returns the tags of the transfers of the inputs to
+// the outputs. Per denomination, the coins of the inputs are paid to the
+// outputs in order, any coins left without an output or an input are burned
+// or minted.
+func inputOutputTransferTags(msgIndex int, inputs []Input, outputs []Output) sdk.Tags {
+	type holding struct {
+		addr   sdk.AccAddress
+		amount sdk.Int
+	}
+	var denoms []string
+	ins := make(map[string][]holding)
+	outs := make(map[string][]holding)
+	for _, in := range inputs {
+		for _, coin := range in.Coins {
+			if _, ok := ins[coin.Denom]; !ok {
+				if _, ok := outs[coin.Denom]; !ok {
+					denoms = append(denoms, coin.Denom)
+				}
+			}
+			ins[coin.Denom] = append(ins[coin.Denom], holding{in.Address, coin.Amount})
+		}
+	}
+	for _, out := range outputs {
+		for _, coin := range out.Coins {
+			if _, ok := ins[coin.Denom]; !ok {
+				if _, ok := outs[coin.Denom]; !ok {
+					denoms = append(denoms, coin.Denom)
+				}
+			}
+			outs[coin.Denom] = append(outs[coin.Denom], holding{out.Address, coin.Amount})
+		}
+	}
+
+	tags := sdk.EmptyTags()
+	for _, denom := range denoms {
+		from, to := ins[denom], outs[denom]
+		for len(from) > 0 || len(to) > 0 {
+			switch {
+			case len(to) == 0:
+				tags = tags.AppendTags(auth.TransferTags(msgIndex, from[0].addr, nil, sdk.Coins{{Denom: denom, Amount: from[0].amount}}))
+				from = from[1:]
+			case len(from) == 0:
+				tags = tags.AppendTags(auth.TransferTags(msgIndex, nil, to[0].addr, sdk.Coins{{Denom: denom, Amount: to[0].amount}}))
+				to = to[1:]
+			default:
+				amount := from[0].amount
+				if to[0].amount.LT(amount) {
+					amount = to[0].amount
+				}
+				tags = tags.AppendTags(auth.TransferTags(msgIndex, from[0].addr, to[0].addr, sdk.Coins{{Denom: denom, Amount: amount}}))
+				from[0].amount = from[0].amount.Sub(amount)
+				to[0].amount = to[0].amount.Sub(amount)
+				if from[0].amount.IsZero() {
+					from = from[1:]
+				}
+				if to[0].amount.IsZero() {
+					to = to[1:]
+				}
+			}
+		}
+	}
+	return tags
+}
@@ -207,3 +207,51 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 15)}))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewCoin("barcoin", 5)}))
 }
+
+func TestVestingAccountKeeper(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: 150}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	coinKeeper := NewKeeper(accountMapper).WithModuleAccounts(map[string][]string{"stake": {auth.Staking}})
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	baseAcc := auth.NewBaseAccountWithAddress(addr)
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("steak", 100)})
+	vacc, err := auth.NewContinuousVestingAccount(&baseAcc, 100, 200)
+	require.Nil(t, err)
+	accountMapper.SetAccount(ctx, vacc)
+
+	// only the vested coins can be sent
+	_, sdkErr := coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("steak", 51)})
+	require.NotNil(t, sdkErr)
+	_, sdkErr = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("steak", 10)})
+	require.Nil(t, sdkErr)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("steak", 90)}))
+
+	// locked coins can be delegated
+	_, sdkErr = coinKeeper.DelegateCoinsFromAccountToModule(ctx, addr, "stake", sdk.Coins{sdk.NewCoin("steak", 80)})
+	require.Nil(t, sdkErr)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("steak", 10)}))
+	acc := accountMapper.GetAccount(ctx, addr).(auth.VestingAccount)
+	require.True(t, acc.GetDelegatedVesting().IsEqual(sdk.Coins{sdk.NewCoin("steak", 50)}))
+	require.True(t, acc.GetDelegatedFree().IsEqual(sdk.Coins{sdk.NewCoin("steak", 30)}))
+
+	// can't delegate more than the account holds
+	_, sdkErr = coinKeeper.DelegateCoinsFromAccountToModule(ctx, addr, "stake", sdk.Coins{sdk.NewCoin("steak", 11)})
+	require.NotNil(t, sdkErr)
+
+	// undelegated coins are locked again
+	_, sdkErr = coinKeeper.UndelegateCoinsFromModuleToAccount(ctx, "stake", addr, sdk.Coins{sdk.NewCoin("steak", 80)})
+	require.Nil(t, sdkErr)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewCoin("steak", 90)}))
+	_, sdkErr = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("steak", 41)})
+	require.NotNil(t, sdkErr)
+	_, sdkErr = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewCoin("steak", 40)})
+	require.Nil(t, sdkErr)
+}
@@ -0,0 +1,101 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// WithModuleAccounts returns the Keeper moving the coins of the module
+// accounts of the modules, given by name with their permissions
+func (keeper Keeper) WithModuleAccounts(permissions map[string][]string) Keeper {
+	keeper.modulePerms = permissions
+	return keeper
+}
+
+// GetModuleAccount returns the module account of the module, creating it if
+// it doesn't exist. The module must be registered with WithModuleAccounts.
+func (keeper Keeper) GetModuleAccount(ctx sdk.Context, module string) *auth.ModuleAccount {
+	perms, ok := keeper.modulePerms[module]
+	if !ok {
+		panic(fmt.Sprintf("module account %s isn't registered", module))
+	}
+	return keeper.am.GetModuleAccount(ctx, module, perms...)
+}
+
+// get the module account of the module, which must have the permission
+func (keeper Keeper) getModuleAccountWithPermission(ctx sdk.Context, module string, permission string) *auth.ModuleAccount {
+	macc := keeper.GetModuleAccount(ctx, module)
+	if !macc.HasPermission(permission) {
+		panic(fmt.Sprintf("module account %s doesn't have the %s permission", module, permission))
+	}
+	return macc
+}
+
+// SendCoinsFromModuleToAccount moves coins of the module account to the addr
+func (keeper Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, module string, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.GetModuleAccount(ctx, module)
+	return sendCoins(ctx, keeper.am, macc.Address, toAddr, amt)
+}
+
+// SendCoinsFromAccountToModule moves coins of the addr to the module account
+func (keeper Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, fromAddr sdk.AccAddress, module string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.GetModuleAccount(ctx, module)
+	return sendCoins(ctx, keeper.am, fromAddr, macc.Address, amt)
+}
+
+// SendCoinsFromModuleToModule moves coins between module accounts
+func (keeper Keeper) SendCoinsFromModuleToModule(ctx sdk.Context, fromModule, toModule string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	from := keeper.GetModuleAccount(ctx, fromModule)
+	to := keeper.GetModuleAccount(ctx, toModule)
+	return sendCoins(ctx, keeper.am, from.Address, to.Address, amt)
+}
+
+// DelegateCoinsFromAccountToModule moves coins delegated by the addr to the
+// module account, which needs the staking permission. Unlike
+// SendCoinsFromAccountToModule, the coins which are still vesting may be
+// delegated.
+func (keeper Keeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, fromAddr sdk.AccAddress, module string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.getModuleAccountWithPermission(ctx, module, auth.Staking)
+	subTags, err := delegateCoins(ctx, keeper.am, fromAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	_, addTags, err := addCoins(ctx, keeper.am, macc.Address, amt)
+	if err != nil {
+		return nil, err
+	}
+	auth.EmitTransfers(ctx, fromAddr, macc.Address, amt)
+	return subTags.AppendTags(addTags), nil
+}
+
+// UndelegateCoinsFromModuleToAccount moves coins unbonded by the addr from
+// the module account, which needs the staking permission, back to the addr
+func (keeper Keeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, module string, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.getModuleAccountWithPermission(ctx, module, auth.Staking)
+	_, subTags, err := subtractCoins(ctx, keeper.am, macc.Address, amt)
+	if err != nil {
+		return nil, err
+	}
+	addTags, err := undelegateCoins(ctx, keeper.am, toAddr, amt)
+	if err != nil {
+		return nil, err
+	}
+	auth.EmitTransfers(ctx, macc.Address, toAddr, amt)
+	return subTags.AppendTags(addTags), nil
+}
+
+// MintModuleCoins mints coins into the module account, which needs the
+// minter permission
+func (keeper Keeper) MintModuleCoins(ctx sdk.Context, module string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.getModuleAccountWithPermission(ctx, module, auth.Minter)
+	return keeper.MintCoins(ctx, macc.Address, amt)
+}
+
+// BurnModuleCoins burns coins of the module account, which needs the burner
+// permission
+func (keeper Keeper) BurnModuleCoins(ctx sdk.Context, module string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc := keeper.getModuleAccountWithPermission(ctx, module, auth.Burner)
+	return keeper.BurnCoins(ctx, macc.Address, amt)
+}
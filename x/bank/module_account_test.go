@@ -0,0 +1,78 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestModuleAccounts(t *testing.T) {
+	ms, authKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: 150}, false, log.NewNopLogger())
+	am := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	keeper := NewKeeper(am).WithModuleAccounts(map[string][]string{
+		"escrow": nil,
+		"mint":   {auth.Minter, auth.Burner},
+		"stake":  {auth.Staking},
+	})
+
+	addr := sdk.AccAddress([]byte("addr"))
+	escrowAddr := auth.NewModuleAddress("escrow")
+	stakeAddr := auth.NewModuleAddress("stake")
+	steak := func(amount int64) sdk.Coins { return sdk.Coins{sdk.NewCoin("steak", amount)} }
+
+	// the minter mints into its module account and sends the coins on
+	_, err := keeper.MintModuleCoins(ctx, "mint", steak(100))
+	require.Nil(t, err)
+	_, err = keeper.SendCoinsFromModuleToAccount(ctx, "mint", addr, steak(60))
+	require.Nil(t, err)
+	_, err = keeper.SendCoinsFromModuleToModule(ctx, "mint", "escrow", steak(40))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(steak(60)))
+	require.True(t, keeper.GetCoins(ctx, escrowAddr).IsEqual(steak(40)))
+	require.True(t, keeper.GetModuleAccount(ctx, "mint").GetCoins().IsZero())
+
+	// accounts send coins to module accounts and back
+	_, err = keeper.SendCoinsFromAccountToModule(ctx, addr, "escrow", steak(10))
+	require.Nil(t, err)
+	_, err = keeper.SendCoinsFromModuleToAccount(ctx, "escrow", addr, steak(51))
+	require.NotNil(t, err)
+	_, err = keeper.SendCoinsFromModuleToAccount(ctx, "escrow", addr, steak(50))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr).IsEqual(steak(100)))
+
+	// the vesting coins of an account can only be delegated
+	baseAcc := auth.NewBaseAccountWithAddress(sdk.AccAddress([]byte("vesting")))
+	baseAcc.SetCoins(steak(100))
+	vacc, err2 := auth.NewContinuousVestingAccount(&baseAcc, 100, 200)
+	require.Nil(t, err2)
+	am.SetAccount(ctx, vacc)
+	_, err = keeper.SendCoinsFromAccountToModule(ctx, vacc.Address, "escrow", steak(60))
+	require.NotNil(t, err)
+	_, err = keeper.DelegateCoinsFromAccountToModule(ctx, vacc.Address, "stake", steak(60))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, stakeAddr).IsEqual(steak(60)))
+	_, err = keeper.UndelegateCoinsFromModuleToAccount(ctx, "stake", vacc.Address, steak(60))
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, vacc.Address).IsEqual(steak(100)))
+
+	// the module accounts need the permissions
+	require.Panics(t, func() { keeper.MintModuleCoins(ctx, "escrow", steak(1)) })
+	require.Panics(t, func() { keeper.BurnModuleCoins(ctx, "escrow", steak(1)) })
+	require.Panics(t, func() { keeper.DelegateCoinsFromAccountToModule(ctx, addr, "escrow", steak(1)) })
+	require.Panics(t, func() { keeper.SendCoinsFromAccountToModule(ctx, addr, "unknown", steak(1)) })
+	_, err = keeper.BurnModuleCoins(ctx, "mint", steak(1))
+	require.NotNil(t, err)
+}
@@ -2,6 +2,8 @@ package bank
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -87,7 +89,8 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 //----------------------------------------
 // MsgIssue
 
-// MsgIssue - high level transaction of the coin module
+// MsgIssue - mint the coins of the outputs, the banker must be the issuer of
+// their denominations
 type MsgIssue struct {
 	Banker  sdk.AccAddress `json:"banker"`
 	Outputs []Output       `json:"outputs"`
@@ -95,7 +98,7 @@ type MsgIssue struct {
 
 var _ sdk.Msg = MsgIssue{}
 
-// NewMsgIssue - construct arbitrary multi-in, multi-out send msg.
+// NewMsgIssue - construct a msg minting the coins of the outputs.
 func NewMsgIssue(banker sdk.AccAddress, out []Output) MsgIssue {
 	return MsgIssue{Banker: banker, Outputs: out}
 }
@@ -105,7 +108,9 @@ func (msg MsgIssue) Type() string { return "bank" } // TODO: "bank/issue"
 
 // Implements Msg.
 func (msg MsgIssue) ValidateBasic() sdk.Error {
-	// XXX
+	if len(msg.Banker) == 0 {
+		return sdk.ErrInvalidAddress(msg.Banker.String())
+	}
 	if len(msg.Outputs) == 0 {
 		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
@@ -141,6 +146,153 @@ func (msg MsgIssue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Banker}
 }
 
+//----------------------------------------
+// MsgRegisterDenom
+
+// MsgRegisterDenom - register a new denomination issued by the issuer, with
+// a max supply, zero for none
+type MsgRegisterDenom struct {
+	Issuer    sdk.AccAddress `json:"issuer"`
+	Denom     string         `json:"denom"`
+	MaxSupply sdk.Int        `json:"max_supply"`
+}
+
+var _ sdk.Msg = MsgRegisterDenom{}
+
+// NewMsgRegisterDenom - construct a msg registering a denomination
+func NewMsgRegisterDenom(issuer sdk.AccAddress, denom string, maxSupply sdk.Int) MsgRegisterDenom {
+	return MsgRegisterDenom{Issuer: issuer, Denom: denom, MaxSupply: maxSupply}
+}
+
+// Implements Msg.
+func (msg MsgRegisterDenom) Type() string { return "bank" }
+
+// Implements Msg.
+func (msg MsgRegisterDenom) ValidateBasic() sdk.Error {
+	if len(msg.Issuer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Issuer.String())
+	}
+	if len(msg.Denom) == 0 {
+		return ErrInvalidDenom(DefaultCodespace, "no denomination")
+	}
+	if strings.Contains(msg.Denom, IBCDenomSeparator) {
+		return ErrInvalidDenom(DefaultCodespace, fmt.Sprintf("%s is reserved for IBC vouchers", msg.Denom))
+	}
+	if msg.MaxSupply.IsNil() {
+		return sdk.ErrInvalidCoins("no max supply")
+	}
+	if msg.MaxSupply.Sign() < 0 {
+		return ErrInvalidMaxSupply(DefaultCodespace, msg.MaxSupply)
+	}
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgRegisterDenom) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgRegisterDenom) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Issuer}
+}
+
+//----------------------------------------
+// MsgBurn
+
+// MsgBurn - burn issued coins of the owner
+type MsgBurn struct {
+	Owner sdk.AccAddress `json:"owner"`
+	Coins sdk.Coins      `json:"coins"`
+}
+
+var _ sdk.Msg = MsgBurn{}
+
+// NewMsgBurn - construct a msg burning coins
+func NewMsgBurn(owner sdk.AccAddress, coins sdk.Coins) MsgBurn {
+	return MsgBurn{Owner: owner, Coins: coins}
+}
+
+// Implements Msg.
+func (msg MsgBurn) Type() string { return "bank" }
+
+// Implements Msg.
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if !msg.Coins.IsValid() || !msg.Coins.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.Coins.String())
+	}
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgBurn) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+//----------------------------------------
+// MsgTransferIssuer
+
+// MsgTransferIssuer - hand the issuance of a denomination to a new issuer
+type MsgTransferIssuer struct {
+	Issuer    sdk.AccAddress `json:"issuer"`
+	Denom     string         `json:"denom"`
+	NewIssuer sdk.AccAddress `json:"new_issuer"`
+}
+
+var _ sdk.Msg = MsgTransferIssuer{}
+
+// NewMsgTransferIssuer - construct a msg transferring an issuance
+func NewMsgTransferIssuer(issuer sdk.AccAddress, denom string, newIssuer sdk.AccAddress) MsgTransferIssuer {
+	return MsgTransferIssuer{Issuer: issuer, Denom: denom, NewIssuer: newIssuer}
+}
+
+// Implements Msg.
+func (msg MsgTransferIssuer) Type() string { return "bank" }
+
+// Implements Msg.
+func (msg MsgTransferIssuer) ValidateBasic() sdk.Error {
+	if len(msg.Issuer) == 0 {
+		return sdk.ErrInvalidAddress(msg.Issuer.String())
+	}
+	if len(msg.NewIssuer) == 0 {
+		return sdk.ErrInvalidAddress(msg.NewIssuer.String())
+	}
+	if len(msg.Denom) == 0 {
+		return ErrInvalidDenom(DefaultCodespace, "no denomination")
+	}
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgTransferIssuer) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgTransferIssuer) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Issuer}
+}
+
 //----------------------------------------
 // Input
 
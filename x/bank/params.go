@@ -0,0 +1,124 @@
+package bank
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// keys of the bank params in the param store
+const (
+	// whether sends are enabled, true if unset
+	SendEnabledKey = "bank/SendEnabled"
+	// whether sends of a denomination are enabled, true if unset
+	DenomSendEnabledKeyPrefix = "bank/SendEnabled/"
+)
+
+// DenomSendEnabledKey returns the param key of whether sends of the
+// denomination are enabled
+func DenomSendEnabledKey(denom string) string {
+	return DenomSendEnabledKeyPrefix + denom
+}
+
+// SendEnabled is whether sends of a denomination are enabled, or of all the
+// denominations if the denomination is empty
+type SendEnabled struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NewSendEnabled creates a new SendEnabled
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// param key of the SendEnabled
+func (se SendEnabled) key() string {
+	if se.Denom == "" {
+		return SendEnabledKey
+	}
+	return DenomSendEnabledKey(se.Denom)
+}
+
+// WithParams returns the Keeper reading whether sends are enabled from the
+// param store, without params all sends are enabled
+func (keeper Keeper) WithParams(setter params.Setter) Keeper {
+	keeper.params = &setter
+	return keeper
+}
+
+// IsSendEnabled returns whether the coins of the denomination can be sent,
+// i.e. whether sends and the sends of the denomination are enabled
+func (keeper Keeper) IsSendEnabled(ctx sdk.Context, denom string) bool {
+	if keeper.params == nil {
+		return true
+	}
+	return keeper.params.GetBoolWithDefault(ctx, SendEnabledKey, true) &&
+		keeper.params.GetBoolWithDefault(ctx, DenomSendEnabledKey(denom), true)
+}
+
+// check that the coins can be sent
+func (keeper Keeper) checkSendEnabled(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	for _, coin := range amt {
+		if !keeper.IsSendEnabled(ctx, coin.Denom) {
+			return ErrSendDisabled(DefaultCodespace, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// SetSendEnabled sets whether sends, or the sends of a denomination, are
+// enabled. It requires the params.
+func (keeper Keeper) SetSendEnabled(ctx sdk.Context, se SendEnabled) {
+	if keeper.params == nil {
+		panic("the bank params aren't enabled")
+	}
+	keeper.params.SetBool(ctx, se.key(), se.Enabled)
+}
+
+// GetSendEnabled returns whether sends are enabled, followed by the
+// denominations whose sends are set, in order of denomination. It returns
+// nothing without params.
+func (keeper Keeper) GetSendEnabled(ctx sdk.Context) (ses []SendEnabled) {
+	if keeper.params == nil {
+		return nil
+	}
+	if keeper.params.GetRaw(ctx, SendEnabledKey) != nil {
+		ses = append(ses, NewSendEnabled("", keeper.params.GetBoolWithDefault(ctx, SendEnabledKey, true)))
+	}
+	keeper.params.IterateRaw(ctx, DenomSendEnabledKeyPrefix, func(key string, _ []byte) (stop bool) {
+		ses = append(ses, NewSendEnabled(strings.TrimPrefix(key, DenomSendEnabledKeyPrefix), keeper.params.GetBoolWithDefault(ctx, key, true)))
+		return false
+	})
+	return ses
+}
+
+// NewParamChangeHandler returns the handler of the changes of the bank
+// params, whose values are JSON booleans
+func NewParamChangeHandler(keeper Keeper) params.ChangeHandler {
+	return func(ctx sdk.Context, change params.Change) sdk.Error {
+		var se SendEnabled
+		switch {
+		case change.Key == SendEnabledKey:
+		case strings.HasPrefix(change.Key, DenomSendEnabledKeyPrefix):
+			se.Denom = strings.TrimPrefix(change.Key, DenomSendEnabledKeyPrefix)
+			if se.Denom == "" {
+				return ErrInvalidDenom(DefaultCodespace, "no denomination specified")
+			}
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unknown bank param %s", change.Key))
+		}
+		err := json.Unmarshal([]byte(change.Value), &se.Enabled)
+		if err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid value %s of param %s, it must be true or false", change.Value, change.Key))
+		}
+		keeper.SetSendEnabled(ctx, se)
+		return nil
+	}
+}
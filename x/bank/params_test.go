@@ -0,0 +1,127 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func setupParamsTest() (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	paramsKey := sdk.NewKVStoreKey("params")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	am := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	keeper := NewKeeper(am).WithParams(params.NewKeeper(cdc, paramsKey).Setter())
+	return ctx, keeper
+}
+
+func TestSendEnabled(t *testing.T) {
+	ctx, keeper := setupParamsTest()
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	keeper.SetCoins(ctx, addr, sdk.Coins{sdk.NewCoin("foocoin", 100), sdk.NewCoin("steak", 100)})
+	foo := sdk.Coins{sdk.NewCoin("foocoin", 10)}
+	steak := sdk.Coins{sdk.NewCoin("steak", 10)}
+
+	// everything is enabled by default
+	require.True(t, keeper.IsSendEnabled(ctx, "steak"))
+	require.Nil(t, keeper.GetSendEnabled(ctx))
+	_, err := keeper.SendCoins(ctx, addr, addr2, steak)
+	require.Nil(t, err)
+
+	// disabling a denomination only stops its sends
+	keeper.SetSendEnabled(ctx, NewSendEnabled("steak", false))
+	_, err = keeper.SendCoins(ctx, addr, addr2, steak)
+	require.NotNil(t, err)
+	require.Equal(t, CodeSendDisabled, err.Code())
+	_, err = keeper.SendCoins(ctx, addr, addr2, foo)
+	require.Nil(t, err)
+	_, err = keeper.InputOutputCoins(ctx, []Input{NewInput(addr, steak)}, []Output{NewOutput(addr2, steak)})
+	require.NotNil(t, err)
+	require.True(t, keeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewCoin("foocoin", 10), sdk.NewCoin("steak", 10)}))
+
+	// disabling all sends stops the sends of every denomination
+	keeper.SetSendEnabled(ctx, NewSendEnabled("steak", true))
+	keeper.SetSendEnabled(ctx, NewSendEnabled("", false))
+	_, err = keeper.SendCoins(ctx, addr, addr2, foo)
+	require.NotNil(t, err)
+	require.False(t, keeper.IsSendEnabled(ctx, "steak"))
+
+	require.Equal(t, []SendEnabled{NewSendEnabled("", false), NewSendEnabled("steak", true)}, keeper.GetSendEnabled(ctx))
+}
+
+func TestBlockedAddrs(t *testing.T) {
+	ctx, keeper := setupParamsTest()
+	keeper = keeper.WithModuleAccounts(map[string][]string{"escrow": nil}).
+		WithBlockedAddrs(auth.NewModuleAddress("escrow"))
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	escrowAddr := auth.NewModuleAddress("escrow")
+	steak := sdk.Coins{sdk.NewCoin("steak", 10)}
+	keeper.SetCoins(ctx, addr, sdk.Coins{sdk.NewCoin("steak", 100)})
+
+	require.True(t, keeper.IsBlockedAddr(escrowAddr))
+	require.False(t, keeper.IsBlockedAddr(addr))
+
+	// sends to the module account are rejected
+	_, err := keeper.SendCoins(ctx, addr, escrowAddr, steak)
+	require.NotNil(t, err)
+	require.Equal(t, CodeBlockedRecipient, err.Code())
+	_, err = keeper.InputOutputCoins(ctx, []Input{NewInput(addr, steak)}, []Output{NewOutput(escrowAddr, steak)})
+	require.NotNil(t, err)
+
+	// while the module moves coins into it, even with sends disabled
+	keeper.SetSendEnabled(ctx, NewSendEnabled("", false))
+	_, err = keeper.SendCoinsFromAccountToModule(ctx, addr, "escrow", steak)
+	require.Nil(t, err)
+	require.True(t, keeper.GetCoins(ctx, escrowAddr).IsEqual(steak))
+}
+
+func TestParamChangeHandler(t *testing.T) {
+	ctx, keeper := setupParamsTest()
+	router := params.NewChangeRouter().AddRoute("bank", NewParamChangeHandler(keeper))
+
+	cases := []struct {
+		change     params.Change
+		expectPass bool
+	}{
+		{params.NewChange(DenomSendEnabledKey("steak"), "false"), true},
+		{params.NewChange(SendEnabledKey, "true"), true},
+		{params.NewChange(DenomSendEnabledKey(""), "false"), false},
+		{params.NewChange(DenomSendEnabledKey("steak"), "no"), false},
+		{params.NewChange("bank/Unknown", "true"), false},
+		{params.NewChange("stake/SendEnabled", "true"), false},
+		{params.NewChange("bank/SendEnabled", ""), false},
+	}
+
+	for i, tc := range cases {
+		err := router.Apply(ctx, tc.change)
+		if tc.expectPass {
+			require.Nil(t, err, "test: %v", i)
+		} else {
+			require.NotNil(t, err, "test: %v", i)
+		}
+	}
+	require.True(t, keeper.IsSendEnabled(ctx, "foocoin"))
+	require.False(t, keeper.IsSendEnabled(ctx, "steak"))
+}
@@ -0,0 +1,55 @@
+package bank
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// query endpoints supported by the bank querier
+const (
+	QuerySupply = "supply"
+)
+
+// SupplyResponse is the result of the supply query. The total supply is
+// every coin in existence, the circulating supply the coins of the accounts
+// which can be spent, i.e. less the coins still vesting, the coins bonded and
+// the coins of the module accounts.
+type SupplyResponse struct {
+	Total       sdk.Coins `json:"total"`
+	Circulating sdk.Coins `json:"circulating"`
+}
+
+// NewQuerier returns the querier of the bank queries, it requires a Keeper
+// recording the supply
+func NewQuerier(cdc *wire.Codec, keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no bank query endpoint specified")
+		}
+		switch path[0] {
+		case QuerySupply:
+			return querySupply(ctx, cdc, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown bank query endpoint %s", path[0]))
+		}
+	}
+}
+
+func querySupply(ctx sdk.Context, cdc *wire.Codec, keeper Keeper) ([]byte, sdk.Error) {
+	if keeper.sk == nil {
+		return nil, sdk.ErrUnknownRequest("the supply isn't recorded")
+	}
+	res := SupplyResponse{
+		Total:       keeper.sk.GetTotalSupply(ctx),
+		Circulating: keeper.GetCirculatingSupply(ctx),
+	}
+	bz, err := cdc.MarshalJSON(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,186 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// SupplyKeyPrefix prefixes the store keys of the supply of the denominations
+var SupplyKeyPrefix = []byte("supply:")
+
+// SupplyKey returns the store key of the supply of a denomination
+func SupplyKey(denom string) []byte {
+	return append([]byte("supply:"), []byte(denom)...)
+}
+
+// SupplyKeeper records the total supply of each denomination, updated by
+// the coins minted and burned
+type SupplyKeeper struct {
+	key sdk.StoreKey
+	cdc *wire.Codec
+}
+
+// NewSupplyKeeper returns a new SupplyKeeper
+func NewSupplyKeeper(cdc *wire.Codec, key sdk.StoreKey) SupplyKeeper {
+	return SupplyKeeper{
+		key: key,
+		cdc: cdc,
+	}
+}
+
+// GetSupply returns the supply of a denomination
+func (keeper SupplyKeeper) GetSupply(ctx sdk.Context, denom string) sdk.Int {
+	store := ctx.KVStore(keeper.key)
+	bz := store.Get(SupplyKey(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	var supply sdk.Int
+	keeper.cdc.MustUnmarshalBinary(bz, &supply)
+	return supply
+}
+
+func (keeper SupplyKeeper) setSupply(ctx sdk.Context, denom string, supply sdk.Int) {
+	store := ctx.KVStore(keeper.key)
+	if supply.IsZero() {
+		store.Delete(SupplyKey(denom))
+		return
+	}
+	store.Set(SupplyKey(denom), keeper.cdc.MustMarshalBinary(supply))
+}
+
+// GetTotalSupply returns the supply of all the denominations in order
+func (keeper SupplyKeeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(keeper.key)
+	iter := sdk.KVStorePrefixIterator(store, SupplyKeyPrefix)
+	defer iter.Close()
+	supply := sdk.Coins{}
+	for ; iter.Valid(); iter.Next() {
+		var amount sdk.Int
+		keeper.cdc.MustUnmarshalBinary(iter.Value(), &amount)
+		denom := string(iter.Key()[len(SupplyKeyPrefix):])
+		supply = append(supply, sdk.Coin{Denom: denom, Amount: amount})
+	}
+	return supply
+}
+
+// SetTotalSupply sets the supply of the denominations, e.g. at genesis
+func (keeper SupplyKeeper) SetTotalSupply(ctx sdk.Context, supply sdk.Coins) {
+	for _, coin := range keeper.GetTotalSupply(ctx) {
+		keeper.setSupply(ctx, coin.Denom, sdk.ZeroInt())
+	}
+	for _, coin := range supply {
+		keeper.setSupply(ctx, coin.Denom, coin.Amount)
+	}
+}
+
+// Inflate adds the coins minted to the supply
+func (keeper SupplyKeeper) Inflate(ctx sdk.Context, coins sdk.Coins) {
+	for _, coin := range coins {
+		keeper.setSupply(ctx, coin.Denom, keeper.GetSupply(ctx, coin.Denom).Add(coin.Amount))
+	}
+}
+
+// Deflate subtracts the coins burned from the supply, which can't go below
+// zero. The supply is left unchanged if any of the coins exceeds it.
+func (keeper SupplyKeeper) Deflate(ctx sdk.Context, coins sdk.Coins) sdk.Error {
+	for _, coin := range coins {
+		supply := keeper.GetSupply(ctx, coin.Denom)
+		if coin.Amount.GT(supply) {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("burned %s, more than the supply %s", coin, supply))
+		}
+	}
+	for _, coin := range coins {
+		keeper.setSupply(ctx, coin.Denom, keeper.GetSupply(ctx, coin.Denom).Sub(coin.Amount))
+	}
+	return nil
+}
+
+//______________________________________________________________________________________________
+
+// WithSupply returns the Keeper recording the coins it mints and burns in
+// the supply
+func (keeper Keeper) WithSupply(sk SupplyKeeper) Keeper {
+	keeper.sk = &sk
+	return keeper
+}
+
+// MintCoins adds newly minted coins to the coins at the addr
+func (keeper Keeper) MintCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	_, tags, err := addCoins(ctx, keeper.am, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+	keeper.InflateSupply(ctx, amt)
+	auth.EmitTransfers(ctx, nil, addr, amt)
+	return tags, nil
+}
+
+// BurnCoins burns coins at the addr. The coins are subtracted and the supply
+// deflated together, neither is changed if either fails.
+func (keeper Keeper) BurnCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	cacheCtx, write := ctx.CacheContext()
+	_, tags, err := subtractCoins(cacheCtx, keeper.am, addr, amt)
+	if err != nil {
+		return nil, err
+	}
+	err = keeper.DeflateSupply(cacheCtx, amt)
+	if err != nil {
+		return nil, err
+	}
+	write()
+	auth.EmitTransfers(ctx, addr, nil, amt)
+	return tags, nil
+}
+
+// InflateSupply records coins minted outside of the accounts, e.g. into
+// the fee pool, if the Keeper records the supply
+func (keeper Keeper) InflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	if keeper.sk != nil {
+		keeper.sk.Inflate(ctx, amt)
+	}
+}
+
+// DeflateSupply records coins burned outside of the accounts, e.g. slashed
+// stake, if the Keeper records the supply
+func (keeper Keeper) DeflateSupply(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	if keeper.sk == nil {
+		return nil
+	}
+	return keeper.sk.Deflate(ctx, amt)
+}
+
+// GetCirculatingSupply returns the coins of all the accounts which can be
+// spent at the current block time, leaving out the module accounts
+func (keeper Keeper) GetCirculatingSupply(ctx sdk.Context) sdk.Coins {
+	circulating := sdk.Coins{}
+	blockTime := ctx.BlockHeader().Time
+	keeper.am.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		if _, ok := acc.(*auth.ModuleAccount); ok {
+			return false
+		}
+		circulating = circulating.Plus(auth.SpendableCoins(acc, blockTime))
+		return false
+	})
+	return circulating
+}
+
+// GetTotalSupply returns the supply of all the denominations if the Keeper
+// records it
+func (keeper Keeper) GetTotalSupply(ctx sdk.Context) sdk.Coins {
+	if keeper.sk == nil {
+		return nil
+	}
+	return keeper.sk.GetTotalSupply(ctx)
+}
+
+// SetTotalSupply sets the supply of all the denominations if the Keeper
+// records it, e.g. at genesis
+func (keeper Keeper) SetTotalSupply(ctx sdk.Context, supply sdk.Coins) {
+	if keeper.sk != nil {
+		keeper.sk.SetTotalSupply(ctx, supply)
+	}
+}
@@ -0,0 +1,97 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestSupply(t *testing.T) {
+	ctx, am, ik := setupIssuanceKeeper()
+	keeper := NewKeeper(am).WithSupply(ik.sk)
+
+	addr := sdk.AccAddress([]byte("addr"))
+	keeper.SetTotalSupply(ctx, sdk.Coins{sdk.NewCoin("steak", 100)})
+
+	// minting and burning update the supply
+	_, err := keeper.MintCoins(ctx, addr, sdk.Coins{sdk.NewCoin("atom", 10), sdk.NewCoin("steak", 50)})
+	require.Nil(t, err)
+	_, err = keeper.BurnCoins(ctx, addr, sdk.Coins{sdk.NewCoin("atom", 10), sdk.NewCoin("steak", 20)})
+	require.Nil(t, err)
+	_, err = keeper.BurnCoins(ctx, addr, sdk.Coins{sdk.NewCoin("steak", 40)})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 130)}, keeper.GetTotalSupply(ctx))
+
+	// a burn exceeding the supply leaves the coins of the account unchanged
+	keeper.SetTotalSupply(ctx, sdk.Coins{sdk.NewCoin("steak", 20)})
+	_, err = keeper.BurnCoins(ctx, addr, sdk.Coins{sdk.NewCoin("steak", 25)})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 30)}, keeper.GetCoins(ctx, addr))
+	keeper.SetTotalSupply(ctx, sdk.Coins{sdk.NewCoin("steak", 130)})
+
+	// coins burned outside of the accounts
+	require.Nil(t, keeper.DeflateSupply(ctx, sdk.Coins{sdk.NewCoin("steak", 30)}))
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 100)}, keeper.GetTotalSupply(ctx))
+	err = keeper.DeflateSupply(ctx, sdk.Coins{sdk.NewCoin("atom", 1), sdk.NewCoin("steak", 101)})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 100)}, keeper.GetTotalSupply(ctx))
+
+	// transfers leave the supply unchanged
+	_, err = keeper.SendCoins(ctx, addr, sdk.AccAddress([]byte("other")), sdk.Coins{sdk.NewCoin("steak", 10)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 100)}, keeper.GetTotalSupply(ctx))
+
+	// a keeper without supply doesn't record it
+	_, err = NewKeeper(am).MintCoins(ctx, addr, sdk.Coins{sdk.NewCoin("steak", 10)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 100)}, keeper.GetTotalSupply(ctx))
+}
+
+func TestQuerySupply(t *testing.T) {
+	db := dbm.NewMemDB()
+	authKey := sdk.NewKVStoreKey("authkey")
+	bankKey := sdk.NewKVStoreKey("bank")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	cdc := wire.NewCodec()
+	auth.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: 150}, false, log.NewNopLogger())
+	am := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	keeper := NewKeeper(am).WithSupply(NewSupplyKeeper(cdc, bankKey))
+
+	// the coins still vesting don't circulate
+	baseAcc := auth.NewBaseAccountWithAddress(sdk.AccAddress([]byte("vesting")))
+	baseAcc.SetCoins(sdk.Coins{sdk.NewCoin("steak", 40)})
+	vacc, err := auth.NewDelayedVestingAccount(&baseAcc, 200)
+	require.Nil(t, err)
+	am.SetAccount(ctx, vacc)
+	keeper.InflateSupply(ctx, sdk.Coins{sdk.NewCoin("steak", 40)})
+	_, sdkErr := keeper.MintCoins(ctx, sdk.AccAddress([]byte("addr")), sdk.Coins{sdk.NewCoin("steak", 60)})
+	require.Nil(t, sdkErr)
+
+	querier := NewQuerier(cdc, keeper)
+	bz, sdkErr := querier(ctx, []string{QuerySupply}, abci.RequestQuery{})
+	require.Nil(t, sdkErr)
+	var res SupplyResponse
+	require.Nil(t, cdc.UnmarshalJSON(bz, &res))
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 100)}, res.Total)
+	require.Equal(t, sdk.Coins{sdk.NewCoin("steak", 60)}, res.Circulating)
+
+	// the supply isn't recorded without a supply keeper
+	_, sdkErr = NewQuerier(cdc, NewKeeper(am))(ctx, []string{QuerySupply}, abci.RequestQuery{})
+	require.NotNil(t, sdkErr)
+}
@@ -8,6 +8,9 @@ import (
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/Send", nil)
 	cdc.RegisterConcrete(MsgIssue{}, "cosmos-sdk/Issue", nil)
+	cdc.RegisterConcrete(MsgRegisterDenom{}, "cosmos-sdk/RegisterDenom", nil)
+	cdc.RegisterConcrete(MsgBurn{}, "cosmos-sdk/Burn", nil)
+	cdc.RegisterConcrete(MsgTransferIssuer{}, "cosmos-sdk/TransferIssuer", nil)
 }
 
 var msgCdc = wire.NewCodec()
@@ -0,0 +1,90 @@
+package distribution
+
+import (
+	"bytes"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// AllocateTokens distributes the fees collected by the fee collector module
+// account in the previous block. The proposer of the block is paid the
+// proposer reward, the community pool the community tax, and the rest is
+// divided among the validators by power, the share of those which didn't
+// sign the block going to the community pool as well.
+func (k Keeper) AllocateTokens(ctx sdk.Context, proposerAddr []byte, votes []abci.SigningValidator) {
+	fees := k.coinKeeper.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins()
+	if fees.IsZero() {
+		return
+	}
+	_, err := k.coinKeeper.SendCoinsFromModuleToModule(ctx, auth.FeeCollectorName, ModuleName, fees)
+	if err != nil {
+		panic(err)
+	}
+
+	feesCollected := NewRatCoins(fees)
+	remaining := feesCollected
+
+	var totalPower, signedPower int64
+	for _, vote := range votes {
+		totalPower += vote.Validator.Power
+		if vote.SignedLastBlock {
+			signedPower += vote.Validator.Power
+		}
+	}
+	if totalPower == 0 {
+		k.addToCommunityPool(ctx, remaining)
+		return
+	}
+
+	// the proposer reward, its bonus growing with the power which signed
+	params := k.GetParams(ctx)
+	proposerMultiplier := params.BaseProposerReward.Add(
+		params.BonusProposerReward.Mul(sdk.NewRat(signedPower, totalPower)))
+	proposer, found := k.getBondedValidatorByConsAddr(ctx, proposerAddr)
+	if found {
+		proposerReward := feesCollected.MulRat(proposerMultiplier)
+		k.allocateTokensToValidator(ctx, proposer, proposerReward)
+		remaining = remaining.Minus(proposerReward)
+	} else {
+		ctx.Logger().With("module", "x/distribution").Error("the proposer of the previous block isn't a bonded validator, its reward goes to the community pool")
+	}
+
+	// the rest, less the community tax, by power
+	voteMultiplier := sdk.OneRat().Sub(proposerMultiplier).Sub(params.CommunityTax)
+	for _, vote := range votes {
+		if !vote.SignedLastBlock {
+			continue
+		}
+		pubkey, err := tmtypes.PB2TM.PubKey(vote.Validator.PubKey)
+		if err != nil {
+			panic(err)
+		}
+		validator, found := k.stakeKeeper.GetValidatorByPubKey(ctx, pubkey)
+		if !found {
+			continue
+		}
+		reward := feesCollected.MulRat(voteMultiplier.Mul(sdk.NewRat(vote.Validator.Power, totalPower)))
+		k.allocateTokensToValidator(ctx, validator, reward)
+		remaining = remaining.Minus(reward)
+	}
+
+	k.addToCommunityPool(ctx, remaining)
+}
+
+// get the bonded validator of the consensus address
+func (k Keeper) getBondedValidatorByConsAddr(ctx sdk.Context, consAddr []byte) (validator stake.Validator, found bool) {
+	if len(consAddr) == 0 {
+		return validator, false
+	}
+	for _, validator := range k.stakeKeeper.GetValidatorsBonded(ctx) {
+		if bytes.Equal(validator.PubKey.Address(), consAddr) {
+			return validator, true
+		}
+	}
+	return validator, false
+}
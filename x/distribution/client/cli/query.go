@@ -0,0 +1,100 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+)
+
+// GetCmdQueryDelegationRewards implements the query of the rewards of a
+// delegation
+func GetCmdQueryDelegationRewards(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards [delegator-addr] [validator-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the rewards of a delegation which can be withdrawn",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			validatorAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(distribution.QueryDelegationRewardsParams{
+				DelegatorAddr: delegatorAddr,
+				ValidatorAddr: validatorAddr,
+			})
+			if err != nil {
+				return err
+			}
+
+			var rewards sdk.Coins
+			return queryAndPrint(cdc, fmt.Sprintf("/custom/%s/%s", queryRoute, distribution.QueryDelegationRewards), bz, &rewards)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryValidatorCommission implements the query of the commission
+// accumulated by a validator
+func GetCmdQueryValidatorCommission(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commission [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the commission accumulated by a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			validatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(distribution.QueryValidatorCommissionParams{ValidatorAddr: validatorAddr})
+			if err != nil {
+				return err
+			}
+
+			var commission distribution.RatCoins
+			return queryAndPrint(cdc, fmt.Sprintf("/custom/%s/%s", queryRoute, distribution.QueryValidatorCommission), bz, &commission)
+		},
+	}
+	return cmd
+}
+
+// GetCmdQueryCommunityPool implements the query of the community pool
+func GetCmdQueryCommunityPool(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool",
+		Args:  cobra.NoArgs,
+		Short: "Query the coins of the community pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var pool distribution.RatCoins
+			return queryAndPrint(cdc, fmt.Sprintf("/custom/%s/%s", queryRoute, distribution.QueryCommunityPool), nil, &pool)
+		},
+	}
+	return cmd
+}
+
+// query the path and print the response decoded into res
+func queryAndPrint(cdc *wire.Codec, path string, data []byte, res interface{}) error {
+	ctx := context.NewCoreContextFromViper()
+	bz, err := ctx.QueryWithData(path, data)
+	if err != nil {
+		return err
+	}
+	err = cdc.UnmarshalJSON(bz, res)
+	if err != nil {
+		return err
+	}
+	output, err := wire.MarshalJSONIndent(cdc, res)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+	return nil
+}
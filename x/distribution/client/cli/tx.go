@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+)
+
+// create withdraw delegator reward command
+func GetCmdWithdrawDelegatorReward(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards [validator-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "withdraw the rewards of the delegation of the sender to a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			validatorAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			delegatorAddr, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := distribution.NewMsgWithdrawDelegatorReward(delegatorAddr, validatorAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// create withdraw validator commission command
+func GetCmdWithdrawValidatorCommission(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-commission",
+		Args:  cobra.NoArgs,
+		Short: "withdraw the commission of the validator owned by the sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			validatorAddr, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := distribution.NewMsgWithdrawValidatorCommission(validatorAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
+
+// create fund community pool command
+func GetCmdFundCommunityPool(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-community-pool [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "deposit coins of the sender to the community pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
+			amount, err := ctx.ParseCoins(args[0])
+			if err != nil {
+				return err
+			}
+			depositor, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := distribution.NewMsgFundCommunityPool(amount, depositor)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,28 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundCommunityPool moves the coins of the depositor to the community pool
+func (k Keeper) FundCommunityPool(ctx sdk.Context, amount sdk.Coins, depositor sdk.AccAddress) sdk.Error {
+	_, err := k.coinKeeper.SendCoinsFromAccountToModule(ctx, depositor, ModuleName, amount)
+	if err != nil {
+		return err
+	}
+	k.addToCommunityPool(ctx, NewRatCoins(amount))
+	return nil
+}
+
+// DistributeFromCommunityPool pays the coins of the community pool to the
+// recipient, as spent by a passed governance proposal
+func (k Keeper) DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) sdk.Error {
+	pool := k.GetCommunityPool(ctx).Minus(NewRatCoins(amount))
+	if !pool.IsNotNegative() {
+		return ErrInsufficientCommunityPool(k.codespace, amount)
+	}
+	k.SetCommunityPool(ctx, pool)
+
+	_, err := k.coinKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, recipient, amount)
+	return err
+}
@@ -0,0 +1,127 @@
+package distribution
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// DelegatorStartingInfo is the period of the validator which ended before
+// the delegation started or was last withdrawn, since which its shares
+// haven't changed
+type DelegatorStartingInfo struct {
+	PreviousPeriod uint64 `json:"previous_period"`
+	Height         int64  `json:"height"`
+}
+
+// NewDelegatorStartingInfo returns the starting info of a delegation
+func NewDelegatorStartingInfo(previousPeriod uint64, height int64) DelegatorStartingInfo {
+	return DelegatorStartingInfo{
+		PreviousPeriod: previousPeriod,
+		Height:         height,
+	}
+}
+
+// start accumulating the rewards of the delegation from the current period
+func (k Keeper) initializeDelegation(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	previousPeriod := k.mustGetValidatorCurrentRewards(ctx, valAddr).Period - 1
+	k.incrementReferenceCount(ctx, valAddr, previousPeriod)
+	k.SetDelegatorStartingInfo(ctx, delAddr, valAddr, NewDelegatorStartingInfo(previousPeriod, ctx.BlockHeight()))
+}
+
+// calculate the rewards of the shares between the ends of the two periods
+func (k Keeper) calculateDelegationRewardsBetween(ctx sdk.Context, valAddr sdk.AccAddress,
+	startingPeriod, endingPeriod uint64, shares sdk.Rat) RatCoins {
+
+	starting := k.mustGetValidatorHistoricalRewards(ctx, valAddr, startingPeriod)
+	ending := k.mustGetValidatorHistoricalRewards(ctx, valAddr, endingPeriod)
+	difference := ending.CumulativeRewardRatio.Minus(starting.CumulativeRewardRatio)
+	if !difference.IsNotNegative() {
+		panic("the cumulative rewards per share of a validator decreased")
+	}
+	return difference.MulRat(shares)
+}
+
+// withdraw the rewards of the delegation up to the end of the current
+// period of its validator and delete its starting info. The whole coins are
+// paid to the delegator and the fractional remainder to the community pool.
+func (k Keeper) withdrawDelegationRewards(ctx sdk.Context, delegation stake.Delegation) (sdk.Coins, sdk.Error) {
+	startingInfo, found := k.GetDelegatorStartingInfo(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+	if !found {
+		return nil, ErrNoDelegation(k.codespace)
+	}
+
+	endingPeriod := k.incrementValidatorPeriod(ctx, delegation.ValidatorAddr)
+	rewards := k.calculateDelegationRewardsBetween(ctx, delegation.ValidatorAddr,
+		startingInfo.PreviousPeriod, endingPeriod, delegation.Shares)
+	k.decrementReferenceCount(ctx, delegation.ValidatorAddr, startingInfo.PreviousPeriod)
+	k.deleteDelegatorStartingInfo(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+
+	coins, remainder := rewards.TruncateDecimal()
+	k.addToCommunityPool(ctx, remainder)
+	if !coins.IsZero() {
+		_, err := k.coinKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, delegation.DelegatorAddr, coins)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return coins, nil
+}
+
+// WithdrawDelegationRewards pays the rewards accumulated by the delegation
+// to the delegator
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	if _, found := k.stakeKeeper.GetValidator(ctx, valAddr); !found {
+		return nil, ErrNoValidatorFound(k.codespace)
+	}
+	delegation, found := k.stakeKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, ErrNoDelegation(k.codespace)
+	}
+
+	coins, err := k.withdrawDelegationRewards(ctx, delegation)
+	if err != nil {
+		return nil, err
+	}
+	k.initializeDelegation(ctx, delAddr, valAddr)
+	return coins, nil
+}
+
+// GetDelegationRewards returns the rewards accumulated by the delegation
+// which would be withdrawn now, without the fractional remainder
+func (k Keeper) GetDelegationRewards(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	if _, found := k.stakeKeeper.GetValidator(ctx, valAddr); !found {
+		return nil, ErrNoValidatorFound(k.codespace)
+	}
+	delegation, found := k.stakeKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, ErrNoDelegation(k.codespace)
+	}
+
+	// end the current period in a cache which is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	startingInfo, found := k.GetDelegatorStartingInfo(cacheCtx, delAddr, valAddr)
+	if !found {
+		return nil, ErrNoDelegation(k.codespace)
+	}
+	endingPeriod := k.incrementValidatorPeriod(cacheCtx, valAddr)
+	rewards := k.calculateDelegationRewardsBetween(cacheCtx, valAddr,
+		startingInfo.PreviousPeriod, endingPeriod, delegation.Shares)
+	coins, _ := rewards.TruncateDecimal()
+	return coins, nil
+}
+
+// withdraw the rewards of the delegations which are left to a removed
+// validator, after it was slashed to zero tokens
+func (k Keeper) withdrawRemovedValidatorDelegations(ctx sdk.Context, valAddr sdk.AccAddress) {
+	for _, delegation := range k.stakeKeeper.GetValidatorDelegations(ctx, valAddr) {
+		if _, found := k.GetDelegatorStartingInfo(ctx, delegation.DelegatorAddr, valAddr); !found {
+			continue
+		}
+		_, err := k.withdrawDelegationRewards(ctx, delegation)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+//nolint
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default distribution codespace
+	DefaultCodespace sdk.CodespaceType = 13
+
+	CodeInvalidAddress        CodeType = 101
+	CodeNoValidator           CodeType = 102
+	CodeNoDelegation          CodeType = 103
+	CodeNoValidatorCommission CodeType = 104
+	CodeInsufficientPool      CodeType = 105
+	CodeInvalidAmount         CodeType = 106
+)
+
+func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAddress, "delegator address is nil")
+}
+func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAddress, "validator address is nil")
+}
+func ErrNoValidatorFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoValidator, "validator does not exist for that address")
+}
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "no delegation for this (address, validator) pair")
+}
+func ErrNoValidatorCommission(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoValidatorCommission, "validator has no commission to withdraw")
+}
+func ErrInsufficientCommunityPool(codespace sdk.CodespaceType, amount sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientPool, fmt.Sprintf("community pool doesn't hold %v", amount))
+}
+func ErrInvalidAmount(codespace sdk.CodespaceType, amount sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAmount, fmt.Sprintf("invalid amount %v", amount))
+}
@@ -0,0 +1,186 @@
+package distribution
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the distribution params, the community pool and the
+// rewards records of the validators and of the delegations at genesis
+type GenesisState struct {
+	Params                 Params                                 `json:"params"`
+	CommunityPool          RatCoins                               `json:"community_pool"`
+	PreviousProposer       []byte                                 `json:"previous_proposer"`
+	CurrentRewards         []ValidatorCurrentRewardsRecord        `json:"validator_current_rewards"`
+	HistoricalRewards      []ValidatorHistoricalRewardsRecord     `json:"validator_historical_rewards"`
+	AccumulatedCommissions []ValidatorAccumulatedCommissionRecord `json:"validator_accumulated_commissions"`
+	DelegatorStartingInfos []DelegatorStartingInfoRecord          `json:"delegator_starting_infos"`
+}
+
+// ValidatorCurrentRewardsRecord - the current rewards of a validator
+type ValidatorCurrentRewardsRecord struct {
+	ValidatorAddr sdk.AccAddress          `json:"validator_addr"`
+	Rewards       ValidatorCurrentRewards `json:"rewards"`
+}
+
+// ValidatorHistoricalRewardsRecord - the historical rewards of a period of
+// a validator
+type ValidatorHistoricalRewardsRecord struct {
+	ValidatorAddr sdk.AccAddress             `json:"validator_addr"`
+	Period        uint64                     `json:"period"`
+	Rewards       ValidatorHistoricalRewards `json:"rewards"`
+}
+
+// ValidatorAccumulatedCommissionRecord - the commission accumulated by a
+// validator
+type ValidatorAccumulatedCommissionRecord struct {
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+	Commission    RatCoins       `json:"commission"`
+}
+
+// DelegatorStartingInfoRecord - the starting info of a delegation
+type DelegatorStartingInfoRecord struct {
+	DelegatorAddr sdk.AccAddress        `json:"delegator_addr"`
+	ValidatorAddr sdk.AccAddress        `json:"validator_addr"`
+	StartingInfo  DelegatorStartingInfo `json:"starting_info"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:        DefaultParams(),
+		CommunityPool: RatCoins{},
+	}
+}
+
+// ValidateGenesis checks the params, that the amounts aren't negative and
+// that the historical rewards the delegations start from are recorded
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if !data.CommunityPool.IsNotNegative() {
+		return errors.New("the community pool can't be negative")
+	}
+	for _, record := range data.CurrentRewards {
+		if !record.Rewards.Rewards.IsNotNegative() {
+			return fmt.Errorf("the current rewards of validator %s can't be negative", record.ValidatorAddr)
+		}
+	}
+	historical := make(map[string]bool, len(data.HistoricalRewards))
+	for _, record := range data.HistoricalRewards {
+		if !record.Rewards.CumulativeRewardRatio.IsNotNegative() {
+			return fmt.Errorf("the historical rewards of validator %s can't be negative", record.ValidatorAddr)
+		}
+		historical[fmt.Sprintf("%s/%d", record.ValidatorAddr, record.Period)] = true
+	}
+	for _, record := range data.AccumulatedCommissions {
+		if !record.Commission.IsNotNegative() {
+			return fmt.Errorf("the commission of validator %s can't be negative", record.ValidatorAddr)
+		}
+	}
+	for _, record := range data.DelegatorStartingInfos {
+		if !historical[fmt.Sprintf("%s/%d", record.ValidatorAddr, record.StartingInfo.PreviousPeriod)] {
+			return fmt.Errorf("the delegation of %s to %s starts from unknown period %d",
+				record.DelegatorAddr, record.ValidatorAddr, record.StartingInfo.PreviousPeriod)
+		}
+	}
+	return nil
+}
+
+// InitGenesis - set the params, the community pool and the rewards records,
+// and start accumulating the rewards of the validators and of the
+// delegations of the stake genesis without records, the stake genesis must
+// be initialized first
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+	k.SetCommunityPool(ctx, data.CommunityPool)
+	if data.PreviousProposer != nil {
+		k.SetPreviousProposer(ctx, data.PreviousProposer)
+	}
+	for _, record := range data.CurrentRewards {
+		k.SetValidatorCurrentRewards(ctx, record.ValidatorAddr, record.Rewards)
+	}
+	for _, record := range data.HistoricalRewards {
+		k.SetValidatorHistoricalRewards(ctx, record.ValidatorAddr, record.Period, record.Rewards)
+	}
+	for _, record := range data.AccumulatedCommissions {
+		k.SetValidatorAccumulatedCommission(ctx, record.ValidatorAddr, record.Commission)
+	}
+	for _, record := range data.DelegatorStartingInfos {
+		k.SetDelegatorStartingInfo(ctx, record.DelegatorAddr, record.ValidatorAddr, record.StartingInfo)
+	}
+
+	for _, validator := range k.stakeKeeper.GetAllValidators(ctx) {
+		if _, found := k.GetValidatorCurrentRewards(ctx, validator.Owner); !found {
+			k.initializeValidator(ctx, validator.Owner)
+		}
+	}
+	for _, delegation := range k.stakeKeeper.GetAllDelegations(ctx) {
+		if _, found := k.GetDelegatorStartingInfo(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr); !found {
+			k.initializeDelegation(ctx, delegation.DelegatorAddr, delegation.ValidatorAddr)
+		}
+	}
+	return nil
+}
+
+// WriteGenesis - output the params, the community pool and the rewards
+// records
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	store := ctx.KVStore(k.storeKey)
+	data := GenesisState{
+		Params:           k.GetParams(ctx),
+		CommunityPool:    k.GetCommunityPool(ctx),
+		PreviousProposer: k.GetPreviousProposer(ctx),
+	}
+
+	iter := sdk.KVStorePrefixIterator(store, ValidatorCurrentRewardsKey)
+	for ; iter.Valid(); iter.Next() {
+		record := ValidatorCurrentRewardsRecord{ValidatorAddr: sdk.AccAddress(iter.Key()[1:])}
+		k.cdc.MustUnmarshalBinary(iter.Value(), &record.Rewards)
+		data.CurrentRewards = append(data.CurrentRewards, record)
+	}
+	iter.Close()
+
+	// the historical rewards are keyed by validator and big endian period
+	iter = sdk.KVStorePrefixIterator(store, ValidatorHistoricalRewardsKey)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		record := ValidatorHistoricalRewardsRecord{
+			ValidatorAddr: sdk.AccAddress(key[1 : len(key)-8]),
+			Period:        binary.BigEndian.Uint64(key[len(key)-8:]),
+		}
+		k.cdc.MustUnmarshalBinary(iter.Value(), &record.Rewards)
+		data.HistoricalRewards = append(data.HistoricalRewards, record)
+	}
+	iter.Close()
+
+	iter = sdk.KVStorePrefixIterator(store, ValidatorAccumulatedCommissionKey)
+	for ; iter.Valid(); iter.Next() {
+		record := ValidatorAccumulatedCommissionRecord{ValidatorAddr: sdk.AccAddress(iter.Key()[1:])}
+		k.cdc.MustUnmarshalBinary(iter.Value(), &record.Commission)
+		data.AccumulatedCommissions = append(data.AccumulatedCommissions, record)
+	}
+	iter.Close()
+
+	// the starting infos are keyed by delegator and validator address
+	iter = sdk.KVStorePrefixIterator(store, DelegatorStartingInfoKey)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		record := DelegatorStartingInfoRecord{
+			DelegatorAddr: sdk.AccAddress(key[1 : 1+sdk.AddrLen]),
+			ValidatorAddr: sdk.AccAddress(key[1+sdk.AddrLen:]),
+		}
+		k.cdc.MustUnmarshalBinary(iter.Value(), &record.StartingInfo)
+		data.DelegatorStartingInfos = append(data.DelegatorStartingInfos, record)
+	}
+	iter.Close()
+
+	return data
+}
@@ -0,0 +1,34 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	allocateTestFees(t, ctx, ck, keeper)
+	keeper.SetPreviousProposer(ctx, pks[0].Address())
+
+	// the rewards of both validators and of their self-delegations
+	genesis := WriteGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(genesis))
+	require.Len(t, genesis.CurrentRewards, 2)
+	require.NotEmpty(t, genesis.HistoricalRewards)
+	require.Len(t, genesis.AccumulatedCommissions, 2)
+	require.Len(t, genesis.DelegatorStartingInfos, 2)
+	require.True(t, ratSteak(2, 1).IsEqual(genesis.CommunityPool))
+
+	// the same state is exported on a new chain
+	ctx2, _, _, keeper2 := createTestInput(t)
+	require.Nil(t, InitGenesis(ctx2, keeper2, genesis))
+	cdc := createTestCodec()
+	require.Equal(t, cdc.MustMarshalJSON(genesis), cdc.MustMarshalJSON(WriteGenesis(ctx2, keeper2)))
+	require.True(t, ratSteak(103, 4).IsEqual(keeper2.GetValidatorAccumulatedCommission(ctx2, addrs[0])))
+
+	// the delegations must start from a recorded period
+	genesis.HistoricalRewards = nil
+	require.NotNil(t, ValidateGenesis(genesis))
+}
@@ -0,0 +1,68 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewHandler returns the handler of the distribution msgs
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		// NOTE msg already has validate basic run
+		switch msg := msg.(type) {
+		case MsgWithdrawDelegatorReward:
+			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
+		case MsgWithdrawValidatorCommission:
+			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
+		case MsgFundCommunityPool:
+			return handleMsgFundCommunityPool(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in distribution module").Result()
+		}
+	}
+}
+
+func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg MsgWithdrawDelegatorReward, k Keeper) sdk.Result {
+	_, err := k.WithdrawDelegationRewards(ctx, msg.DelegatorAddr, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		sdk.TagAction, []byte("withdraw-delegator-reward"),
+		sdk.TagDelegator, []byte(msg.DelegatorAddr.String()),
+		sdk.TagSrcValidator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg MsgWithdrawValidatorCommission, k Keeper) sdk.Result {
+	_, err := k.WithdrawValidatorCommission(ctx, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		sdk.TagAction, []byte("withdraw-validator-commission"),
+		sdk.TagSrcValidator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgFundCommunityPool(ctx sdk.Context, msg MsgFundCommunityPool, k Keeper) sdk.Result {
+	err := k.FundCommunityPool(ctx, msg.Amount, msg.Depositor)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		sdk.TagAction, []byte("fund-community-pool"),
+		"depositor", []byte(msg.Depositor.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
@@ -0,0 +1,59 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Hooks are the staking hooks keeping the rewards of the validators and of
+// the delegations
+type Hooks struct {
+	k Keeper
+}
+
+var _ sdk.StakingHooks = Hooks{}
+
+// Hooks returns the staking hooks of the Keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterValidatorCreated initializes the rewards of the validator
+func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.AccAddress) {
+	h.k.initializeValidator(ctx, valAddr)
+}
+
+// BeforeValidatorRemoved pays the rewards of the validator and of the
+// delegations left to it, and deletes its rewards
+func (h Hooks) BeforeValidatorRemoved(ctx sdk.Context, valAddr sdk.AccAddress) {
+	h.k.withdrawRemovedValidatorDelegations(ctx, valAddr)
+	h.k.removeValidator(ctx, valAddr)
+}
+
+// BeforeDelegationCreated ends the period of the validator, whose shares
+// are changing
+func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	h.k.incrementValidatorPeriod(ctx, valAddr)
+}
+
+// BeforeDelegationSharesModified withdraws the rewards of the delegation
+// before its shares change
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	delegation, found := h.k.stakeKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return
+	}
+	_, err := h.k.withdrawDelegationRewards(ctx, delegation)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// BeforeDelegationRemoved does nothing, the rewards of the delegation were
+// withdrawn before its shares were removed
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {}
+
+// AfterDelegationModified starts accumulating the rewards of the delegation
+// with its new shares
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	h.k.initializeDelegation(ctx, delAddr, valAddr)
+}
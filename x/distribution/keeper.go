@@ -0,0 +1,200 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// ModuleName is the name of the module account of distribution, which holds
+// the rewards not yet withdrawn, the commissions and the community pool
+const ModuleName = "distribution"
+
+// Keeper of the distribution store. The rewards are accumulated lazily: a
+// validator records the rewards per share of its delegations at the end of
+// every period, a period ending whenever the shares of the validator
+// change, and a delegation is paid for the periods since it started when it
+// is withdrawn or its shares change.
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *wire.Codec
+	params      params.Setter
+	coinKeeper  bank.Keeper
+	stakeKeeper stake.Keeper
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper returns a new distribution Keeper, the coinKeeper must move the
+// coins of the fee collector and of the distribution module accounts
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, paramSetter params.Setter, ck bank.Keeper, sk stake.Keeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		params:      paramSetter,
+		coinKeeper:  ck,
+		stakeKeeper: sk,
+		codespace:   codespace,
+	}
+}
+
+//______________________________________________________________________
+
+// GetCommunityPool returns the coins of the community pool
+func (k Keeper) GetCommunityPool(ctx sdk.Context) (pool RatCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(CommunityPoolKey)
+	if bz == nil {
+		return RatCoins{}
+	}
+	k.cdc.MustUnmarshalBinary(bz, &pool)
+	return pool
+}
+
+// SetCommunityPool sets the coins of the community pool
+func (k Keeper) SetCommunityPool(ctx sdk.Context, pool RatCoins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(CommunityPoolKey, k.cdc.MustMarshalBinary(pool))
+}
+
+// add the coins to the community pool
+func (k Keeper) addToCommunityPool(ctx sdk.Context, coins RatCoins) {
+	if coins.IsZero() {
+		return
+	}
+	k.SetCommunityPool(ctx, k.GetCommunityPool(ctx).Plus(coins))
+}
+
+// GetPreviousProposer returns the consensus address of the proposer of the
+// previous block
+func (k Keeper) GetPreviousProposer(ctx sdk.Context) []byte {
+	store := ctx.KVStore(k.storeKey)
+	return store.Get(PreviousProposerKey)
+}
+
+// SetPreviousProposer sets the consensus address of the proposer of the
+// previous block
+func (k Keeper) SetPreviousProposer(ctx sdk.Context, consAddr []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(PreviousProposerKey, consAddr)
+}
+
+//______________________________________________________________________
+
+// GetValidatorCurrentRewards returns the rewards of the current period of
+// the validator
+func (k Keeper) GetValidatorCurrentRewards(ctx sdk.Context, valAddr sdk.AccAddress) (rewards ValidatorCurrentRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorCurrentRewardsKey(valAddr))
+	if bz == nil {
+		return rewards, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &rewards)
+	return rewards, true
+}
+
+// SetValidatorCurrentRewards sets the rewards of the current period of the
+// validator
+func (k Keeper) SetValidatorCurrentRewards(ctx sdk.Context, valAddr sdk.AccAddress, rewards ValidatorCurrentRewards) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorCurrentRewardsKey(valAddr), k.cdc.MustMarshalBinary(rewards))
+}
+
+// get the current rewards of a validator which must be initialized
+func (k Keeper) mustGetValidatorCurrentRewards(ctx sdk.Context, valAddr sdk.AccAddress) ValidatorCurrentRewards {
+	rewards, found := k.GetValidatorCurrentRewards(ctx, valAddr)
+	if !found {
+		panic("the rewards of the validator aren't initialized")
+	}
+	return rewards
+}
+
+// GetValidatorHistoricalRewards returns the cumulative rewards per share of
+// the validator at the end of the period
+func (k Keeper) GetValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.AccAddress, period uint64) (rewards ValidatorHistoricalRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorHistoricalRewardsKey(valAddr, period))
+	if bz == nil {
+		return rewards, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &rewards)
+	return rewards, true
+}
+
+// SetValidatorHistoricalRewards sets the cumulative rewards per share of the
+// validator at the end of the period
+func (k Keeper) SetValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.AccAddress, period uint64, rewards ValidatorHistoricalRewards) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorHistoricalRewardsKey(valAddr, period), k.cdc.MustMarshalBinary(rewards))
+}
+
+// get the historical rewards of a period which must still be referenced
+func (k Keeper) mustGetValidatorHistoricalRewards(ctx sdk.Context, valAddr sdk.AccAddress, period uint64) ValidatorHistoricalRewards {
+	rewards, found := k.GetValidatorHistoricalRewards(ctx, valAddr, period)
+	if !found {
+		panic("the historical rewards of the period aren't recorded")
+	}
+	return rewards
+}
+
+// GetValidatorAccumulatedCommission returns the commission accumulated by
+// the validator and not yet withdrawn
+func (k Keeper) GetValidatorAccumulatedCommission(ctx sdk.Context, valAddr sdk.AccAddress) (commission RatCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetValidatorAccumulatedCommissionKey(valAddr))
+	if bz == nil {
+		return RatCoins{}
+	}
+	k.cdc.MustUnmarshalBinary(bz, &commission)
+	return commission
+}
+
+// SetValidatorAccumulatedCommission sets the commission accumulated by the
+// validator and not yet withdrawn
+func (k Keeper) SetValidatorAccumulatedCommission(ctx sdk.Context, valAddr sdk.AccAddress, commission RatCoins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetValidatorAccumulatedCommissionKey(valAddr), k.cdc.MustMarshalBinary(commission))
+}
+
+// GetDelegatorStartingInfo returns the starting info of the delegation
+func (k Keeper) GetDelegatorStartingInfo(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) (info DelegatorStartingInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDelegatorStartingInfoKey(delAddr, valAddr))
+	if bz == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &info)
+	return info, true
+}
+
+// SetDelegatorStartingInfo sets the starting info of the delegation
+func (k Keeper) SetDelegatorStartingInfo(ctx sdk.Context, delAddr, valAddr sdk.AccAddress, info DelegatorStartingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetDelegatorStartingInfoKey(delAddr, valAddr), k.cdc.MustMarshalBinary(info))
+}
+
+// delete the starting info of the delegation
+func (k Keeper) deleteDelegatorStartingInfo(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegatorStartingInfoKey(delAddr, valAddr))
+}
+
+// delete all the rewards and the commission of the validator
+func (k Keeper) deleteValidatorRewards(ctx sdk.Context, valAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorCurrentRewardsKey(valAddr))
+	store.Delete(GetValidatorAccumulatedCommissionKey(valAddr))
+
+	var keys [][]byte
+	iter := sdk.KVStorePrefixIterator(store, GetValidatorHistoricalRewardsPrefix(valAddr))
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
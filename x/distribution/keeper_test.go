@@ -0,0 +1,253 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func steak(amt int64) sdk.Coins {
+	return sdk.Coins{{"steak", sdk.NewInt(amt)}}
+}
+
+func ratSteak(num, denom int64) RatCoins {
+	return RatCoins{{"steak", sdk.NewRat(num, denom)}}
+}
+
+// bond two validators of 100 steak, the first one charging half of its
+// rewards as commission
+func createTestValidators(t *testing.T, ctx sdk.Context, sk stake.Keeper) {
+	msg := newTestMsgCreateValidator(addrs[0], pks[0], sdk.NewInt(100)).
+		WithCommission(sdk.NewRat(1, 2), sdk.NewRat(1, 2), sdk.ZeroRat())
+	got := stake.NewHandler(sk)(ctx, msg)
+	require.True(t, got.IsOK(), "%v", got)
+	got = stake.NewHandler(sk)(ctx, newTestMsgCreateValidator(addrs[1], pks[1], sdk.NewInt(100)))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+}
+
+// collect 100 steak of fees and allocate them, both validators having
+// signed the block proposed by the first one
+func allocateTestFees(t *testing.T, ctx sdk.Context, ck bank.Keeper, keeper Keeper) {
+	_, err := ck.SendCoinsFromAccountToModule(ctx, addrs[2], auth.FeeCollectorName, steak(100))
+	require.Nil(t, err)
+	keeper.AllocateTokens(ctx, pks[0].Address(), []abci.SigningValidator{
+		newTestSigningValidator(pks[0], 100, true),
+		newTestSigningValidator(pks[1], 100, true),
+	})
+}
+
+// allocate rewards to the validator, the module account holding them
+func allocateTestRewards(t *testing.T, ctx sdk.Context, ck bank.Keeper, keeper Keeper,
+	sk stake.Keeper, valAddr sdk.AccAddress, amt int64) {
+	_, _, err := ck.AddCoins(ctx, auth.NewModuleAddress(ModuleName), steak(amt))
+	require.Nil(t, err)
+	validator, _ := sk.GetValidator(ctx, valAddr)
+	keeper.allocateTokensToValidator(ctx, validator, ratSteak(amt, 1))
+}
+
+func TestAllocateTokens(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	allocateTestFees(t, ctx, ck, keeper)
+
+	// the proposer is paid 5% and both validators 93% / 2, the community
+	// pool the 2% left
+	require.True(t, ck.GetModuleAccount(ctx, auth.FeeCollectorName).GetCoins().IsZero())
+	require.True(t, steak(100).IsEqual(ck.GetModuleAccount(ctx, ModuleName).GetCoins()))
+	require.True(t, ratSteak(103, 4).IsEqual(keeper.GetValidatorAccumulatedCommission(ctx, addrs[0])))
+	rewards, found := keeper.GetValidatorCurrentRewards(ctx, addrs[0])
+	require.True(t, found)
+	require.True(t, ratSteak(103, 4).IsEqual(rewards.Rewards))
+	require.True(t, keeper.GetValidatorAccumulatedCommission(ctx, addrs[1]).IsZero())
+	rewards, found = keeper.GetValidatorCurrentRewards(ctx, addrs[1])
+	require.True(t, found)
+	require.True(t, ratSteak(93, 2).IsEqual(rewards.Rewards))
+	require.True(t, ratSteak(2, 1).IsEqual(keeper.GetCommunityPool(ctx)))
+}
+
+func TestAllocateTokensUnknownProposer(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	_, err := ck.SendCoinsFromAccountToModule(ctx, addrs[2], auth.FeeCollectorName, steak(100))
+	require.Nil(t, err)
+
+	// the second validator didn't sign, the proposer is unknown
+	keeper.AllocateTokens(ctx, pks[2].Address(), []abci.SigningValidator{
+		newTestSigningValidator(pks[0], 100, true),
+		newTestSigningValidator(pks[1], 100, false),
+	})
+	require.True(t, ratSteak(95, 4).IsEqual(keeper.GetValidatorAccumulatedCommission(ctx, addrs[0])))
+	rewards, _ := keeper.GetValidatorCurrentRewards(ctx, addrs[1])
+	require.True(t, rewards.Rewards.IsZero())
+	require.True(t, ratSteak(105, 2).IsEqual(keeper.GetCommunityPool(ctx)))
+}
+
+func TestWithdrawDelegationRewards(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	allocateTestFees(t, ctx, ck, keeper)
+
+	rewards, err := keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])
+	require.Nil(t, err)
+	require.True(t, steak(46).IsEqual(rewards))
+
+	// the fractional remainder goes to the community pool
+	rewards, err = keeper.WithdrawDelegationRewards(ctx, addrs[1], addrs[1])
+	require.Nil(t, err)
+	require.True(t, steak(46).IsEqual(rewards))
+	require.True(t, steak(146).IsEqual(ck.GetCoins(ctx, addrs[1])))
+	require.True(t, ratSteak(5, 2).IsEqual(keeper.GetCommunityPool(ctx)))
+
+	// nothing is left to withdraw
+	rewards, err = keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])
+	require.Nil(t, err)
+	require.True(t, rewards.IsZero())
+
+	_, err = keeper.WithdrawDelegationRewards(ctx, addrs[2], addrs[1])
+	require.NotNil(t, err)
+	_, err = keeper.WithdrawDelegationRewards(ctx, addrs[1], addrs[2])
+	require.NotNil(t, err)
+}
+
+func TestDelegationRewardsSharesModified(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	handler := stake.NewHandler(sk)
+
+	// a second delegator doubles the shares of the validator
+	got := handler(ctx, stake.NewMsgDelegate(addrs[2], addrs[1], sdk.Coin{"steak", sdk.NewInt(100)}))
+	require.True(t, got.IsOK(), "%v", got)
+	allocateTestRewards(t, ctx, ck, keeper, sk, addrs[1], 100)
+
+	rewards, err := keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])
+	require.Nil(t, err)
+	require.True(t, steak(50).IsEqual(rewards))
+
+	// unbonding withdraws the rewards of the delegation
+	got = handler(ctx, stake.NewMsgBeginUnbonding(addrs[2], addrs[1], sdk.NewRat(100)))
+	require.True(t, got.IsOK(), "%v", got)
+	require.True(t, steak(150).IsEqual(ck.GetCoins(ctx, addrs[2])))
+	_, found := keeper.GetDelegatorStartingInfo(ctx, addrs[2], addrs[1])
+	require.False(t, found)
+
+	// the rewards of the other delegation are unchanged
+	rewards, err = keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])
+	require.Nil(t, err)
+	require.True(t, steak(50).IsEqual(rewards))
+
+	// delegating more withdraws the rewards as well
+	got = handler(ctx, stake.NewMsgDelegate(addrs[1], addrs[1], sdk.Coin{"steak", sdk.NewInt(50)}))
+	require.True(t, got.IsOK(), "%v", got)
+	require.True(t, steak(100).IsEqual(ck.GetCoins(ctx, addrs[1])))
+	_, found = keeper.GetDelegatorStartingInfo(ctx, addrs[1], addrs[1])
+	require.True(t, found)
+	require.True(t, keeper.GetCommunityPool(ctx).IsZero())
+}
+
+func TestWithdrawValidatorCommission(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	allocateTestFees(t, ctx, ck, keeper)
+
+	// the fractional remainder is kept
+	commission, err := keeper.WithdrawValidatorCommission(ctx, addrs[0])
+	require.Nil(t, err)
+	require.True(t, steak(25).IsEqual(commission))
+	require.True(t, steak(125).IsEqual(ck.GetCoins(ctx, addrs[0])))
+	require.True(t, ratSteak(3, 4).IsEqual(keeper.GetValidatorAccumulatedCommission(ctx, addrs[0])))
+
+	_, err = keeper.WithdrawValidatorCommission(ctx, addrs[0])
+	require.NotNil(t, err)
+	_, err = keeper.WithdrawValidatorCommission(ctx, addrs[1])
+	require.NotNil(t, err)
+	_, err = keeper.WithdrawValidatorCommission(ctx, addrs[2])
+	require.NotNil(t, err)
+}
+
+func TestRemoveValidator(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+
+	allocateTestRewards(t, ctx, ck, keeper, sk, addrs[0], 101)
+
+	// unbonding the only delegation removes the validator, its rewards and
+	// commission are paid to the owner
+	got := stake.NewHandler(sk)(ctx, stake.NewMsgBeginUnbonding(addrs[0], addrs[0], sdk.NewRat(100)))
+	require.True(t, got.IsOK(), "%v", got)
+	_, found := sk.GetValidator(ctx, addrs[0])
+	require.False(t, found)
+	require.True(t, steak(200).IsEqual(ck.GetCoins(ctx, addrs[0])))
+	require.True(t, ratSteak(1, 1).IsEqual(keeper.GetCommunityPool(ctx)))
+
+	_, found = keeper.GetValidatorCurrentRewards(ctx, addrs[0])
+	require.False(t, found)
+	_, found = keeper.GetValidatorHistoricalRewards(ctx, addrs[0], 1)
+	require.False(t, found)
+	require.True(t, keeper.GetValidatorAccumulatedCommission(ctx, addrs[0]).IsZero())
+}
+
+func TestRemoveSlashedValidator(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	createTestValidators(t, ctx, sk)
+	got := stake.NewHandler(sk)(ctx, stake.NewMsgDelegate(addrs[2], addrs[1], sdk.Coin{"steak", sdk.NewInt(100)}))
+	require.True(t, got.IsOK(), "%v", got)
+	allocateTestRewards(t, ctx, ck, keeper, sk, addrs[1], 100)
+
+	// slashing all the tokens removes the validator, the delegations left
+	// to it are paid their rewards
+	sk.Slash(ctx, pks[1], ctx.BlockHeight(), 200, sdk.OneRat())
+	_, found := sk.GetValidator(ctx, addrs[1])
+	require.False(t, found)
+	require.True(t, steak(150).IsEqual(ck.GetCoins(ctx, addrs[1])))
+	require.True(t, steak(150).IsEqual(ck.GetCoins(ctx, addrs[2])))
+	require.True(t, keeper.GetCommunityPool(ctx).IsZero())
+
+	_, found = keeper.GetDelegatorStartingInfo(ctx, addrs[2], addrs[1])
+	require.False(t, found)
+	_, found = keeper.GetValidatorCurrentRewards(ctx, addrs[1])
+	require.False(t, found)
+	_, found = sk.GetDelegation(ctx, addrs[2], addrs[1])
+	require.False(t, found)
+
+	// a validator recreated at the address starts without the delegations
+	// of the removed one
+	got = stake.NewHandler(sk)(ctx, newTestMsgCreateValidator(addrs[1], pks[1], sdk.NewInt(100)))
+	require.True(t, got.IsOK(), "%v", got)
+	got = stake.NewHandler(sk)(ctx, stake.NewMsgDelegate(addrs[2], addrs[1], sdk.Coin{"steak", sdk.NewInt(100)}))
+	require.True(t, got.IsOK(), "%v", got)
+	delegation, found := sk.GetDelegation(ctx, addrs[2], addrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.NewRat(100), delegation.Shares)
+	require.True(t, steak(50).IsEqual(ck.GetCoins(ctx, addrs[2])))
+}
+
+func TestCommunityPool(t *testing.T) {
+	ctx, ck, _, keeper := createTestInput(t)
+
+	// deposits are added to the community pool
+	got := NewHandler(keeper)(ctx, NewMsgFundCommunityPool(steak(150), addrs[0]))
+	require.True(t, got.IsOK(), "%v", got)
+	require.True(t, steak(50).IsEqual(ck.GetCoins(ctx, addrs[0])))
+	require.True(t, ratSteak(150, 1).IsEqual(keeper.GetCommunityPool(ctx)))
+	got = NewHandler(keeper)(ctx, NewMsgFundCommunityPool(steak(51), addrs[0]))
+	require.False(t, got.IsOK())
+
+	// spends can't exceed the community pool
+	err := keeper.DistributeFromCommunityPool(ctx, steak(151), addrs[1])
+	require.NotNil(t, err)
+	err = keeper.DistributeFromCommunityPool(ctx, sdk.Coins{{"atom", sdk.NewInt(1)}}, addrs[1])
+	require.NotNil(t, err)
+	err = keeper.DistributeFromCommunityPool(ctx, steak(100), addrs[1])
+	require.Nil(t, err)
+	require.True(t, steak(300).IsEqual(ck.GetCoins(ctx, addrs[1])))
+	require.True(t, ratSteak(50, 1).IsEqual(keeper.GetCommunityPool(ctx)))
+	require.True(t, steak(50).IsEqual(ck.GetModuleAccount(ctx, ModuleName).GetCoins()))
+}
@@ -0,0 +1,45 @@
+package distribution
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//nolint
+var (
+	// Keys for store prefixes
+	CommunityPoolKey                  = []byte{0x00} // key for the community pool
+	PreviousProposerKey               = []byte{0x01} // key for the consensus address of the proposer of the previous block
+	ValidatorCurrentRewardsKey        = []byte{0x02} // prefix for the rewards of the current period of each validator
+	ValidatorHistoricalRewardsKey     = []byte{0x03} // prefix for the cumulative rewards per share of each period of each validator
+	ValidatorAccumulatedCommissionKey = []byte{0x04} // prefix for the commission accumulated by each validator
+	DelegatorStartingInfoKey          = []byte{0x05} // prefix for the starting period of each delegation
+)
+
+// get the key for the current rewards of the validator
+func GetValidatorCurrentRewardsKey(valAddr sdk.AccAddress) []byte {
+	return append(ValidatorCurrentRewardsKey, valAddr.Bytes()...)
+}
+
+// get the prefix of the historical rewards of the validator
+func GetValidatorHistoricalRewardsPrefix(valAddr sdk.AccAddress) []byte {
+	return append(ValidatorHistoricalRewardsKey, valAddr.Bytes()...)
+}
+
+// get the key for the historical rewards of the period of the validator
+func GetValidatorHistoricalRewardsKey(valAddr sdk.AccAddress, period uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, period)
+	return append(GetValidatorHistoricalRewardsPrefix(valAddr), bz...)
+}
+
+// get the key for the accumulated commission of the validator
+func GetValidatorAccumulatedCommissionKey(valAddr sdk.AccAddress) []byte {
+	return append(ValidatorAccumulatedCommissionKey, valAddr.Bytes()...)
+}
+
+// get the key for the starting info of the delegation
+func GetDelegatorStartingInfoKey(delAddr, valAddr sdk.AccAddress) []byte {
+	return append(append(DelegatorStartingInfoKey, delAddr.Bytes()...), valAddr.Bytes()...)
+}
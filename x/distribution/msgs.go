@@ -0,0 +1,125 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "distribution"
+
+// verify interface at compile time
+var _, _, _ sdk.Msg = &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}, &MsgFundCommunityPool{}
+
+// MsgWithdrawDelegatorReward - struct for withdrawing the rewards of a
+// delegation
+type MsgWithdrawDelegatorReward struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+}
+
+func NewMsgWithdrawDelegatorReward(delAddr, valAddr sdk.AccAddress) MsgWithdrawDelegatorReward {
+	return MsgWithdrawDelegatorReward{
+		DelegatorAddr: delAddr,
+		ValidatorAddr: valAddr,
+	}
+}
+
+//nolint
+func (msg MsgWithdrawDelegatorReward) Type() string { return MsgType }
+func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() sdk.Error {
+	if len(msg.DelegatorAddr) == 0 {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if len(msg.ValidatorAddr) == 0 {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgWithdrawValidatorCommission - struct for withdrawing the commission
+// accumulated by a validator, signed by its owner
+type MsgWithdrawValidatorCommission struct {
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+}
+
+func NewMsgWithdrawValidatorCommission(valAddr sdk.AccAddress) MsgWithdrawValidatorCommission {
+	return MsgWithdrawValidatorCommission{
+		ValidatorAddr: valAddr,
+	}
+}
+
+//nolint
+func (msg MsgWithdrawValidatorCommission) Type() string { return MsgType }
+func (msg MsgWithdrawValidatorCommission) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ValidatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawValidatorCommission) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
+	if len(msg.ValidatorAddr) == 0 {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+// MsgFundCommunityPool - struct for depositing coins to the community pool
+type MsgFundCommunityPool struct {
+	Amount    sdk.Coins      `json:"amount"`
+	Depositor sdk.AccAddress `json:"depositor"`
+}
+
+func NewMsgFundCommunityPool(amount sdk.Coins, depositor sdk.AccAddress) MsgFundCommunityPool {
+	return MsgFundCommunityPool{
+		Amount:    amount,
+		Depositor: depositor,
+	}
+}
+
+//nolint
+func (msg MsgFundCommunityPool) Type() string { return MsgType }
+func (msg MsgFundCommunityPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgFundCommunityPool) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgFundCommunityPool) ValidateBasic() sdk.Error {
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return ErrInvalidAmount(DefaultCodespace, msg.Amount)
+	}
+	if len(msg.Depositor) == 0 {
+		return sdk.ErrInvalidAddress(msg.Depositor.String())
+	}
+	return nil
+}
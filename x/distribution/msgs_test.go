@@ -0,0 +1,33 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgFundCommunityPool(t *testing.T) {
+	tests := []struct {
+		amount     sdk.Coins
+		depositor  sdk.AccAddress
+		expectPass bool
+	}{
+		{steak(10), addrs[0], true},
+		{sdk.Coins{{"atom", sdk.NewInt(1)}, {"steak", sdk.NewInt(10)}}, addrs[0], true},
+		{sdk.Coins{}, addrs[0], false},
+		{steak(-10), addrs[0], false},
+		{sdk.Coins{{"steak", sdk.NewInt(10)}, {"atom", sdk.NewInt(1)}}, addrs[0], false},
+		{steak(10), sdk.AccAddress{}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgFundCommunityPool(tc.amount, tc.depositor)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
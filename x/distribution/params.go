@@ -0,0 +1,96 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// nolint
+const (
+	CommunityTaxKey        = "distribution/CommunityTax"
+	BaseProposerRewardKey  = "distribution/BaseProposerReward"
+	BonusProposerRewardKey = "distribution/BonusProposerReward"
+)
+
+// Params of the distribution of the fees of a block. The community tax is
+// paid to the community pool, the proposer of the block is paid the base
+// proposer reward plus the bonus proposer reward for the fraction of the
+// power which signed the block, and the rest is paid to the validators
+// which signed it by power.
+type Params struct {
+	CommunityTax        sdk.Rat `json:"community_tax"`
+	BaseProposerReward  sdk.Rat `json:"base_proposer_reward"`
+	BonusProposerReward sdk.Rat `json:"bonus_proposer_reward"`
+}
+
+// DefaultParams returns the default distribution params
+func DefaultParams() Params {
+	return Params{
+		CommunityTax:        sdk.NewRat(2, 100),
+		BaseProposerReward:  sdk.NewRat(1, 100),
+		BonusProposerReward: sdk.NewRat(4, 100),
+	}
+}
+
+// Validate checks that the rates are non negative and sum to at most one
+func (p Params) Validate() error {
+	for _, rate := range []sdk.Rat{p.CommunityTax, p.BaseProposerReward, p.BonusProposerReward} {
+		if rate.Rat == nil {
+			return fmt.Errorf("distribution rates must be set")
+		}
+		if rate.LT(sdk.ZeroRat()) {
+			return fmt.Errorf("distribution rates can't be negative: %v", rate.FloatString())
+		}
+	}
+	sum := p.CommunityTax.Add(p.BaseProposerReward).Add(p.BonusProposerReward)
+	if sum.GT(sdk.OneRat()) {
+		return fmt.Errorf("distribution rates sum to %v, more than one", sum.FloatString())
+	}
+	return nil
+}
+
+// GetParams returns the distribution params, the default ones if unset
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	def := DefaultParams()
+	return Params{
+		CommunityTax:        k.params.GetRatWithDefault(ctx, CommunityTaxKey, def.CommunityTax),
+		BaseProposerReward:  k.params.GetRatWithDefault(ctx, BaseProposerRewardKey, def.BaseProposerReward),
+		BonusProposerReward: k.params.GetRatWithDefault(ctx, BonusProposerRewardKey, def.BonusProposerReward),
+	}
+}
+
+// SetParams sets the distribution params
+func (k Keeper) SetParams(ctx sdk.Context, p Params) {
+	k.params.SetRat(ctx, CommunityTaxKey, p.CommunityTax)
+	k.params.SetRat(ctx, BaseProposerRewardKey, p.BaseProposerReward)
+	k.params.SetRat(ctx, BonusProposerRewardKey, p.BonusProposerReward)
+}
+
+// NewParamChangeHandler returns the handler of the changes of the
+// distribution params, whose values are decimals, e.g. 0.02
+func NewParamChangeHandler(k Keeper) params.ChangeHandler {
+	return func(ctx sdk.Context, change params.Change) sdk.Error {
+		rate, err := sdk.NewRatFromDecimal(change.Value, 18)
+		if err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid value %s of param %s, it must be a decimal", change.Value, change.Key))
+		}
+		p := k.GetParams(ctx)
+		switch change.Key {
+		case CommunityTaxKey:
+			p.CommunityTax = rate
+		case BaseProposerRewardKey:
+			p.BaseProposerReward = rate
+		case BonusProposerRewardKey:
+			p.BonusProposerReward = rate
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unknown distribution param %s", change.Key))
+		}
+		if err := p.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(err.Error())
+		}
+		k.SetParams(ctx, p)
+		return nil
+	}
+}
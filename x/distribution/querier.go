@@ -0,0 +1,81 @@
+package distribution
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the distribution querier
+const (
+	QueryDelegationRewards   = "delegation_rewards"
+	QueryValidatorCommission = "validator_commission"
+	QueryCommunityPool       = "community_pool"
+)
+
+// QueryDelegationRewardsParams are the params of the query of the rewards
+// of a delegation
+type QueryDelegationRewardsParams struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+}
+
+// QueryValidatorCommissionParams are the params of the query of the
+// commission accumulated by a validator
+type QueryValidatorCommissionParams struct {
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+}
+
+// NewQuerier returns the querier of the distribution queries
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no distribution query endpoint specified")
+		}
+		switch path[0] {
+		case QueryDelegationRewards:
+			return queryDelegationRewards(ctx, req, k)
+		case QueryValidatorCommission:
+			return queryValidatorCommission(ctx, req, k)
+		case QueryCommunityPool:
+			return k.marshalQueryResponse(k.GetCommunityPool(ctx))
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown distribution query endpoint %s", path[0]))
+		}
+	}
+}
+
+func queryDelegationRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryDelegationRewardsParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err.Error()))
+	}
+	rewards, sdkErr := k.GetDelegationRewards(ctx, params.DelegatorAddr, params.ValidatorAddr)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+	return k.marshalQueryResponse(rewards)
+}
+
+func queryValidatorCommission(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params QueryValidatorCommissionParams
+	err := k.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err.Error()))
+	}
+	if _, found := k.stakeKeeper.GetValidator(ctx, params.ValidatorAddr); !found {
+		return nil, ErrNoValidatorFound(k.codespace)
+	}
+	return k.marshalQueryResponse(k.GetValidatorAccumulatedCommission(ctx, params.ValidatorAddr))
+}
+
+func (k Keeper) marshalQueryResponse(res interface{}) ([]byte, sdk.Error) {
+	bz, err := k.cdc.MarshalJSON(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,17 @@
+package distribution
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker allocates the fees collected in the previous block to its
+// proposer, to the validators which signed it and to the community pool,
+// and records the proposer of the block for the next one
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	if ctx.BlockHeight() > 1 {
+		k.AllocateTokens(ctx, k.GetPreviousProposer(ctx), req.Validators)
+	}
+	k.SetPreviousProposer(ctx, req.Header.Proposer.Address)
+}
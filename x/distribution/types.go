@@ -0,0 +1,171 @@
+package distribution
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RatCoin is an amount of a denomination which may be fractional, the
+// rewards are divided among the validators and the delegators exactly and
+// only rounded down when they are withdrawn
+type RatCoin struct {
+	Denom  string  `json:"denom"`
+	Amount sdk.Rat `json:"amount"`
+}
+
+// String provides a human-readable representation of a RatCoin
+func (coin RatCoin) String() string {
+	return fmt.Sprintf("%v%v", coin.Amount.FloatString(), coin.Denom)
+}
+
+// RatCoins is a set of RatCoin sorted by denomination, without zero amounts
+type RatCoins []RatCoin
+
+// NewRatCoins returns the RatCoins of the coins
+func NewRatCoins(coins sdk.Coins) RatCoins {
+	ratCoins := RatCoins{}
+	for _, coin := range coins {
+		if coin.IsZero() {
+			continue
+		}
+		ratCoins = append(ratCoins, RatCoin{Denom: coin.Denom, Amount: sdk.NewRatFromInt(coin.Amount)})
+	}
+	return ratCoins
+}
+
+// String provides a human-readable representation of the RatCoins
+func (coins RatCoins) String() string {
+	if len(coins) == 0 {
+		return ""
+	}
+	out := make([]string, len(coins))
+	for i, coin := range coins {
+		out[i] = coin.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Plus combines two sets of RatCoins, dropping the zero sums
+func (coins RatCoins) Plus(coinsB RatCoins) RatCoins {
+	sum := RatCoins{}
+	indexA, indexB := 0, 0
+	lenA, lenB := len(coins), len(coinsB)
+	for {
+		if indexA == lenA {
+			return append(sum, coinsB[indexB:]...)
+		} else if indexB == lenB {
+			return append(sum, coins[indexA:]...)
+		}
+		coinA, coinB := coins[indexA], coinsB[indexB]
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1:
+			sum = append(sum, coinA)
+			indexA++
+		case 0:
+			amount := coinA.Amount.Add(coinB.Amount)
+			if !amount.IsZero() {
+				sum = append(sum, RatCoin{Denom: coinA.Denom, Amount: amount})
+			}
+			indexA++
+			indexB++
+		case 1:
+			sum = append(sum, coinB)
+			indexB++
+		}
+	}
+}
+
+// Minus subtracts a set of RatCoins from another
+func (coins RatCoins) Minus(coinsB RatCoins) RatCoins {
+	return coins.Plus(coinsB.MulRat(sdk.NewRat(-1)))
+}
+
+// MulRat multiplies every amount by the rational
+func (coins RatCoins) MulRat(r sdk.Rat) RatCoins {
+	res := RatCoins{}
+	for _, coin := range coins {
+		amount := coin.Amount.Mul(r)
+		if !amount.IsZero() {
+			res = append(res, RatCoin{Denom: coin.Denom, Amount: amount})
+		}
+	}
+	return res
+}
+
+// QuoRat divides every amount by the rational
+func (coins RatCoins) QuoRat(r sdk.Rat) RatCoins {
+	return coins.MulRat(sdk.OneRat().Quo(r))
+}
+
+// AmountOf returns the amount of the denomination
+func (coins RatCoins) AmountOf(denom string) sdk.Rat {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+	return sdk.ZeroRat()
+}
+
+// IsZero returns true if there are no coins
+func (coins RatCoins) IsZero() bool {
+	for _, coin := range coins {
+		if !coin.Amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNotNegative returns true if no amount is negative
+func (coins RatCoins) IsNotNegative() bool {
+	for _, coin := range coins {
+		if coin.Amount.LT(sdk.ZeroRat()) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsEqual returns true if the two sets of RatCoins have the same value
+func (coins RatCoins) IsEqual(coinsB RatCoins) bool {
+	return coins.Minus(coinsB).IsZero()
+}
+
+// TruncateDecimal returns the whole coins of the non negative RatCoins,
+// rounded down, and the fractional remainder
+func (coins RatCoins) TruncateDecimal() (sdk.Coins, RatCoins) {
+	truncated := sdk.Coins{}
+	remainder := RatCoins{}
+	for _, coin := range coins {
+		amount := coin.Amount.Num().Div(coin.Amount.Denom())
+		if !amount.IsZero() {
+			truncated = append(truncated, sdk.Coin{Denom: coin.Denom, Amount: amount})
+		}
+		change := coin.Amount.Sub(sdk.NewRatFromInt(amount))
+		if !change.IsZero() {
+			remainder = append(remainder, RatCoin{Denom: coin.Denom, Amount: change})
+		}
+	}
+	return truncated, remainder
+}
+
+// the precision of the cumulative rewards per share, which would otherwise
+// accumulate ever larger denominators
+var ratioPrecision = new(big.Int).Exp(big.NewInt(10), big.NewInt(18), nil)
+
+// round the non negative amounts down to the ratio precision
+func (coins RatCoins) truncatePrecision() RatCoins {
+	res := RatCoins{}
+	for _, coin := range coins {
+		scaled := coin.Amount.Mul(sdk.NewRatFromBigInt(ratioPrecision))
+		amount := sdk.NewRatFromBigInt(new(big.Int).Div(scaled.Num().BigInt(), scaled.Denom().BigInt()), ratioPrecision)
+		if !amount.IsZero() {
+			res = append(res, RatCoin{Denom: coin.Denom, Amount: amount})
+		}
+	}
+	return res
+}
@@ -0,0 +1,38 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRatCoinsArithmetic(t *testing.T) {
+	a := RatCoins{{"atom", sdk.NewRat(1, 2)}, {"steak", sdk.NewRat(3)}}
+	b := RatCoins{{"steak", sdk.NewRat(1, 3)}, {"tree", sdk.NewRat(2)}}
+
+	sum := a.Plus(b)
+	require.Equal(t, 3, len(sum))
+	require.True(t, sdk.NewRat(10, 3).Equal(sum.AmountOf("steak")))
+	require.True(t, sum.Minus(b).IsEqual(a))
+
+	// the zero amounts are dropped
+	require.True(t, a.Minus(a).IsZero())
+	require.Equal(t, 0, len(a.Minus(a)))
+	require.False(t, b.Minus(a).IsNotNegative())
+	require.True(t, a.Plus(a).IsEqual(a.MulRat(sdk.NewRat(2))))
+	require.True(t, a.QuoRat(sdk.NewRat(2)).IsEqual(a.MulRat(sdk.NewRat(1, 2))))
+	require.True(t, NewRatCoins(sdk.Coins{{"steak", sdk.NewInt(3)}}).IsEqual(RatCoins{{"steak", sdk.NewRat(3)}}))
+}
+
+func TestRatCoinsTruncate(t *testing.T) {
+	coins := RatCoins{{"atom", sdk.NewRat(1, 2)}, {"steak", sdk.NewRat(7, 2)}}
+	truncated, remainder := coins.TruncateDecimal()
+	require.True(t, sdk.Coins{{"steak", sdk.NewInt(3)}}.IsEqual(truncated))
+	require.True(t, RatCoins{{"atom", sdk.NewRat(1, 2)}, {"steak", sdk.NewRat(1, 2)}}.IsEqual(remainder))
+
+	// the ratios are rounded down to 18 decimals
+	rounded := RatCoins{{"steak", sdk.NewRat(1, 3)}}.truncatePrecision()
+	require.True(t, sdk.NewRat(333333333333333333, 1000000000000000000).Equal(rounded.AmountOf("steak")))
+}
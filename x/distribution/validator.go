@@ -0,0 +1,138 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// ValidatorCurrentRewards are the rewards of the delegations of a validator
+// during its current period
+type ValidatorCurrentRewards struct {
+	Rewards RatCoins `json:"rewards"`
+	Period  uint64   `json:"period"`
+}
+
+// NewValidatorCurrentRewards returns the rewards of the current period
+func NewValidatorCurrentRewards(rewards RatCoins, period uint64) ValidatorCurrentRewards {
+	return ValidatorCurrentRewards{
+		Rewards: rewards,
+		Period:  period,
+	}
+}
+
+// ValidatorHistoricalRewards are the cumulative rewards per share of the
+// delegations of a validator at the end of a period, kept while a
+// delegation starting at the period or the current period refers to them
+type ValidatorHistoricalRewards struct {
+	CumulativeRewardRatio RatCoins `json:"cumulative_reward_ratio"`
+	ReferenceCount        uint16   `json:"reference_count"`
+}
+
+// NewValidatorHistoricalRewards returns the historical rewards of a period
+func NewValidatorHistoricalRewards(cumulativeRewardRatio RatCoins, referenceCount uint16) ValidatorHistoricalRewards {
+	return ValidatorHistoricalRewards{
+		CumulativeRewardRatio: cumulativeRewardRatio,
+		ReferenceCount:        referenceCount,
+	}
+}
+
+// initialize the rewards of a new validator, whose first period is 1
+func (k Keeper) initializeValidator(ctx sdk.Context, valAddr sdk.AccAddress) {
+	k.SetValidatorHistoricalRewards(ctx, valAddr, 0, NewValidatorHistoricalRewards(RatCoins{}, 1))
+	k.SetValidatorCurrentRewards(ctx, valAddr, NewValidatorCurrentRewards(RatCoins{}, 1))
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, RatCoins{})
+}
+
+// end the current period of the validator, recording the cumulative rewards
+// per share of its delegations, and return it
+func (k Keeper) incrementValidatorPeriod(ctx sdk.Context, valAddr sdk.AccAddress) uint64 {
+	rewards := k.mustGetValidatorCurrentRewards(ctx, valAddr)
+
+	// without shares, no delegation can be paid the rewards of the period
+	ratio := RatCoins{}
+	validator, found := k.stakeKeeper.GetValidator(ctx, valAddr)
+	if !found || validator.DelegatorShares.IsZero() {
+		k.addToCommunityPool(ctx, rewards.Rewards)
+	} else {
+		ratio = rewards.Rewards.QuoRat(validator.DelegatorShares).truncatePrecision()
+		k.addToCommunityPool(ctx, rewards.Rewards.Minus(ratio.MulRat(validator.DelegatorShares)))
+	}
+
+	previous := k.mustGetValidatorHistoricalRewards(ctx, valAddr, rewards.Period-1)
+	k.decrementReferenceCount(ctx, valAddr, rewards.Period-1)
+	k.SetValidatorHistoricalRewards(ctx, valAddr, rewards.Period,
+		NewValidatorHistoricalRewards(previous.CumulativeRewardRatio.Plus(ratio), 1))
+	k.SetValidatorCurrentRewards(ctx, valAddr, NewValidatorCurrentRewards(RatCoins{}, rewards.Period+1))
+	return rewards.Period
+}
+
+// add a reference to the historical rewards of the period
+func (k Keeper) incrementReferenceCount(ctx sdk.Context, valAddr sdk.AccAddress, period uint64) {
+	historical := k.mustGetValidatorHistoricalRewards(ctx, valAddr, period)
+	historical.ReferenceCount++
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+}
+
+// remove a reference to the historical rewards of the period, deleting them
+// when they are no longer referenced
+func (k Keeper) decrementReferenceCount(ctx sdk.Context, valAddr sdk.AccAddress, period uint64) {
+	historical := k.mustGetValidatorHistoricalRewards(ctx, valAddr, period)
+	historical.ReferenceCount--
+	if historical.ReferenceCount == 0 {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(GetValidatorHistoricalRewardsKey(valAddr, period))
+		return
+	}
+	k.SetValidatorHistoricalRewards(ctx, valAddr, period, historical)
+}
+
+// allocate the tokens to the validator, its commission to the validator and
+// the rest to its delegations
+func (k Keeper) allocateTokensToValidator(ctx sdk.Context, validator stake.Validator, tokens RatCoins) {
+	commission := tokens.MulRat(validator.Commission)
+	k.SetValidatorAccumulatedCommission(ctx, validator.Owner,
+		k.GetValidatorAccumulatedCommission(ctx, validator.Owner).Plus(commission))
+
+	rewards := k.mustGetValidatorCurrentRewards(ctx, validator.Owner)
+	rewards.Rewards = rewards.Rewards.Plus(tokens.Minus(commission))
+	k.SetValidatorCurrentRewards(ctx, validator.Owner, rewards)
+}
+
+// WithdrawValidatorCommission pays the whole coins of the commission
+// accumulated by the validator to its owner, the fractional remainder is
+// kept
+func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	if _, found := k.stakeKeeper.GetValidator(ctx, valAddr); !found {
+		return nil, ErrNoValidatorFound(k.codespace)
+	}
+
+	commission, remainder := k.GetValidatorAccumulatedCommission(ctx, valAddr).TruncateDecimal()
+	if commission.IsZero() {
+		return nil, ErrNoValidatorCommission(k.codespace)
+	}
+	k.SetValidatorAccumulatedCommission(ctx, valAddr, remainder)
+
+	_, err := k.coinKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, valAddr, commission)
+	if err != nil {
+		return nil, err
+	}
+	return commission, nil
+}
+
+// pay the commission of a removed validator to its owner and move the rest
+// of its rewards, which no delegation can claim, to the community pool
+func (k Keeper) removeValidator(ctx sdk.Context, valAddr sdk.AccAddress) {
+	commission, remainder := k.GetValidatorAccumulatedCommission(ctx, valAddr).TruncateDecimal()
+	if !commission.IsZero() {
+		_, err := k.coinKeeper.SendCoinsFromModuleToAccount(ctx, ModuleName, valAddr, commission)
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.addToCommunityPool(ctx, remainder)
+
+	if rewards, found := k.GetValidatorCurrentRewards(ctx, valAddr); found {
+		k.addToCommunityPool(ctx, rewards.Rewards)
+	}
+	k.deleteValidatorRewards(ctx, valAddr)
+}
@@ -0,0 +1,127 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance is a limit on the fees a grantee may have paid by a granter.
+type FeeAllowance interface {
+	// Accept deducts the fee from the allowance at the given block time, or
+	// returns an error if the fee isn't allowed. It returns remove if the
+	// allowance is used up or expired and should be deleted.
+	Accept(fee sdk.Coins, blockTime int64) (remove bool, err sdk.Error)
+
+	// ValidateBasic checks the allowance is well formed
+	ValidateBasic() sdk.Error
+}
+
+//-----------------------------------------------------------
+// BasicFeeAllowance
+
+var _ FeeAllowance = (*BasicFeeAllowance)(nil)
+
+// BasicFeeAllowance allows fees up to SpendLimit until the Expiration time.
+// A nil SpendLimit is unlimited and a zero Expiration never expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit"`
+	Expiration int64     `json:"expiration"` // unix time
+}
+
+// Implements FeeAllowance
+func (a *BasicFeeAllowance) Accept(fee sdk.Coins, blockTime int64) (bool, sdk.Error) {
+	if a.isExpired(blockTime) {
+		return true, ErrAllowanceExpired(DefaultCodespace)
+	}
+	if a.SpendLimit == nil {
+		return false, nil
+	}
+
+	left := a.SpendLimit.Minus(fee)
+	if !left.IsNotNegative() {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.SpendLimit)
+	}
+	a.SpendLimit = left
+	return left.IsZero(), nil
+}
+
+func (a BasicFeeAllowance) isExpired(blockTime int64) bool {
+	return a.Expiration != 0 && blockTime >= a.Expiration
+}
+
+// Implements FeeAllowance
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if a.SpendLimit != nil && (!a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive()) {
+		return ErrInvalidAllowance(DefaultCodespace, "spend limit must be positive")
+	}
+	if a.Expiration < 0 {
+		return ErrInvalidAllowance(DefaultCodespace, "expiration must not be negative")
+	}
+	return nil
+}
+
+//-----------------------------------------------------------
+// PeriodicFeeAllowance
+
+var _ FeeAllowance = (*PeriodicFeeAllowance)(nil)
+
+// PeriodicFeeAllowance allows fees up to PeriodSpendLimit every Period
+// seconds, within the limits of the Basic allowance. PeriodCanSpend is what is
+// left to spend until PeriodReset.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic"`
+	Period           int64             `json:"period"` // seconds
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit"`
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend"`
+	PeriodReset      int64             `json:"period_reset"` // unix time
+}
+
+// Implements FeeAllowance
+func (a *PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime int64) (bool, sdk.Error) {
+	if a.Basic.isExpired(blockTime) {
+		return true, ErrAllowanceExpired(DefaultCodespace)
+	}
+	a.tryResetPeriod(blockTime)
+
+	periodLeft := a.PeriodCanSpend.Minus(fee)
+	if !periodLeft.IsNotNegative() {
+		return false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.PeriodCanSpend)
+	}
+	if a.Basic.SpendLimit != nil {
+		left := a.Basic.SpendLimit.Minus(fee)
+		if !left.IsNotNegative() {
+			return false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.Basic.SpendLimit)
+		}
+		a.Basic.SpendLimit = left
+	}
+	a.PeriodCanSpend = periodLeft
+	return a.Basic.SpendLimit != nil && a.Basic.SpendLimit.IsZero(), nil
+}
+
+// start a new period if the current one is over. The first period starts
+// with the first fee paid, periods which went by without any fee being paid
+// are skipped.
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime int64) {
+	if blockTime < a.PeriodReset {
+		return
+	}
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	if a.PeriodReset == 0 || blockTime >= a.PeriodReset+a.Period {
+		a.PeriodReset = blockTime + a.Period
+	} else {
+		a.PeriodReset += a.Period
+	}
+}
+
+// Implements FeeAllowance
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+	if a.Period <= 0 {
+		return ErrInvalidAllowance(DefaultCodespace, "period must be positive")
+	}
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsPositive() {
+		return ErrInvalidAllowance(DefaultCodespace, "period spend limit must be positive")
+	}
+	return nil
+}
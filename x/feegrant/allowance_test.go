@@ -0,0 +1,79 @@
+package feegrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBasicFeeAllowance(t *testing.T) {
+	cases := []struct {
+		allowance BasicFeeAllowance
+		fee       sdk.Coins
+		blockTime int64
+		accept    bool
+		remove    bool
+		left      sdk.Coins
+	}{
+		{BasicFeeAllowance{}, sdk.Coins{sdk.NewCoin("atom", 100)}, 10, true, false, nil},
+		{BasicFeeAllowance{sdk.Coins{sdk.NewCoin("atom", 100)}, 0}, sdk.Coins{sdk.NewCoin("atom", 40)}, 10, true, false, sdk.Coins{sdk.NewCoin("atom", 60)}},
+		{BasicFeeAllowance{sdk.Coins{sdk.NewCoin("atom", 100)}, 0}, sdk.Coins{sdk.NewCoin("atom", 100)}, 10, true, true, nil},
+		{BasicFeeAllowance{sdk.Coins{sdk.NewCoin("atom", 100)}, 0}, sdk.Coins{sdk.NewCoin("atom", 101)}, 10, false, false, sdk.Coins{sdk.NewCoin("atom", 100)}},
+		{BasicFeeAllowance{sdk.Coins{sdk.NewCoin("atom", 100)}, 0}, sdk.Coins{sdk.NewCoin("btc", 1)}, 10, false, false, sdk.Coins{sdk.NewCoin("atom", 100)}},
+		{BasicFeeAllowance{nil, 20}, sdk.Coins{sdk.NewCoin("atom", 1)}, 19, true, false, nil},
+		{BasicFeeAllowance{nil, 20}, sdk.Coins{sdk.NewCoin("atom", 1)}, 20, false, true, nil},
+	}
+
+	for i, tc := range cases {
+		allowance := tc.allowance
+		remove, err := allowance.Accept(tc.fee, tc.blockTime)
+		require.Equal(t, tc.accept, err == nil, "case %d: %v", i, err)
+		require.Equal(t, tc.remove, remove, "case %d", i)
+		require.True(t, allowance.SpendLimit.IsEqual(tc.left), "case %d: %v", i, allowance.SpendLimit)
+	}
+}
+
+func TestPeriodicFeeAllowance(t *testing.T) {
+	allowance := &PeriodicFeeAllowance{
+		Basic:            BasicFeeAllowance{sdk.Coins{sdk.NewCoin("atom", 250)}, 1000},
+		Period:           100,
+		PeriodSpendLimit: sdk.Coins{sdk.NewCoin("atom", 100)},
+	}
+	require.Nil(t, allowance.ValidateBasic())
+
+	// the first period starts with the first fee
+	remove, err := allowance.Accept(sdk.Coins{sdk.NewCoin("atom", 60)}, 10)
+	require.Nil(t, err)
+	require.False(t, remove)
+	require.Equal(t, int64(110), allowance.PeriodReset)
+
+	// can't spend more than the period limit
+	_, err = allowance.Accept(sdk.Coins{sdk.NewCoin("atom", 50)}, 50)
+	require.NotNil(t, err)
+
+	// the limit is reset every period
+	_, err = allowance.Accept(sdk.Coins{sdk.NewCoin("atom", 90)}, 110)
+	require.Nil(t, err)
+	require.Equal(t, int64(210), allowance.PeriodReset)
+
+	// skipped periods don't add up
+	_, err = allowance.Accept(sdk.Coins{sdk.NewCoin("atom", 101)}, 500)
+	require.NotNil(t, err)
+	require.Equal(t, int64(600), allowance.PeriodReset)
+
+	// the allowance is removed once the total limit is used up
+	remove, err = allowance.Accept(sdk.Coins{sdk.NewCoin("atom", 100)}, 500)
+	require.Nil(t, err)
+	require.True(t, remove)
+
+	// or when it expires
+	allowance.Basic.SpendLimit = nil
+	remove, err = allowance.Accept(sdk.Coins{sdk.NewCoin("atom", 1)}, 1000)
+	require.NotNil(t, err)
+	require.True(t, remove)
+
+	invalid := PeriodicFeeAllowance{Period: 0, PeriodSpendLimit: sdk.Coins{sdk.NewCoin("atom", 1)}}
+	require.NotNil(t, invalid.ValidateBasic())
+}
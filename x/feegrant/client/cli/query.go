@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GetCmdQueryFeeGrant returns the command to query a fee grant
+func GetCmdQueryFeeGrant(storeName string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-grant [granter] [grantee]",
+		Short: "Query the fee allowance granted by an account to another",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			ctx := context.NewCoreContextFromViper()
+			res, err := ctx.QueryStore(feegrant.GetFeeGrantKey(granter, grantee), storeName)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.Errorf("%s has no fee allowance from %s", grantee, granter)
+			}
+
+			var grant feegrant.FeeGrant
+			cdc.MustUnmarshalBinary(res, &grant)
+			output, err := wire.MarshalJSONIndent(cdc, grant)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(output))
+			return nil
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GetCmdGrantFeeAllowance returns the command to grant a fee allowance
+func GetCmdGrantFeeAllowance(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "Grant an account an allowance to pay fees from your account",
+		Long: `Grant an account an allowance to pay fees from your account, limited
+to --spend-limit in total and to --period-limit every --period seconds if
+set, until the --expiration unix time if set. The grantee uses the allowance by
+setting --fee-granter to your address.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
+			granter, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			allowance, err := buildAllowance(ctx)
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgGrantFeeAllowance(granter, grantee, allowance)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	cmd.Flags().String(FlagSpendLimit, "", "Total fees the grantee may spend, unlimited if not set")
+	cmd.Flags().Int64(FlagExpiration, 0, "Unix time the allowance expires at, never if not set")
+	cmd.Flags().Int64(FlagPeriod, 0, "Length in seconds of the periods of a periodic allowance")
+	cmd.Flags().String(FlagPeriodLimit, "", "Fees the grantee may spend every period of a periodic allowance")
+	return cmd
+}
+
+// build the allowance from the flags
+func buildAllowance(ctx context.CoreContext) (feegrant.FeeAllowance, error) {
+	basic := feegrant.BasicFeeAllowance{
+		Expiration: viper.GetInt64(FlagExpiration),
+	}
+	if limit := viper.GetString(FlagSpendLimit); limit != "" {
+		spendLimit, err := ctx.ParseCoins(limit)
+		if err != nil {
+			return nil, err
+		}
+		basic.SpendLimit = spendLimit
+	}
+
+	period := viper.GetInt64(FlagPeriod)
+	if period == 0 {
+		return &basic, nil
+	}
+	periodLimit, err := ctx.ParseCoins(viper.GetString(FlagPeriodLimit))
+	if err != nil {
+		return nil, err
+	}
+	return &feegrant.PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodLimit,
+	}, nil
+}
+
+// GetCmdRevokeFeeAllowance returns the command to revoke a fee allowance
+func GetCmdRevokeFeeAllowance(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "Revoke the fee allowance granted to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granter, err := ctx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeFeeAllowance(granter, grantee)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
+		},
+	}
+	return cmd
+}
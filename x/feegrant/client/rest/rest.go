@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// REST Variable names
+// nolint
+const (
+	RestGranter = "granter"
+	RestGrantee = "grantee"
+	storeName   = "feegrant"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(ctx context.CoreContext, r *mux.Router, cdc *wire.Codec) {
+	r.HandleFunc("/feegrant/grants", grantHandlerFn(cdc, ctx)).Methods("POST")
+	r.HandleFunc("/feegrant/revocations", revokeHandlerFn(cdc, ctx)).Methods("POST")
+
+	r.HandleFunc(fmt.Sprintf("/feegrant/grants/{%s}/{%s}", RestGranter, RestGrantee), queryFeeGrantHandlerFn(cdc, ctx)).Methods("GET")
+}
+
+type grantReq struct {
+	BaseReq   baseReq               `json:"base_req"`
+	Granter   sdk.AccAddress        `json:"granter"`   // Address of the account paying the fees
+	Grantee   sdk.AccAddress        `json:"grantee"`   // Address of the account allowed to have its fees paid
+	Allowance feegrant.FeeAllowance `json:"allowance"` // Limits of the fees paid
+}
+
+type revokeReq struct {
+	BaseReq baseReq        `json:"base_req"`
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func grantHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req grantReq
+		err := buildReq(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		if !req.BaseReq.baseReqValidate(w) {
+			return
+		}
+
+		// create the message
+		msg := feegrant.NewMsgGrantFeeAllowance(req.Granter, req.Grantee, req.Allowance)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErr(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// sign
+		signAndBuild(w, ctx, req.BaseReq, msg, cdc)
+	}
+}
+
+func revokeHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeReq
+		err := buildReq(w, r, cdc, &req)
+		if err != nil {
+			return
+		}
+
+		if !req.BaseReq.baseReqValidate(w) {
+			return
+		}
+
+		// create the message
+		msg := feegrant.NewMsgRevokeFeeAllowance(req.Granter, req.Grantee)
+		err = msg.ValidateBasic()
+		if err != nil {
+			writeErr(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// sign
+		signAndBuild(w, ctx, req.BaseReq, msg, cdc)
+	}
+}
+
+func queryFeeGrantHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		granter, err := sdk.AccAddressFromBech32(vars[RestGranter])
+		if err != nil {
+			writeErr(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+		grantee, err := sdk.AccAddressFromBech32(vars[RestGrantee])
+		if err != nil {
+			writeErr(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := ctx.QueryStore(feegrant.GetFeeGrantKey(granter, grantee), storeName)
+		if err != nil {
+			writeErr(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			writeErr(&w, http.StatusNotFound, fmt.Sprintf("%s has no fee allowance from %s", grantee, granter))
+			return
+		}
+
+		var grant feegrant.FeeGrant
+		err = cdc.UnmarshalBinary(res, &grant)
+		if err != nil {
+			writeErr(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		output, err := wire.MarshalJSONIndent(cdc, grant)
+		if err != nil {
+			writeErr(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		w.Write(output)
+	}
+}
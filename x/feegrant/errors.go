@@ -0,0 +1,38 @@
+//nolint
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+const (
+	// Default feegrant codespace
+	DefaultCodespace sdk.CodespaceType = 11
+
+	CodeInvalidAllowance CodeType = 101
+	CodeNoAllowance      CodeType = 102
+	CodeFeeLimitExceeded CodeType = 103
+	CodeAllowanceExpired CodeType = 104
+	CodeInvalidGrant     CodeType = 105
+)
+
+func ErrInvalidAllowance(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAllowance, msg)
+}
+func ErrNoAllowance(codespace sdk.CodespaceType, granter, grantee sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, fmt.Sprintf("%s has no fee allowance from %s", grantee, granter))
+}
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType, fee, limit sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, fmt.Sprintf("fee %s exceeds the allowance %s", fee, limit))
+}
+func ErrAllowanceExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAllowanceExpired, "fee allowance expired")
+}
+func ErrInvalidGrant(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidGrant, msg)
+}
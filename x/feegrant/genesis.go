@@ -0,0 +1,48 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the fee grants at genesis
+type GenesisState struct {
+	FeeGrants []FeeGrant `json:"fee_grants"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis checks the fee grants as the msgs granting them
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.FeeGrants {
+		if err := NewMsgGrantFeeAllowance(grant.Granter, grant.Grantee, grant.Allowance).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis - store the genesis fee grants
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	for _, grant := range data.FeeGrants {
+		k.GrantFeeAllowance(ctx, grant)
+	}
+	return nil
+}
+
+// WriteGenesis - output the fee grants
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var grants []FeeGrant
+	k.IterateFeeGrants(ctx, func(grant FeeGrant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return GenesisState{
+		FeeGrants: grants,
+	}
+}
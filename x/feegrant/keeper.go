@@ -0,0 +1,112 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// nolint
+var (
+	FeeGrantKeyPrefix = []byte{0x00} // prefix for each key to a fee grant
+)
+
+// GetFeeGrantKey returns the key of the fee grant from granter to grantee
+func GetFeeGrantKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeGrantsKey(granter), grantee.Bytes()...)
+}
+
+// GetFeeGrantsKey returns the prefix of the keys of all the fee grants of granter
+func GetFeeGrantsKey(granter sdk.AccAddress) []byte {
+	return append(FeeGrantKeyPrefix, granter.Bytes()...)
+}
+
+// FeeGrant is a fee allowance given by a granter to a grantee
+type FeeGrant struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+var _ auth.FeeGrantKeeper = Keeper{}
+
+// Keeper of the fee grant store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates a fee grant keeper
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+}
+
+// GrantFeeAllowance sets the fee allowance of grantee from granter,
+// overriding any previous allowance
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant FeeGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinary(grant)
+	store.Set(GetFeeGrantKey(grant.Granter, grant.Grantee), bz)
+}
+
+// RevokeFeeAllowance removes the fee allowance of grantee from granter
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := GetFeeGrantKey(granter, grantee)
+	if !store.Has(key) {
+		return ErrNoAllowance(k.codespace, granter, grantee)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// GetFeeGrant returns the fee grant from granter to grantee
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant FeeGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetFeeGrantKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinary(bz, &grant)
+	return grant, true
+}
+
+// IterateFeeGrants iterates over all the fee grants, stopping when fn
+// returns true
+func (k Keeper) IterateFeeGrants(ctx sdk.Context, fn func(grant FeeGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, FeeGrantKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant FeeGrant
+		k.cdc.MustUnmarshalBinary(iter.Value(), &grant)
+		if fn(grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees deducts the fee from the allowance of grantee from granter.
+// Allowances which are used up or expired are removed.
+// Implements auth.FeeGrantKeeper.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return ErrNoAllowance(k.codespace, granter, grantee)
+	}
+
+	remove, err := grant.Allowance.Accept(fee, ctx.BlockHeader().Time)
+	if remove {
+		ctx.KVStore(k.storeKey).Delete(GetFeeGrantKey(granter, grantee))
+	} else if err == nil {
+		k.GrantFeeAllowance(ctx, grant)
+	}
+	return err
+}
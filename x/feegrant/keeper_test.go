@@ -0,0 +1,90 @@
+package feegrant
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+func setupKeeper() (sdk.Context, Keeper) {
+	db := dbm.NewMemDB()
+	key := sdk.NewKVStoreKey("feegrant")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+
+	cdc := wire.NewCodec()
+	RegisterWire(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: 100}, false, log.NewNopLogger())
+	return ctx, NewKeeper(cdc, key, DefaultCodespace)
+}
+
+func TestKeeperFeeGrants(t *testing.T) {
+	ctx, keeper := setupKeeper()
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+	grantee2 := sdk.AccAddress([]byte("grantee2"))
+
+	// no allowance
+	err := keeper.UseGrantedFees(ctx, granter, grantee, sdk.Coins{sdk.NewCoin("atom", 1)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeNoAllowance, err.Code())
+
+	keeper.GrantFeeAllowance(ctx, FeeGrant{granter, grantee, &BasicFeeAllowance{SpendLimit: sdk.Coins{sdk.NewCoin("atom", 100)}}})
+	keeper.GrantFeeAllowance(ctx, FeeGrant{granter, grantee2, &BasicFeeAllowance{Expiration: 100}})
+
+	// the allowance is decremented
+	err = keeper.UseGrantedFees(ctx, granter, grantee, sdk.Coins{sdk.NewCoin("atom", 40)})
+	require.Nil(t, err)
+	grant, found := keeper.GetFeeGrant(ctx, granter, grantee)
+	require.True(t, found)
+	require.True(t, grant.Allowance.(*BasicFeeAllowance).SpendLimit.IsEqual(sdk.Coins{sdk.NewCoin("atom", 60)}))
+
+	// exceeding the allowance leaves it untouched
+	err = keeper.UseGrantedFees(ctx, granter, grantee, sdk.Coins{sdk.NewCoin("atom", 61)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeFeeLimitExceeded, err.Code())
+	grant, _ = keeper.GetFeeGrant(ctx, granter, grantee)
+	require.True(t, grant.Allowance.(*BasicFeeAllowance).SpendLimit.IsEqual(sdk.Coins{sdk.NewCoin("atom", 60)}))
+
+	// used up and expired allowances are removed
+	err = keeper.UseGrantedFees(ctx, granter, grantee, sdk.Coins{sdk.NewCoin("atom", 60)})
+	require.Nil(t, err)
+	_, found = keeper.GetFeeGrant(ctx, granter, grantee)
+	require.False(t, found)
+
+	err = keeper.UseGrantedFees(ctx, granter, grantee2, sdk.Coins{sdk.NewCoin("atom", 1)})
+	require.NotNil(t, err)
+	require.Equal(t, CodeAllowanceExpired, err.Code())
+	_, found = keeper.GetFeeGrant(ctx, granter, grantee2)
+	require.False(t, found)
+
+	// revoke
+	keeper.GrantFeeAllowance(ctx, FeeGrant{granter, grantee, &BasicFeeAllowance{}})
+	require.Nil(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+	require.NotNil(t, keeper.RevokeFeeAllowance(ctx, granter, grantee))
+}
+
+func TestFeeGrantGenesis(t *testing.T) {
+	ctx, keeper := setupKeeper()
+	granter := sdk.AccAddress([]byte("granter"))
+	grantee := sdk.AccAddress([]byte("grantee"))
+
+	grants := []FeeGrant{
+		{granter, grantee, &PeriodicFeeAllowance{Period: 10, PeriodSpendLimit: sdk.Coins{sdk.NewCoin("atom", 5)}}},
+	}
+	require.Nil(t, InitGenesis(ctx, keeper, GenesisState{grants}))
+	require.Equal(t, grants, WriteGenesis(ctx, keeper).FeeGrants)
+
+	// self grants are invalid
+	require.NotNil(t, InitGenesis(ctx, keeper, GenesisState{[]FeeGrant{{granter, granter, &BasicFeeAllowance{}}}}))
+}
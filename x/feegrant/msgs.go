@@ -0,0 +1,100 @@
+package feegrant
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "feegrant"
+
+// verify interface at compile time
+var _, _ sdk.Msg = MsgGrantFeeAllowance{}, MsgRevokeFeeAllowance{}
+
+//______________________________________________________________________
+
+// MsgGrantFeeAllowance - grants the grantee an allowance to have its fees
+// paid by the granter
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+//nolint
+func (msg MsgGrantFeeAllowance) Type() string                 { return MsgType }
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Granter} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	if len(msg.Granter) == 0 {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if len(msg.Grantee) == 0 {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	if bytes.Equal(msg.Granter, msg.Grantee) {
+		return ErrInvalidGrant(DefaultCodespace, "cannot grant a fee allowance to self")
+	}
+	if msg.Allowance == nil {
+		return ErrInvalidAllowance(DefaultCodespace, "missing allowance")
+	}
+	return msg.Allowance.ValidateBasic()
+}
+
+//______________________________________________________________________
+
+// MsgRevokeFeeAllowance - revokes the allowance of the grantee
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+//nolint
+func (msg MsgRevokeFeeAllowance) Type() string                 { return MsgType }
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Granter} }
+
+// get the bytes for the message signer to sign on
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if len(msg.Granter) == 0 {
+		return sdk.ErrInvalidAddress("missing granter address")
+	}
+	if len(msg.Grantee) == 0 {
+		return sdk.ErrInvalidAddress("missing grantee address")
+	}
+	return nil
+}
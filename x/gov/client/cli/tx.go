@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -11,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/pkg/errors"
 )
 
@@ -24,6 +26,9 @@ const (
 	flagDepositer    = "depositer"
 	flagVoter        = "voter"
 	flagOption       = "option"
+	flagParamChange  = "param-change"
+	flagRecipient    = "recipient"
+	flagAmount       = "amount"
 )
 
 // submit a proposal tx
@@ -37,13 +42,18 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 			strProposalType := viper.GetString(flagProposalType)
 			initialDeposit := viper.GetString(flagDeposit)
 
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
 			// get the from address from the name flag
 			from, err := sdk.AccAddressFromBech32(viper.GetString(flagProposer))
 			if err != nil {
 				return err
 			}
 
-			amount, err := sdk.ParseCoins(initialDeposit)
+			amount, err := ctx.ParseCoins(initialDeposit)
 			if err != nil {
 				return err
 			}
@@ -53,8 +63,32 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 				return err
 			}
 
+			strChanges, err := cmd.Flags().GetStringArray(flagParamChange)
+			if err != nil {
+				return err
+			}
+			var changes []params.Change
+			for _, strChange := range strChanges {
+				kv := strings.SplitN(strChange, "=", 2)
+				if len(kv) != 2 {
+					return fmt.Errorf("param change %s isn't of the form key=value", strChange)
+				}
+				changes = append(changes, params.NewChange(kv[0], kv[1]))
+			}
+
 			// create the message
 			msg := gov.NewMsgSubmitProposal(title, description, proposalType, from, amount)
+			msg.ParamChanges = changes
+			if proposalType == gov.ProposalTypeCommunityPoolSpend {
+				msg.SpendRecipient, err = sdk.AccAddressFromBech32(viper.GetString(flagRecipient))
+				if err != nil {
+					return err
+				}
+				msg.SpendAmount, err = ctx.ParseCoins(viper.GetString(flagAmount))
+				if err != nil {
+					return err
+				}
+			}
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -62,7 +96,6 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
 			// proposalID must be returned, and it is a part of response
 			ctx.PrintResponse = true
 
@@ -79,6 +112,9 @@ func GetCmdSubmitProposal(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
 	cmd.Flags().String(flagProposer, "", "proposer of proposal")
+	cmd.Flags().StringArray(flagParamChange, nil, "param change key=value of a ParameterChange proposal, e.g. bank/SendEnabled=true")
+	cmd.Flags().String(flagRecipient, "", "bech32 recipient paid from the community pool by a CommunityPoolSpend proposal")
+	cmd.Flags().String(flagAmount, "", "amount paid from the community pool by a CommunityPoolSpend proposal")
 
 	return cmd
 }
@@ -89,6 +125,11 @@ func GetCmdDeposit(cdc *wire.Codec) *cobra.Command {
 		Use:   "deposit",
 		Short: "deposit tokens for activing proposal",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
 			// get the from address from the name flag
 			depositer, err := sdk.AccAddressFromBech32(viper.GetString(flagDepositer))
 			if err != nil {
@@ -97,7 +138,7 @@ func GetCmdDeposit(cdc *wire.Codec) *cobra.Command {
 
 			proposalID := viper.GetInt64(flagProposalID)
 
-			amount, err := sdk.ParseCoins(viper.GetString(flagDeposit))
+			amount, err := ctx.ParseCoins(viper.GetString(flagDeposit))
 			if err != nil {
 				return err
 			}
@@ -111,8 +152,6 @@ func GetCmdDeposit(cdc *wire.Codec) *cobra.Command {
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
-
 			err = ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
 			if err != nil {
 				return err
@@ -9,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/gorilla/mux"
 	"github.com/pkg/errors"
 )
@@ -43,6 +44,9 @@ type postProposalReq struct {
 	ProposalType   gov.ProposalKind `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 	Proposer       sdk.AccAddress   `json:"proposer"`        //  Address of the proposer
 	InitialDeposit sdk.Coins        `json:"initial_deposit"` // Coins to add to the proposal's deposit
+	ParamChanges   []params.Change  `json:"param_changes"`   // Param changes of a ParameterChange proposal
+	SpendRecipient sdk.AccAddress   `json:"spend_recipient"` // Recipient paid from the community pool by a CommunityPoolSpend proposal
+	SpendAmount    sdk.Coins        `json:"spend_amount"`    // Amount paid from the community pool by a CommunityPoolSpend proposal
 }
 
 type depositReq struct {
@@ -71,6 +75,9 @@ func postProposalHandlerFn(cdc *wire.Codec, ctx context.CoreContext) http.Handle
 
 		// create the message
 		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, req.ProposalType, req.Proposer, req.InitialDeposit)
+		msg.ParamChanges = req.ParamChanges
+		msg.SpendRecipient = req.SpendRecipient
+		msg.SpendAmount = req.SpendAmount
 		err = msg.ValidateBasic()
 		if err != nil {
 			writeErr(&w, http.StatusBadRequest, err.Error())
@@ -16,7 +16,7 @@ type baseReq struct {
 	ChainID       string `json:"chain_id"`
 	AccountNumber int64  `json:"account_number"`
 	Sequence      int64  `json:"sequence"`
-	Gas           int64  `json:"gas"`
+	Gas           string `json:"gas"`
 }
 
 func buildReq(w http.ResponseWriter, r *http.Request, cdc *wire.Codec, req interface{}) error {
@@ -74,7 +74,11 @@ func signAndBuild(w http.ResponseWriter, ctx context.CoreContext, baseReq baseRe
 	ctx = ctx.WithChainID(baseReq.ChainID)
 
 	// add gas to context
-	ctx = ctx.WithGas(baseReq.Gas)
+	ctx, err := ctx.WithGasSetting(baseReq.Gas)
+	if err != nil {
+		writeErr(&w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	txBytes, err := ctx.SignAndBuild(baseReq.Name, baseReq.Password, []sdk.Msg{msg}, cdc)
 	if err != nil {
@@ -10,17 +10,19 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 5
 
-	CodeUnknownProposal         sdk.CodeType = 1
-	CodeInactiveProposal        sdk.CodeType = 2
-	CodeAlreadyActiveProposal   sdk.CodeType = 3
-	CodeAlreadyFinishedProposal sdk.CodeType = 4
-	CodeAddressNotStaked        sdk.CodeType = 5
-	CodeInvalidTitle            sdk.CodeType = 6
-	CodeInvalidDescription      sdk.CodeType = 7
-	CodeInvalidProposalType     sdk.CodeType = 8
-	CodeInvalidVote             sdk.CodeType = 9
-	CodeInvalidGenesis          sdk.CodeType = 10
-	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeUnknownProposal           sdk.CodeType = 1
+	CodeInactiveProposal          sdk.CodeType = 2
+	CodeAlreadyActiveProposal     sdk.CodeType = 3
+	CodeAlreadyFinishedProposal   sdk.CodeType = 4
+	CodeAddressNotStaked          sdk.CodeType = 5
+	CodeInvalidTitle              sdk.CodeType = 6
+	CodeInvalidDescription        sdk.CodeType = 7
+	CodeInvalidProposalType       sdk.CodeType = 8
+	CodeInvalidVote               sdk.CodeType = 9
+	CodeInvalidGenesis            sdk.CodeType = 10
+	CodeInvalidProposalStatus     sdk.CodeType = 11
+	CodeInvalidParamChanges       sdk.CodeType = 12
+	CodeInvalidCommunityPoolSpend sdk.CodeType = 13
 )
 
 //----------------------------------------
@@ -65,3 +67,11 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
+
+func ErrInvalidParamChanges(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChanges, fmt.Sprintf("Invalid param changes: %s", msg))
+}
+
+func ErrInvalidCommunityPoolSpend(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCommunityPoolSpend, fmt.Sprintf("Invalid community pool spend: %s", msg))
+}
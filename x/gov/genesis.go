@@ -1,12 +1,19 @@
 package gov
 
 import (
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// GenesisState - all staking state that must be provided at genesis
+// GenesisState - the next proposal ID and the proposals, with their deposits
+// and votes, at genesis
 type GenesisState struct {
-	StartingProposalID int64 `json:"starting_proposalID"`
+	StartingProposalID int64      `json:"starting_proposalID"`
+	Proposals          []Proposal `json:"proposals"`
+	Deposits           []Deposit  `json:"deposits"`
+	Votes              []Vote     `json:"votes"`
 }
 
 func NewGenesisState(startingProposalID int64) GenesisState {
@@ -22,20 +29,132 @@ func DefaultGenesisState() GenesisState {
 	}
 }
 
-// InitGenesis - store genesis parameters
-func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) {
+// ValidateGenesis checks the proposals are valid and below the starting
+// proposal ID, and that the deposits and votes are on existing proposals
+func ValidateGenesis(data GenesisState) error {
+	if data.StartingProposalID < 1 {
+		return fmt.Errorf("invalid starting proposal ID %d", data.StartingProposalID)
+	}
+	statuses := make(map[int64]ProposalStatus, len(data.Proposals))
+	for _, proposal := range data.Proposals {
+		id := proposal.GetProposalID()
+		if id < 1 || id >= data.StartingProposalID {
+			return fmt.Errorf("proposal ID %d isn't below the starting proposal ID %d", id, data.StartingProposalID)
+		}
+		if _, found := statuses[id]; found {
+			return fmt.Errorf("duplicate proposal %d", id)
+		}
+		if !validProposalType(proposal.GetProposalType()) {
+			return fmt.Errorf("invalid type of proposal %d", id)
+		}
+		if !validProposalStatus(proposal.GetStatus()) {
+			return fmt.Errorf("invalid status of proposal %d", id)
+		}
+		if !proposal.GetTotalDeposit().IsValid() {
+			return fmt.Errorf("invalid total deposit of proposal %d: %s", id, proposal.GetTotalDeposit())
+		}
+		statuses[id] = proposal.GetStatus()
+	}
+	for _, deposit := range data.Deposits {
+		if _, found := statuses[deposit.ProposalID]; !found {
+			return fmt.Errorf("deposit of %s on unknown proposal %d", deposit.Depositer, deposit.ProposalID)
+		}
+		if !deposit.Amount.IsValid() || !deposit.Amount.IsPositive() {
+			return fmt.Errorf("invalid deposit of %s on proposal %d: %s", deposit.Depositer, deposit.ProposalID, deposit.Amount)
+		}
+	}
+	for _, vote := range data.Votes {
+		if _, found := statuses[vote.ProposalID]; !found {
+			return fmt.Errorf("vote of %s on unknown proposal %d", vote.Voter, vote.ProposalID)
+		}
+		if !validVoteOption(vote.Option) {
+			return fmt.Errorf("invalid vote of %s on proposal %d: %s", vote.Voter, vote.ProposalID, vote.Option)
+		}
+	}
+	return nil
+}
+
+// InitGenesis - store the starting proposal ID, the proposals, deposits and
+// votes, and queue the proposals in their deposit or voting period
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
 	err := k.setInitialProposalID(ctx, data.StartingProposalID)
 	if err != nil {
-		// TODO: Handle this with #870
-		panic(err)
+		return err
+	}
+
+	proposals := make([]Proposal, len(data.Proposals))
+	copy(proposals, data.Proposals)
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].GetProposalID() < proposals[j].GetProposalID()
+	})
+	for _, proposal := range proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.GetStatus() == StatusDepositPeriod {
+			k.InactiveProposalQueuePush(ctx, proposal)
+		}
+	}
+
+	// the active queue is ordered by the start of the voting periods
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].GetVotingStartBlock() < proposals[j].GetVotingStartBlock()
+	})
+	for _, proposal := range proposals {
+		if proposal.GetStatus() == StatusVotingPeriod {
+			k.ActiveProposalQueuePush(ctx, proposal)
+		}
+	}
+
+	for _, deposit := range data.Deposits {
+		k.setDeposit(ctx, deposit.ProposalID, deposit.Depositer, deposit)
 	}
+	for _, vote := range data.Votes {
+		k.setVote(ctx, vote.ProposalID, vote.Voter, vote)
+	}
+	return nil
 }
 
-// WriteGenesis - output genesis parameters
+// WriteGenesis - output the next proposal ID and the proposals, deposits and
+// votes
 func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	initalProposalID, _ := k.getNewProposalID(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	var startingProposalID int64
+	k.cdc.MustUnmarshalBinary(store.Get(KeyNextProposalID), &startingProposalID)
+
+	var proposals []Proposal
+	iter := sdk.KVStorePrefixIterator(store, []byte("proposals:"))
+	for ; iter.Valid(); iter.Next() {
+		var proposal Proposal
+		k.cdc.MustUnmarshalBinary(iter.Value(), &proposal)
+		proposals = append(proposals, proposal)
+	}
+	iter.Close()
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].GetProposalID() < proposals[j].GetProposalID()
+	})
+
+	var deposits []Deposit
+	k.IterateAllDeposits(ctx, func(deposit Deposit) bool {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	var votes []Vote
+	iter = sdk.KVStorePrefixIterator(store, []byte("votes:"))
+	for ; iter.Valid(); iter.Next() {
+		var vote Vote
+		k.cdc.MustUnmarshalBinary(iter.Value(), &vote)
+		votes = append(votes, vote)
+	}
+	iter.Close()
 
 	return GenesisState{
-		initalProposalID,
+		StartingProposalID: startingProposalID,
+		Proposals:          proposals,
+		Deposits:           deposits,
+		Votes:              votes,
 	}
 }
@@ -0,0 +1,90 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 2)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// a proposal in its deposit period and one in its voting period
+	depositing := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	err, _ := keeper.AddDeposit(ctx, depositing.GetProposalID(), addrs[0], sdk.Coins{sdk.NewCoin("steak", 5)})
+	require.Nil(t, err)
+	voting := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	err, votingStarted := keeper.AddDeposit(ctx, voting.GetProposalID(), addrs[1], sdk.Coins{sdk.NewCoin("steak", 10)})
+	require.Nil(t, err)
+	require.True(t, votingStarted)
+	require.Nil(t, keeper.AddVote(ctx, voting.GetProposalID(), addrs[0], OptionYes))
+
+	// exporting doesn't consume a proposal ID
+	genesis := WriteGenesis(ctx, keeper)
+	require.Equal(t, genesis, WriteGenesis(ctx, keeper))
+	require.Equal(t, voting.GetProposalID()+1, genesis.StartingProposalID)
+	require.Len(t, genesis.Proposals, 2)
+	require.Len(t, genesis.Deposits, 2)
+	require.Len(t, genesis.Votes, 1)
+	require.Nil(t, ValidateGenesis(genesis))
+
+	// the state and the queues are restored on a new chain
+	mapp2, keeper2, _, _, _, _ := getMockApp(t, 0)
+	mapp2.BeginBlock(abci.RequestBeginBlock{})
+	ctx2 := mapp2.BaseApp.NewContext(false, abci.Header{})
+	ctx2.KVStore(keeper2.storeKey).Delete(KeyNextProposalID)
+	require.Nil(t, InitGenesis(ctx2, keeper2, genesis))
+	require.Equal(t, genesis, WriteGenesis(ctx2, keeper2))
+	require.Equal(t, depositing.GetProposalID(), keeper2.InactiveProposalQueuePeek(ctx2).GetProposalID())
+	require.Equal(t, voting.GetProposalID(), keeper2.ActiveProposalQueuePeek(ctx2).GetProposalID())
+
+	// the starting proposal ID can only be set once
+	require.NotNil(t, InitGenesis(ctx2, keeper2, genesis))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr"))
+	proposal := &TextProposal{
+		ProposalID:   1,
+		ProposalType: ProposalTypeText,
+		Status:       StatusVotingPeriod,
+		TotalDeposit: sdk.Coins{sdk.NewCoin("steak", 10)},
+	}
+	valid := GenesisState{
+		StartingProposalID: 2,
+		Proposals:          []Proposal{proposal},
+		Deposits:           []Deposit{{addr, 1, sdk.Coins{sdk.NewCoin("steak", 10)}}},
+		Votes:              []Vote{{addr, 1, OptionYes}},
+	}
+	require.Nil(t, ValidateGenesis(valid))
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+
+	// the proposals must be below the starting proposal ID
+	invalid := valid
+	invalid.StartingProposalID = 1
+	require.NotNil(t, ValidateGenesis(invalid))
+
+	// the proposals can't be duplicated
+	invalid = valid
+	invalid.Proposals = []Proposal{proposal, proposal}
+	require.NotNil(t, ValidateGenesis(invalid))
+
+	// the deposits and votes must be on known proposals
+	invalid = valid
+	invalid.Deposits = []Deposit{{addr, 2, sdk.Coins{sdk.NewCoin("steak", 10)}}}
+	require.NotNil(t, ValidateGenesis(invalid))
+	invalid = valid
+	invalid.Votes = []Vote{{addr, 2, OptionYes}}
+	require.NotNil(t, ValidateGenesis(invalid))
+
+	// the votes must have a valid option
+	invalid = valid
+	invalid.Votes = []Vote{{addr, 1, OptionEmpty}}
+	require.NotNil(t, ValidateGenesis(invalid))
+}
@@ -1,6 +1,8 @@
 package gov
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -23,7 +25,22 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
 
-	proposal := keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	var proposal Proposal
+	if len(msg.ParamChanges) > 0 {
+		var err sdk.Error
+		proposal, err = keeper.NewParameterChangeProposal(ctx, msg.Title, msg.Description, msg.ParamChanges)
+		if err != nil {
+			return err.Result()
+		}
+	} else if msg.ProposalType == ProposalTypeCommunityPoolSpend {
+		var err sdk.Error
+		proposal, err = keeper.NewCommunityPoolSpendProposal(ctx, msg.Title, msg.Description, msg.SpendRecipient, msg.SpendAmount)
+		if err != nil {
+			return err.Result()
+		}
+	} else {
+		proposal = keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	}
 
 	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), msg.Proposer, msg.InitialDeposit)
 	if err != nil {
@@ -122,6 +139,20 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (tags sdk.Tags, nonVotingVals []
 				activeProposal.SetStatus(StatusPassed)
 				tags.AppendTag("action", []byte("proposalPassed"))
 				tags.AppendTag("proposalId", proposalIDBytes)
+
+				// the changes may have become invalid during the voting period
+				err := keeper.applyParamChanges(ctx, activeProposal)
+				if err != nil {
+					ctx.Logger().With("module", "x/gov").Error(fmt.Sprintf("failed to apply the param changes of proposal %d: %s", activeProposal.GetProposalID(), err))
+					tags = tags.AppendTag("paramChangesFailed", proposalIDBytes)
+				}
+
+				// the community pool may no longer hold the amount
+				err = keeper.applyCommunityPoolSpend(ctx, activeProposal)
+				if err != nil {
+					ctx.Logger().With("module", "x/gov").Error(fmt.Sprintf("failed to pay the community pool spend of proposal %d: %s", activeProposal.GetProposalID(), err))
+					tags = tags.AppendTag("communityPoolSpendFailed", proposalIDBytes)
+				}
 			} else {
 				keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
 				activeProposal.SetStatus(StatusRejected)
@@ -4,8 +4,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
+// ModuleName is the name of the module account of gov, which escrows the
+// deposits and burns them when they aren't refunded
+const ModuleName = "gov"
+
+// CommunityPool pays the community pool spends of the passed proposals
+type CommunityPool interface {
+	DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) sdk.Error
+}
+
 // Governance Keeper
 type Keeper struct {
 	// The reference to the CoinKeeper to modify balances
@@ -17,6 +27,12 @@ type Keeper struct {
 	// The reference to the DelegationSet to get information about delegators
 	ds sdk.DelegationSet
 
+	// Routes the param changes of the passed proposals, if set
+	paramChanges *params.ChangeRouter
+
+	// Pays the community pool spends of the passed proposals, if set
+	communityPool CommunityPool
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
@@ -39,6 +55,22 @@ func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, ds sdk.Delegat
 	}
 }
 
+// WithParamChanges returns the Keeper applying the param changes of the
+// passed ParameterChange proposals with the router. Without it, proposals
+// changing params can't be submitted.
+func (keeper Keeper) WithParamChanges(router *params.ChangeRouter) Keeper {
+	keeper.paramChanges = router
+	return keeper
+}
+
+// WithCommunityPool returns the Keeper paying the passed
+// CommunityPoolSpend proposals from the community pool. Without it,
+// proposals spending the community pool can't be submitted.
+func (keeper Keeper) WithCommunityPool(communityPool CommunityPool) Keeper {
+	keeper.communityPool = communityPool
+	return keeper
+}
+
 // Returns the go-wire codec.
 func (keeper Keeper) WireCodec() *wire.Codec {
 	return keeper.cdc
@@ -68,6 +100,120 @@ func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description
 	return proposal
 }
 
+// NewParameterChangeProposal creates a ParameterChange proposal applying the
+// changes when it passes. The changes are checked by applying them to a
+// cache of the state, which is discarded.
+func (keeper Keeper) NewParameterChangeProposal(ctx sdk.Context, title string, description string, changes []params.Change) (Proposal, sdk.Error) {
+	if keeper.paramChanges == nil {
+		return nil, ErrInvalidParamChanges(keeper.codespace, "params can't be changed by proposals")
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	for _, change := range changes {
+		err := keeper.paramChanges.Apply(cacheCtx, change)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var proposal Proposal = &ParameterChangeProposal{
+		TextProposal: TextProposal{
+			ProposalID:       proposalID,
+			Title:            title,
+			Description:      description,
+			ProposalType:     ProposalTypeParameterChange,
+			Status:           StatusDepositPeriod,
+			TotalDeposit:     sdk.Coins{},
+			SubmitBlock:      ctx.BlockHeight(),
+			VotingStartBlock: -1,
+		},
+		Changes: changes,
+	}
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal, nil
+}
+
+// applyParamChanges applies the param changes of a passed proposal. Either
+// all the changes are applied or, if one fails, none.
+func (keeper Keeper) applyParamChanges(ctx sdk.Context, proposal Proposal) sdk.Error {
+	pcp, ok := proposal.(*ParameterChangeProposal)
+	if !ok {
+		return nil
+	}
+	if keeper.paramChanges == nil {
+		return ErrInvalidParamChanges(keeper.codespace, "params can't be changed by proposals")
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	for _, change := range pcp.Changes {
+		err := keeper.paramChanges.Apply(cacheCtx, change)
+		if err != nil {
+			return err
+		}
+	}
+	writeCache()
+	return nil
+}
+
+// NewCommunityPoolSpendProposal creates a CommunityPoolSpend proposal paying
+// the amount to the recipient when it passes. The community pool must hold
+// the amount when the proposal is submitted, which is checked by paying it
+// in a cache of the state, which is discarded.
+func (keeper Keeper) NewCommunityPoolSpendProposal(ctx sdk.Context, title string, description string, recipient sdk.AccAddress, amount sdk.Coins) (Proposal, sdk.Error) {
+	if keeper.communityPool == nil {
+		return nil, ErrInvalidCommunityPoolSpend(keeper.codespace, "the community pool can't be spent by proposals")
+	}
+	cacheCtx, _ := ctx.CacheContext()
+	err := keeper.communityPool.DistributeFromCommunityPool(cacheCtx, amount, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var proposal Proposal = &CommunityPoolSpendProposal{
+		TextProposal: TextProposal{
+			ProposalID:       proposalID,
+			Title:            title,
+			Description:      description,
+			ProposalType:     ProposalTypeCommunityPoolSpend,
+			Status:           StatusDepositPeriod,
+			TotalDeposit:     sdk.Coins{},
+			SubmitBlock:      ctx.BlockHeight(),
+			VotingStartBlock: -1,
+		},
+		Recipient: recipient,
+		Amount:    amount,
+	}
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal, nil
+}
+
+// applyCommunityPoolSpend pays the community pool spend of a passed
+// proposal, nothing is paid if the community pool no longer holds it
+func (keeper Keeper) applyCommunityPoolSpend(ctx sdk.Context, proposal Proposal) sdk.Error {
+	cpsp, ok := proposal.(*CommunityPoolSpendProposal)
+	if !ok {
+		return nil
+	}
+	if keeper.communityPool == nil {
+		return ErrInvalidCommunityPoolSpend(keeper.codespace, "the community pool can't be spent by proposals")
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := keeper.communityPool.DistributeFromCommunityPool(cacheCtx, cpsp.Amount, cpsp.Recipient)
+	if err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
+
 // Get Proposal from store by ProposalID
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID int64) Proposal {
 	store := ctx.KVStore(keeper.storeKey)
@@ -249,8 +395,8 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID int64, depositerAddr
 		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID), false
 	}
 
-	// Subtract coins from depositer's account
-	_, _, err := keeper.ck.SubtractCoins(ctx, depositerAddr, depositAmount)
+	// Escrow the coins of the depositer on the gov module account
+	_, err := keeper.ck.SendCoinsFromAccountToModule(ctx, depositerAddr, ModuleName, depositAmount)
 	if err != nil {
 		return err, false
 	}
@@ -286,6 +432,21 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID int64) sdk.Iterator
 	return sdk.KVStorePrefixIterator(store, KeyDepositsSubspace(proposalID))
 }
 
+// Iterates over the deposits on all the proposals
+func (keeper Keeper) IterateAllDeposits(ctx sdk.Context, process func(Deposit) (stop bool)) {
+	store := ctx.KVStore(keeper.storeKey)
+	depositsIterator := sdk.KVStorePrefixIterator(store, KeyDepositsPrefix)
+	defer depositsIterator.Close()
+
+	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := Deposit{}
+		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), &deposit)
+		if process(deposit) {
+			return
+		}
+	}
+}
+
 // Returns and deletes all the deposits on a specific proposal
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -295,7 +456,7 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 		deposit := &Deposit{}
 		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
 
-		_, _, err := keeper.ck.AddCoins(ctx, deposit.Depositer, deposit.Amount)
+		_, err := keeper.ck.SendCoinsFromModuleToAccount(ctx, ModuleName, deposit.Depositer, deposit.Amount)
 		if err != nil {
 			panic("should not happen")
 		}
@@ -306,12 +467,20 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	depositsIterator.Close()
 }
 
-// Deletes all the deposits on a specific proposal without refunding them
+// Deletes all the deposits on a specific proposal without refunding them,
+// burning their coins
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
+		_, err := keeper.ck.BurnModuleCoins(ctx, ModuleName, deposit.Amount)
+		if err != nil {
+			panic("should not happen")
+		}
+
 		store.Delete(depositsIterator.Key())
 	}
 
@@ -8,6 +8,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // overwrite defaults for testing
@@ -30,6 +32,110 @@ func TestGetSetProposal(t *testing.T) {
 	require.True(t, ProposalEqual(proposal, gotProposal))
 }
 
+func TestParameterChangeProposal(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// the test params are written to the gov store, values other than true
+	// are rejected
+	paramKey := func(change params.Change) []byte { return []byte("params:" + change.Key) }
+	router := params.NewChangeRouter().AddRoute("test", func(ctx sdk.Context, change params.Change) sdk.Error {
+		if change.Value != "true" {
+			return sdk.ErrUnknownRequest("invalid value")
+		}
+		ctx.KVStore(keeper.storeKey).Set(paramKey(change), []byte(change.Value))
+		return nil
+	})
+	valid := params.NewChange("test/a", "true")
+	invalid := params.NewChange("test/b", "false")
+
+	// params can't be changed without a router
+	_, err := keeper.NewParameterChangeProposal(ctx, "Test", "description", []params.Change{valid})
+	require.NotNil(t, err)
+
+	// the changes are checked without being applied
+	keeper = keeper.WithParamChanges(router)
+	_, err = keeper.NewParameterChangeProposal(ctx, "Test", "description", []params.Change{valid, invalid})
+	require.NotNil(t, err)
+	_, err = keeper.NewParameterChangeProposal(ctx, "Test", "description", []params.Change{params.NewChange("other/a", "true")})
+	require.NotNil(t, err)
+	proposal, err := keeper.NewParameterChangeProposal(ctx, "Test", "description", []params.Change{valid})
+	require.Nil(t, err)
+	require.Equal(t, ProposalTypeParameterChange, proposal.GetProposalType())
+	require.Nil(t, ctx.KVStore(keeper.storeKey).Get(paramKey(valid)))
+
+	gotProposal := keeper.GetProposal(ctx, proposal.GetProposalID())
+	require.True(t, ProposalEqual(proposal, gotProposal))
+	require.Equal(t, []params.Change{valid}, gotProposal.(*ParameterChangeProposal).Changes)
+
+	// the changes are applied when the proposal passes
+	require.Nil(t, keeper.applyParamChanges(ctx, gotProposal))
+	require.Equal(t, []byte("true"), ctx.KVStore(keeper.storeKey).Get(paramKey(valid)))
+
+	// none of the changes are applied if one fails
+	failing := &ParameterChangeProposal{Changes: []params.Change{params.NewChange("test/c", "true"), invalid}}
+	require.NotNil(t, keeper.applyParamChanges(ctx, failing))
+	require.Nil(t, ctx.KVStore(keeper.storeKey).Get([]byte("params:test/c")))
+
+	// text proposals change nothing
+	require.Nil(t, keeper.applyParamChanges(ctx, keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)))
+}
+
+// testCommunityPool holds a single denomination in the gov store and pays
+// the recipients by recording the amount paid
+type testCommunityPool struct {
+	keeper Keeper
+}
+
+func (cp testCommunityPool) DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(cp.keeper.storeKey)
+	pool := int64(0)
+	if bz := store.Get([]byte("pool")); bz != nil {
+		cp.keeper.cdc.MustUnmarshalBinary(bz, &pool)
+	}
+	if amount.AmountOf("steak").Int64() > pool {
+		return ErrInvalidCommunityPoolSpend(DefaultCodespace, "insufficient pool")
+	}
+	store.Set([]byte("pool"), cp.keeper.cdc.MustMarshalBinary(pool-amount.AmountOf("steak").Int64()))
+	store.Set(recipient, cp.keeper.cdc.MustMarshalBinary(amount))
+	return nil
+}
+
+func TestCommunityPoolSpendProposal(t *testing.T) {
+	mapp, keeper, _, addrs, _, _ := getMockApp(t, 1)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	ctx.KVStore(keeper.storeKey).Set([]byte("pool"), keeper.cdc.MustMarshalBinary(int64(10)))
+	tenSteak := sdk.Coins{sdk.NewCoin("steak", 10)}
+
+	// the pool can't be spent without a community pool
+	_, err := keeper.NewCommunityPoolSpendProposal(ctx, "Test", "description", addrs[0], tenSteak)
+	require.NotNil(t, err)
+
+	// the pool must hold the amount, which isn't paid
+	keeper = keeper.WithCommunityPool(testCommunityPool{keeper})
+	_, err = keeper.NewCommunityPoolSpendProposal(ctx, "Test", "description", addrs[0], sdk.Coins{sdk.NewCoin("steak", 11)})
+	require.NotNil(t, err)
+	proposal, err := keeper.NewCommunityPoolSpendProposal(ctx, "Test", "description", addrs[0], tenSteak)
+	require.Nil(t, err)
+	require.Equal(t, ProposalTypeCommunityPoolSpend, proposal.GetProposalType())
+	require.Nil(t, ctx.KVStore(keeper.storeKey).Get(addrs[0]))
+
+	gotProposal := keeper.GetProposal(ctx, proposal.GetProposalID())
+	require.True(t, ProposalEqual(proposal, gotProposal))
+	require.Equal(t, addrs[0], gotProposal.(*CommunityPoolSpendProposal).Recipient)
+	require.True(t, tenSteak.IsEqual(gotProposal.(*CommunityPoolSpendProposal).Amount))
+
+	// the amount is paid when the proposal passes, once
+	require.Nil(t, keeper.applyCommunityPoolSpend(ctx, gotProposal))
+	require.Equal(t, keeper.cdc.MustMarshalBinary(tenSteak), ctx.KVStore(keeper.storeKey).Get(addrs[0]))
+	require.NotNil(t, keeper.applyCommunityPoolSpend(ctx, gotProposal))
+
+	// text proposals spend nothing
+	require.Nil(t, keeper.applyCommunityPoolSpend(ctx, keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)))
+}
+
 func TestIncrementProposalNumber(t *testing.T) {
 	mapp, keeper, _, _, _, _ := getMockApp(t, 0)
 	mapp.BeginBlock(abci.RequestBeginBlock{})
@@ -120,6 +226,10 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, fourSteak.Plus(fiveSteak).Plus(fourSteak), keeper.GetProposal(ctx, proposalID).GetTotalDeposit())
 	require.Equal(t, addr1Initial.Minus(fourSteak), keeper.ck.GetCoins(ctx, addrs[1]))
 
+	// Check that the gov module account escrows the deposits
+	moduleAddr := auth.NewModuleAddress(ModuleName)
+	require.True(t, keeper.ck.GetCoins(ctx, moduleAddr).IsEqual(fourSteak.Plus(fiveSteak).Plus(fourSteak)))
+
 	// Check that proposal moved to voting period
 	require.Equal(t, ctx.BlockHeight(), keeper.GetProposal(ctx, proposalID).GetVotingStartBlock())
 	require.NotNil(t, keeper.ActiveProposalQueuePeek(ctx))
@@ -147,6 +257,7 @@ func TestDeposits(t *testing.T) {
 	require.False(t, found)
 	require.Equal(t, addr0Initial, keeper.ck.GetCoins(ctx, addrs[0]))
 	require.Equal(t, addr1Initial, keeper.ck.GetCoins(ctx, addrs[1]))
+	require.True(t, keeper.ck.GetCoins(ctx, moduleAddr).IsZero())
 
 }
 
@@ -0,0 +1,40 @@
+package gov
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Number of proposals in their voting period.
+	ActiveProposals metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics() *Metrics {
+	return &Metrics{
+		ActiveProposals: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Subsystem: "gov",
+			Name:      "active_proposals",
+			Help:      "Number of proposals in their voting period.",
+		}, []string{}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		ActiveProposals: discard.NewGauge(),
+	}
+}
+
+// Record sets the gauges from the current governance state
+func (m *Metrics) Record(ctx sdk.Context, keeper Keeper) {
+	m.ActiveProposals.Set(float64(len(keeper.getActiveProposalQueue(ctx))))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // name to idetify transaction types
@@ -12,11 +13,14 @@ const MsgType = "gov"
 //-----------------------------------------------------------
 // MsgSubmitProposal
 type MsgSubmitProposal struct {
-	Title          string         //  Title of the proposal
-	Description    string         //  Description of the proposal
-	ProposalType   ProposalKind   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
-	Proposer       sdk.AccAddress //  Address of the proposer
-	InitialDeposit sdk.Coins      //  Initial deposit paid by sender. Must be strictly positive.
+	Title          string          //  Title of the proposal
+	Description    string          //  Description of the proposal
+	ProposalType   ProposalKind    //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	Proposer       sdk.AccAddress  //  Address of the proposer
+	InitialDeposit sdk.Coins       //  Initial deposit paid by sender. Must be strictly positive.
+	ParamChanges   []params.Change `json:",omitempty"` //  Param changes applied when a ParameterChange proposal passes
+	SpendRecipient sdk.AccAddress  `json:",omitempty"` //  Recipient paid from the community pool when a CommunityPoolSpend proposal passes
+	SpendAmount    sdk.Coins       `json:",omitempty"` //  Amount paid from the community pool when a CommunityPoolSpend proposal passes
 }
 
 func NewMsgSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
@@ -29,6 +33,24 @@ func NewMsgSubmitProposal(title string, description string, proposalType Proposa
 	}
 }
 
+// NewMsgSubmitParameterChangeProposal creates a MsgSubmitProposal of a
+// ParameterChange proposal applying the changes when it passes
+func NewMsgSubmitParameterChangeProposal(title string, description string, proposer sdk.AccAddress, initialDeposit sdk.Coins, changes []params.Change) MsgSubmitProposal {
+	msg := NewMsgSubmitProposal(title, description, ProposalTypeParameterChange, proposer, initialDeposit)
+	msg.ParamChanges = changes
+	return msg
+}
+
+// NewMsgSubmitCommunityPoolSpendProposal creates a MsgSubmitProposal of a
+// CommunityPoolSpend proposal paying the amount to the recipient when it
+// passes
+func NewMsgSubmitCommunityPoolSpendProposal(title string, description string, proposer sdk.AccAddress, initialDeposit sdk.Coins, recipient sdk.AccAddress, amount sdk.Coins) MsgSubmitProposal {
+	msg := NewMsgSubmitProposal(title, description, ProposalTypeCommunityPoolSpend, proposer, initialDeposit)
+	msg.SpendRecipient = recipient
+	msg.SpendAmount = amount
+	return msg
+}
+
 // Implements Msg.
 func (msg MsgSubmitProposal) Type() string { return MsgType }
 
@@ -52,6 +74,27 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if !msg.InitialDeposit.IsNotNegative() {
 		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
 	}
+	if len(msg.ParamChanges) > 0 && msg.ProposalType != ProposalTypeParameterChange {
+		return ErrInvalidParamChanges(DefaultCodespace, fmt.Sprintf("%s proposals can't change params", msg.ProposalType))
+	}
+	for _, change := range msg.ParamChanges {
+		err := change.ValidateBasic()
+		if err != nil {
+			return err
+		}
+	}
+	if msg.ProposalType != ProposalTypeCommunityPoolSpend {
+		if len(msg.SpendRecipient) > 0 || len(msg.SpendAmount) > 0 {
+			return ErrInvalidCommunityPoolSpend(DefaultCodespace, fmt.Sprintf("%s proposals can't spend the community pool", msg.ProposalType))
+		}
+		return nil
+	}
+	if len(msg.SpendRecipient) == 0 {
+		return sdk.ErrInvalidAddress(msg.SpendRecipient.String())
+	}
+	if !msg.SpendAmount.IsValid() || !msg.SpendAmount.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.SpendAmount.String())
+	}
 	return nil
 }
 
@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mock"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 var (
@@ -49,6 +50,61 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for the param changes of MsgSubmitProposal
+func TestMsgSubmitProposalParamChanges(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		proposalType ProposalKind
+		changes      []params.Change
+		expectPass   bool
+	}{
+		{ProposalTypeParameterChange, []params.Change{params.NewChange("bank/SendEnabled", "true")}, true},
+		{ProposalTypeParameterChange, []params.Change{params.NewChange("SendEnabled", "true")}, false},
+		{ProposalTypeParameterChange, []params.Change{params.NewChange("bank/SendEnabled", "")}, false},
+		{ProposalTypeText, []params.Change{params.NewChange("bank/SendEnabled", "true")}, false},
+		{ProposalTypeText, nil, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitProposal("Test Proposal", "the purpose of this proposal is to test", tc.proposalType, addrs[0], coinsPos)
+		msg.ParamChanges = tc.changes
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+// test ValidateBasic for the community pool spend of MsgSubmitProposal
+func TestMsgSubmitProposalCommunityPoolSpend(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+	tests := []struct {
+		proposalType ProposalKind
+		recipient    sdk.AccAddress
+		amount       sdk.Coins
+		expectPass   bool
+	}{
+		{ProposalTypeCommunityPoolSpend, addrs[1], coinsPos, true},
+		{ProposalTypeCommunityPoolSpend, addrs[1], coinsMulti, true},
+		{ProposalTypeCommunityPoolSpend, sdk.AccAddress{}, coinsPos, false},
+		{ProposalTypeCommunityPoolSpend, addrs[1], coinsZero, false},
+		{ProposalTypeCommunityPoolSpend, addrs[1], coinsNeg, false},
+		{ProposalTypeText, addrs[1], coinsPos, false},
+		{ProposalTypeText, nil, coinsPos, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitCommunityPoolSpendProposal("Test Proposal", "the purpose of this proposal is to test", addrs[0], coinsPos, tc.recipient, tc.amount)
+		msg.ProposalType = tc.proposalType
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 //-----------------------------------------------------------
@@ -91,6 +92,33 @@ func (tp *TextProposal) SetVotingStartBlock(votingStartBlock int64) {
 	tp.VotingStartBlock = votingStartBlock
 }
 
+//-----------------------------------------------------------
+// Parameter Change Proposals
+
+// ParameterChangeProposal is a proposal applying the param changes when it
+// passes
+type ParameterChangeProposal struct {
+	TextProposal
+	Changes []params.Change `json:"changes"` //  Param changes applied when the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*ParameterChangeProposal)(nil)
+
+//-----------------------------------------------------------
+// Community Pool Spend Proposals
+
+// CommunityPoolSpendProposal is a proposal paying the amount from the
+// community pool to the recipient when it passes
+type CommunityPoolSpendProposal struct {
+	TextProposal
+	Recipient sdk.AccAddress `json:"recipient"` //  Address paid when the proposal passes
+	Amount    sdk.Coins      `json:"amount"`    //  Coins paid from the community pool
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*CommunityPoolSpendProposal)(nil)
+
 //-----------------------------------------------------------
 // ProposalQueue
 type ProposalQueue []int64
@@ -103,9 +131,10 @@ type ProposalKind byte
 
 //nolint
 const (
-	ProposalTypeText            ProposalKind = 0x01
-	ProposalTypeParameterChange ProposalKind = 0x02
-	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
+	ProposalTypeText               ProposalKind = 0x01
+	ProposalTypeParameterChange    ProposalKind = 0x02
+	ProposalTypeSoftwareUpgrade    ProposalKind = 0x03
+	ProposalTypeCommunityPoolSpend ProposalKind = 0x04
 )
 
 // String to proposalType byte.  Returns ff if invalid.
@@ -117,6 +146,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 		return ProposalTypeParameterChange, nil
 	case "SoftwareUpgrade":
 		return ProposalTypeSoftwareUpgrade, nil
+	case "CommunityPoolSpend":
+		return ProposalTypeCommunityPoolSpend, nil
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -126,7 +157,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 func validProposalType(pt ProposalKind) bool {
 	if pt == ProposalTypeText ||
 		pt == ProposalTypeParameterChange ||
-		pt == ProposalTypeSoftwareUpgrade {
+		pt == ProposalTypeSoftwareUpgrade ||
+		pt == ProposalTypeCommunityPoolSpend {
 		return true
 	}
 	return false
@@ -167,12 +199,14 @@ func (pt *ProposalKind) UnmarshalJSON(data []byte) error {
 // Turns VoteOption byte to String
 func (pt ProposalKind) String() string {
 	switch pt {
-	case 0x00:
+	case ProposalTypeText:
 		return "Text"
-	case 0x01:
+	case ProposalTypeParameterChange:
 		return "ParameterChange"
-	case 0x02:
+	case ProposalTypeSoftwareUpgrade:
 		return "SoftwareUpgrade"
+	case ProposalTypeCommunityPoolSpend:
+		return "CommunityPoolSpend"
 	default:
 		return ""
 	}
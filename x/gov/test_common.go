@@ -12,6 +12,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -27,7 +28,11 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	keyStake := sdk.NewKVStoreKey("stake")
 	keyGov := sdk.NewKVStoreKey("gov")
 
-	ck := bank.NewKeeper(mapp.AccountMapper)
+	ck := bank.NewKeeper(mapp.AccountMapper).WithModuleAccounts(map[string][]string{
+		ModuleName:              {auth.Burner},
+		stake.BondedPoolName:    {auth.Staking, auth.Burner},
+		stake.NotBondedPoolName: {auth.Staking, auth.Burner},
+	})
 	sk := stake.NewKeeper(mapp.Cdc, keyStake, ck, mapp.RegisterCodespace(stake.DefaultCodespace))
 	keeper := NewKeeper(mapp.Cdc, keyGov, ck, sk, DefaultCodespace)
 	mapp.Router().AddRoute("gov", NewHandler(keeper))
@@ -65,7 +70,10 @@ func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper) sdk
 		if err != nil {
 			panic(err)
 		}
-		InitGenesis(ctx, keeper, DefaultGenesisState())
+		err = InitGenesis(ctx, keeper, DefaultGenesisState())
+		if err != nil {
+			panic(err)
+		}
 		return abci.ResponseInitChain{}
 	}
 }
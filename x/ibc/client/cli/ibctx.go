@@ -27,7 +27,10 @@ func IBCTransferCmd(cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "transfer",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
 
 			// get the from address
 			from, err := ctx.GetFromAddress()
@@ -36,7 +39,7 @@ func IBCTransferCmd(cdc *wire.Codec) *cobra.Command {
 			}
 
 			// build the message
-			msg, err := buildMsg(from)
+			msg, err := buildMsg(ctx, from)
 			if err != nil {
 				return err
 			}
@@ -56,9 +59,9 @@ func IBCTransferCmd(cdc *wire.Codec) *cobra.Command {
 	return cmd
 }
 
-func buildMsg(from sdk.AccAddress) (sdk.Msg, error) {
+func buildMsg(ctx context.CoreContext, from sdk.AccAddress) (sdk.Msg, error) {
 	amount := viper.GetString(flagAmount)
-	coins, err := sdk.ParseCoins(amount)
+	coins, err := ctx.ParseCoins(amount)
 	if err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ type transferBody struct {
 	SrcChainID       string    `json:"src_chain_id"`
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
-	Gas              int64     `json:"gas"`
+	Gas              string    `json:"gas"`
 }
 
 // TransferRequestHandler - http request handler to transfer coins to a address
@@ -71,7 +71,12 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.Core
 		msg := ibc.IBCTransferMsg{packet}
 
 		// add gas to context
-		ctx = ctx.WithGas(m.Gas)
+		ctx, err = ctx.WithGasSetting(m.Gas)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
 
 		// sign
 		ctx = ctx.WithAccountNumber(m.AccountNumber)
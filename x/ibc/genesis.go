@@ -0,0 +1,109 @@
+package ibc
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the sequences of the incoming packets and the outgoing
+// packets at genesis
+type GenesisState struct {
+	IngressSequences []IngressSequence `json:"ingress_sequences"`
+	EgressPackets    []IBCPacket       `json:"egress_packets"`
+}
+
+// IngressSequence - the sequence of the incoming packets of a source chain
+type IngressSequence struct {
+	SrcChain string `json:"src_chain"`
+	Sequence int64  `json:"sequence"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis checks the sequences and the outgoing packets, the chain
+// IDs can't contain slashes which separate them from the indexes in the keys
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.IngressSequences))
+	for _, seq := range data.IngressSequences {
+		if seq.SrcChain == "" || strings.Contains(seq.SrcChain, "/") {
+			return fmt.Errorf("invalid source chain %q", seq.SrcChain)
+		}
+		if seen[seq.SrcChain] {
+			return fmt.Errorf("duplicate ingress sequence of chain %s", seq.SrcChain)
+		}
+		seen[seq.SrcChain] = true
+		if seq.Sequence < 0 {
+			return fmt.Errorf("negative ingress sequence of chain %s", seq.SrcChain)
+		}
+	}
+	for _, packet := range data.EgressPackets {
+		if packet.DestChain == "" || strings.Contains(packet.DestChain, "/") {
+			return fmt.Errorf("invalid destination chain %q", packet.DestChain)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis - set the ingress sequences and post the outgoing packets in
+// order, which restores their indexes
+func InitGenesis(ctx sdk.Context, ibcm Mapper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	for _, seq := range data.IngressSequences {
+		ibcm.SetIngressSequence(ctx, seq.SrcChain, seq.Sequence)
+	}
+	for _, packet := range data.EgressPackets {
+		if err := ibcm.PostIBCPacket(ctx, packet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGenesis - output the ingress sequences and the outgoing packets by
+// destination chain and index
+func WriteGenesis(ctx sdk.Context, ibcm Mapper) GenesisState {
+	store := ctx.KVStore(ibcm.key)
+
+	var seqs []IngressSequence
+	iter := sdk.KVStorePrefixIterator(store, []byte("ingress/"))
+	for ; iter.Valid(); iter.Next() {
+		seq := IngressSequence{SrcChain: string(iter.Key()[len("ingress/"):])}
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &seq.Sequence)
+		seqs = append(seqs, seq)
+	}
+	iter.Close()
+
+	// the keys of the lengths hold the chain ID, those of the packets the
+	// chain ID and the index
+	var packets []IBCPacket
+	iter = sdk.KVStorePrefixIterator(store, []byte("egress/"))
+	for ; iter.Valid(); iter.Next() {
+		destChain := string(iter.Key()[len("egress/"):])
+		if strings.Contains(destChain, "/") {
+			continue
+		}
+		var length int64
+		unmarshalBinaryPanic(ibcm.cdc, iter.Value(), &length)
+		for index := int64(0); index < length; index++ {
+			var packet IBCPacket
+			unmarshalBinaryPanic(ibcm.cdc, store.Get(EgressKey(destChain, index)), &packet)
+			packets = append(packets, packet)
+		}
+	}
+	iter.Close()
+
+	return GenesisState{
+		IngressSequences: seqs,
+		EgressPackets:    packets,
+	}
+}
@@ -134,3 +134,33 @@ func TestIBC(t *testing.T) {
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, int64(1))
 }
+
+func TestExportImportGenesis(t *testing.T) {
+	cdc := makeCodec()
+	key := sdk.NewKVStoreKey("ibc")
+	ctx := defaultContext(key)
+	ibcm := NewMapper(cdc, key, DefaultCodespace)
+
+	src, dest := newAddress(), newAddress()
+	mycoins := sdk.Coins{sdk.NewCoin("mycoin", 10)}
+	ibcm.SetIngressSequence(ctx, "chaina", 3)
+	require.Nil(t, ibcm.PostIBCPacket(ctx, NewIBCPacket(src, dest, mycoins, "gaia", "chainb")))
+	require.Nil(t, ibcm.PostIBCPacket(ctx, NewIBCPacket(dest, src, mycoins, "gaia", "chainb")))
+	require.Nil(t, ibcm.PostIBCPacket(ctx, NewIBCPacket(src, dest, mycoins, "gaia", "chaina")))
+
+	genesis := WriteGenesis(ctx, ibcm)
+	require.Nil(t, ValidateGenesis(genesis))
+	require.Equal(t, []IngressSequence{{"chaina", 3}}, genesis.IngressSequences)
+	require.Len(t, genesis.EgressPackets, 3)
+
+	// the packets keep their indexes on a new chain
+	ctx2 := defaultContext(key)
+	require.Nil(t, InitGenesis(ctx2, ibcm, genesis))
+	require.Equal(t, genesis, WriteGenesis(ctx2, ibcm))
+	require.Equal(t, int64(3), ibcm.GetIngressSequence(ctx2, "chaina"))
+	require.Equal(t, int64(2), ibcm.getEgressLength(ctx2.KVStore(key), "chainb"))
+
+	// the chain IDs can't contain slashes
+	genesis.IngressSequences = []IngressSequence{{"chain/a", 3}}
+	require.NotNil(t, ValidateGenesis(genesis))
+}
@@ -0,0 +1,45 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the minter and the minting params at genesis
+type GenesisState struct {
+	Minter Minter `json:"minter"`
+	Params Params `json:"params"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Minter: InitialMinter(),
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis checks the minter and the params
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Minter.Validate(); err != nil {
+		return err
+	}
+	return data.Params.Validate()
+}
+
+// InitGenesis - set the minter and the params
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	k.SetMinter(ctx, data.Minter)
+	k.SetParams(ctx, data.Params)
+	return nil
+}
+
+// WriteGenesis - output the minter and the params
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return GenesisState{
+		Minter: k.GetMinter(ctx),
+		Params: k.GetParams(ctx),
+	}
+}
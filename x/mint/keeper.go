@@ -0,0 +1,104 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// ModuleName is the name of the module account of mint, which needs the
+// minter permission
+const ModuleName = "mint"
+
+//nolint
+var (
+	// Keys for the store
+	MinterKey = []byte{0x00} // key for the minter
+	ParamsKey = []byte{0x01} // key for the params
+)
+
+// Keeper of the mint store
+type Keeper struct {
+	storeKey    sdk.StoreKey
+	cdc         *wire.Codec
+	coinKeeper  bank.Keeper
+	stakeKeeper stake.Keeper
+}
+
+// NewKeeper returns a new mint Keeper, the coinKeeper must move the coins
+// of the mint and of the fee collector module accounts
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, sk stake.Keeper) Keeper {
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		coinKeeper:  ck,
+		stakeKeeper: sk,
+	}
+}
+
+//______________________________________________________________________
+
+// GetMinter returns the minter
+func (k Keeper) GetMinter(ctx sdk.Context) (minter Minter) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(MinterKey)
+	if bz == nil {
+		panic("Stored minter should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(bz, &minter)
+	return
+}
+
+// SetMinter sets the minter
+func (k Keeper) SetMinter(ctx sdk.Context, minter Minter) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(MinterKey, k.cdc.MustMarshalBinary(minter))
+}
+
+// GetParams returns the minting params
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		panic("Stored params should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(bz, &params)
+	return
+}
+
+// SetParams sets the minting params
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(ParamsKey, k.cdc.MustMarshalBinary(params))
+}
+
+// Inflation returns the current annual inflation rate
+func (k Keeper) Inflation(ctx sdk.Context) sdk.Rat {
+	return k.GetMinter(ctx).Inflation
+}
+
+// AnnualProvisions returns the current annual provisions
+func (k Keeper) AnnualProvisions(ctx sdk.Context) sdk.Rat {
+	return k.GetMinter(ctx).AnnualProvisions
+}
+
+//______________________________________________________________________
+
+// MintProvisions mints the provisions of the bond denomination into the
+// mint module account and pays them to the fee collector, which the
+// distribution distributes with the fees. The tokens are added to the loose
+// tokens of stake.
+func (k Keeper) MintProvisions(ctx sdk.Context, provisions sdk.Int) {
+	coins := sdk.Coins{{k.stakeKeeper.GetParams(ctx).BondDenom, provisions}}
+	_, err := k.coinKeeper.MintModuleCoins(ctx, ModuleName, coins)
+	if err != nil {
+		panic(err)
+	}
+	_, err = k.coinKeeper.SendCoinsFromModuleToModule(ctx, ModuleName, auth.FeeCollectorName, coins)
+	if err != nil {
+		panic(err)
+	}
+	k.stakeKeeper.InflateSupply(ctx, provisions)
+}
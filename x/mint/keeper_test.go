@@ -0,0 +1,70 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+func TestBeginBlocker(t *testing.T) {
+	ctx, ck, sk, keeper := createTestInput(t)
+	params := keeper.GetParams(ctx)
+	feeCollector := auth.NewModuleAddress(auth.FeeCollectorName)
+
+	// the first block calculates the provisions with nothing bonded
+	ctx = ctx.WithBlockHeader(abci.Header{Time: 10000})
+	BeginBlocker(ctx, keeper)
+	minter := keeper.GetMinter(ctx)
+	expInflation := InitialMinter().NextInflation(params, sdk.ZeroRat())
+	require.True(sdk.RatEq(t, expInflation, keeper.Inflation(ctx)))
+	require.True(sdk.RatEq(t, expInflation.Mul(sdk.NewRat(initTokens)), keeper.AnnualProvisions(ctx)))
+	require.Equal(t, int64(10000), minter.InflationLastTime)
+
+	// the provisions of the block are paid to the fee collector and added to
+	// the loose tokens
+	provisions := minter.BlockProvision(params)
+	require.True(t, provisions.Sign() > 0)
+	require.Equal(t, sdk.Coins{{"steak", provisions}}, ck.GetCoins(ctx, feeCollector))
+	require.True(t, ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName)).IsZero())
+	require.True(sdk.RatEq(t, sdk.NewRat(initTokens).Add(sdk.NewRatFromInt(provisions)), sk.GetPool(ctx).LooseTokens))
+
+	// the provisions don't change within the hour, whatever the block times
+	ctx = ctx.WithBlockHeader(abci.Header{Time: 10000 + recalculationPeriod - 1})
+	BeginBlocker(ctx, keeper)
+	require.Equal(t, minter.InflationLastTime, keeper.GetMinter(ctx).InflationLastTime)
+	require.True(sdk.RatEq(t, minter.AnnualProvisions, keeper.AnnualProvisions(ctx)))
+	require.Equal(t, sdk.Coins{{"steak", provisions.MulRaw(2)}}, ck.GetCoins(ctx, feeCollector))
+
+	// but they are recalculated after an hour
+	ctx = ctx.WithBlockHeader(abci.Header{Time: 10000 + recalculationPeriod})
+	BeginBlocker(ctx, keeper)
+	minter2 := keeper.GetMinter(ctx)
+	require.Equal(t, 10000+recalculationPeriod, minter2.InflationLastTime)
+	require.True(t, minter2.Inflation.GT(minter.Inflation))
+	require.True(t, minter2.AnnualProvisions.GT(minter.AnnualProvisions))
+}
+
+func TestInitGenesis(t *testing.T) {
+	ctx, _, _, keeper := createTestInput(t)
+
+	genesis := DefaultGenesisState()
+	genesis.Params.InflationMin = sdk.NewRat(30, 100)
+	require.NotNil(t, InitGenesis(ctx, keeper, genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Minter.AnnualProvisions = sdk.NewRat(-1)
+	require.NotNil(t, InitGenesis(ctx, keeper, genesis))
+
+	genesis = DefaultGenesisState()
+	genesis.Minter.Inflation = sdk.NewRat(1, 10)
+	genesis.Params.BlocksPerYear = 100
+	require.Nil(t, InitGenesis(ctx, keeper, genesis))
+	exported := WriteGenesis(ctx, keeper)
+	require.True(sdk.RatEq(t, genesis.Minter.Inflation, exported.Minter.Inflation))
+	require.Equal(t, uint64(100), exported.Params.BlocksPerYear)
+}
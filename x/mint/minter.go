@@ -0,0 +1,81 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const precision = 100000000000     // increased to this precision for accuracy
+var hrsPerYrRat = sdk.NewRat(8766) // as defined by a julian year of 365.25 days
+
+// Minter is the state of the minting. The inflation and the annual
+// provisions are recalculated every hour, and the provisions of a block are
+// the annual provisions divided by the blocks per year, so they don't depend
+// on the times of the blocks.
+type Minter struct {
+	InflationLastTime int64   `json:"inflation_last_time"` // unix time of the last recalculation of the inflation
+	Inflation         sdk.Rat `json:"inflation"`           // current annual inflation rate
+	AnnualProvisions  sdk.Rat `json:"annual_provisions"`   // current annual provisions
+}
+
+// InitialMinter returns the minter of a new chain, its provisions are
+// calculated in its first block
+func InitialMinter() Minter {
+	return Minter{
+		InflationLastTime: 0,
+		Inflation:         sdk.NewRat(7, 100),
+		AnnualProvisions:  sdk.ZeroRat(),
+	}
+}
+
+// Validate checks that the inflation and the annual provisions are set and
+// not negative
+func (m Minter) Validate() error {
+	if m.Inflation.Rat == nil || m.AnnualProvisions.Rat == nil {
+		return fmt.Errorf("the inflation and the annual provisions of the minter must be set")
+	}
+	if m.Inflation.LT(sdk.ZeroRat()) {
+		return fmt.Errorf("the inflation can't be negative: %v", m.Inflation.FloatString())
+	}
+	if m.AnnualProvisions.LT(sdk.ZeroRat()) {
+		return fmt.Errorf("the annual provisions can't be negative: %v", m.AnnualProvisions.FloatString())
+	}
+	return nil
+}
+
+// NextInflation returns the inflation rate for the next hour
+func (m Minter) NextInflation(params Params, bondedRatio sdk.Rat) (inflation sdk.Rat) {
+
+	// The target annual inflation rate is recalculated every hour. The
+	// inflation is also subject to a rate change (positive or negative)
+	// depending on the distance from the desired ratio (67%). The maximum rate
+	// change possible is defined to be 13% per year, however the annual
+	// inflation is capped as between 7% and 20%.
+
+	// (1 - bondedRatio/GoalBonded) * InflationRateChange
+	inflationRateChangePerYear := sdk.OneRat().Sub(bondedRatio.Quo(params.GoalBonded)).Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.Quo(hrsPerYrRat)
+
+	// increase the new annual inflation for this next cycle
+	inflation = m.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation.Round(precision)
+}
+
+// NextAnnualProvisions returns the annual provisions of the inflation of
+// the minter for the token supply
+func (m Minter) NextAnnualProvisions(totalSupply sdk.Rat) sdk.Rat {
+	return m.Inflation.Mul(totalSupply)
+}
+
+// BlockProvision returns the tokens minted in a block
+func (m Minter) BlockProvision(params Params) sdk.Int {
+	return m.AnnualProvisions.Quo(sdk.NewRat(int64(params.BlocksPerYear))).RoundInt()
+}
@@ -0,0 +1,99 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNextInflation(t *testing.T) {
+	minter := InitialMinter()
+	params := DefaultParams()
+
+	// Governing Mechanism:
+	//    inflationRateChangePerYear = (1- BondedRatio/ GoalBonded) * MaxInflationRateChange
+
+	tests := []struct {
+		name                                      string
+		bondedRatio, setInflation, expectedChange sdk.Rat
+	}{
+		// with 0% bonded atom supply the inflation should increase by InflationRateChange
+		{"test 1", sdk.ZeroRat(), sdk.NewRat(7, 100), params.InflationRateChange.Quo(hrsPerYrRat).Round(precision)},
+
+		// 100% bonded, starting at 20% inflation and being reduced
+		// (1 - (1/0.67))*(0.13/8667)
+		{"test 2", sdk.OneRat(), sdk.NewRat(20, 100),
+			sdk.OneRat().Sub(sdk.OneRat().Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(hrsPerYrRat).Round(precision)},
+
+		// 50% bonded, starting at 10% inflation and being increased
+		{"test 3", sdk.NewRat(1, 2), sdk.NewRat(10, 100),
+			sdk.OneRat().Sub(sdk.NewRat(1, 2).Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(hrsPerYrRat).Round(precision)},
+
+		// test 7% minimum stop (testing with 100% bonded)
+		{"test 4", sdk.OneRat(), sdk.NewRat(7, 100), sdk.ZeroRat()},
+		{"test 5", sdk.OneRat(), sdk.NewRat(70001, 1000000), sdk.NewRat(-1, 1000000).Round(precision)},
+
+		// test 20% maximum stop (testing with 0% bonded)
+		{"test 6", sdk.ZeroRat(), sdk.NewRat(20, 100), sdk.ZeroRat()},
+		{"test 7", sdk.ZeroRat(), sdk.NewRat(199999, 1000000), sdk.NewRat(1, 1000000).Round(precision)},
+
+		// perfect balance shouldn't change inflation
+		{"test 8", sdk.NewRat(67, 100), sdk.NewRat(15, 100), sdk.ZeroRat()},
+	}
+	for _, tc := range tests {
+		minter.Inflation = tc.setInflation
+
+		inflation := minter.NextInflation(params, tc.bondedRatio)
+		diffInflation := inflation.Sub(tc.setInflation)
+
+		require.True(t, diffInflation.Equal(tc.expectedChange),
+			"Name: %v\nDiff:  %v\nExpected: %v\n", tc.name, diffInflation, tc.expectedChange)
+	}
+}
+
+func TestBlockProvision(t *testing.T) {
+	minter := InitialMinter()
+	params := DefaultParams()
+	params.BlocksPerYear = 1000
+
+	tests := []struct {
+		annualProvisions sdk.Rat
+		expProvisions    int64
+	}{
+		{sdk.ZeroRat(), 0},
+		{sdk.NewRat(1000), 1},
+		{sdk.NewRat(2000), 2},
+		{sdk.NewRat(1234567), 1235},
+	}
+	for i, tc := range tests {
+		minter.AnnualProvisions = tc.annualProvisions
+		provisions := minter.BlockProvision(params)
+		require.Equal(t, tc.expProvisions, provisions.Int64(), "test: %v", i)
+	}
+
+	// the annual provisions are the inflation of the supply
+	minter.Inflation = sdk.NewRat(1, 10)
+	require.True(sdk.RatEq(t, sdk.NewRat(100), minter.NextAnnualProvisions(sdk.NewRat(1000))))
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.Nil(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.InflationMin = sdk.NewRat(30, 100)
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.GoalBonded = sdk.ZeroRat()
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.BlocksPerYear = 0
+	require.NotNil(t, params.Validate())
+
+	params = DefaultParams()
+	params.InflationRateChange = sdk.NewRat(-1, 100)
+	require.NotNil(t, params.Validate())
+}
@@ -0,0 +1,54 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Params of the minting. The inflation changes by up to the inflation rate
+// change per year towards the goal bonded ratio, between the min and the max
+// inflation.
+type Params struct {
+	InflationRateChange sdk.Rat `json:"inflation_rate_change"` // maximum annual change in inflation rate
+	InflationMax        sdk.Rat `json:"inflation_max"`         // maximum inflation rate
+	InflationMin        sdk.Rat `json:"inflation_min"`         // minimum inflation rate
+	GoalBonded          sdk.Rat `json:"goal_bonded"`           // goal of percent bonded atoms
+	BlocksPerYear       uint64  `json:"blocks_per_year"`       // expected blocks per year
+}
+
+// DefaultParams returns the default minting params
+func DefaultParams() Params {
+	return Params{
+		InflationRateChange: sdk.NewRat(13, 100),
+		InflationMax:        sdk.NewRat(20, 100),
+		InflationMin:        sdk.NewRat(7, 100),
+		GoalBonded:          sdk.NewRat(67, 100),
+		BlocksPerYear:       uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+	}
+}
+
+// Validate checks that the rates are set and not negative, that the min
+// inflation isn't more than the max inflation, and that the goal bonded
+// ratio and the blocks per year aren't zero
+func (p Params) Validate() error {
+	for _, rate := range []sdk.Rat{p.InflationRateChange, p.InflationMax, p.InflationMin, p.GoalBonded} {
+		if rate.Rat == nil {
+			return fmt.Errorf("mint rates must be set")
+		}
+		if rate.LT(sdk.ZeroRat()) {
+			return fmt.Errorf("mint rates can't be negative: %v", rate.FloatString())
+		}
+	}
+	if p.InflationMin.GT(p.InflationMax) {
+		return fmt.Errorf("the min inflation %v is more than the max inflation %v",
+			p.InflationMin.FloatString(), p.InflationMax.FloatString())
+	}
+	if p.GoalBonded.IsZero() {
+		return fmt.Errorf("the goal bonded ratio can't be zero")
+	}
+	if p.BlocksPerYear == 0 {
+		return fmt.Errorf("the blocks per year can't be zero")
+	}
+	return nil
+}
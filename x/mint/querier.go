@@ -0,0 +1,43 @@
+package mint
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the mint querier
+const (
+	QueryParams           = "params"
+	QueryInflation        = "inflation"
+	QueryAnnualProvisions = "annual_provisions"
+)
+
+// NewQuerier returns the querier of the mint queries
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		if len(path) == 0 {
+			return nil, sdk.ErrUnknownRequest("no mint query endpoint specified")
+		}
+		switch path[0] {
+		case QueryParams:
+			return k.marshalQueryResponse(k.GetParams(ctx))
+		case QueryInflation:
+			return k.marshalQueryResponse(k.Inflation(ctx))
+		case QueryAnnualProvisions:
+			return k.marshalQueryResponse(k.AnnualProvisions(ctx))
+		default:
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("unknown mint query endpoint %s", path[0]))
+		}
+	}
+}
+
+func (k Keeper) marshalQueryResponse(res interface{}) ([]byte, sdk.Error) {
+	bz, err := k.cdc.MarshalJSON(res)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
@@ -0,0 +1,31 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the inflation and the annual provisions are recalculated every hour
+const recalculationPeriod int64 = 60 * 60
+
+// BeginBlocker recalculates the inflation and the annual provisions if an
+// hour has passed since they were last calculated, and mints the provisions
+// of the block
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	blockTime := ctx.BlockHeader().Time
+	if blockTime-minter.InflationLastTime >= recalculationPeriod {
+		pool := k.stakeKeeper.GetPool(ctx)
+		minter.InflationLastTime = blockTime
+		minter.Inflation = minter.NextInflation(params, pool.BondedRatio())
+		minter.AnnualProvisions = minter.NextAnnualProvisions(pool.TokenSupply())
+		k.SetMinter(ctx, minter)
+	}
+
+	provisions := minter.BlockProvision(params)
+	if provisions.Sign() <= 0 {
+		return
+	}
+	k.MintProvisions(ctx, provisions)
+}
@@ -0,0 +1,78 @@
+package params
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Change is a change of the param of the key to the JSON encoded value, the
+// key starts with the name of the module of the param, e.g. "bank/"
+type Change struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// NewChange creates a new Change
+func NewChange(key, value string) Change {
+	return Change{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// Module returns the name of the module of the param
+func (c Change) Module() string {
+	return strings.SplitN(c.Key, "/", 2)[0]
+}
+
+// ValidateBasic checks that the key names a module and the value is set
+func (c Change) ValidateBasic() sdk.Error {
+	if !strings.Contains(c.Key, "/") || c.Module() == "" {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("param key %s doesn't start with a module name", c.Key))
+	}
+	if c.Value == "" {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("no value for param %s", c.Key))
+	}
+	return nil
+}
+
+// ChangeHandler validates a change of a param of a module and applies it
+type ChangeHandler func(ctx sdk.Context, change Change) sdk.Error
+
+// ChangeRouter routes the param changes to the ChangeHandler of the module
+// of their key
+type ChangeRouter struct {
+	handlers map[string]ChangeHandler
+}
+
+// NewChangeRouter returns a new ChangeRouter
+func NewChangeRouter() *ChangeRouter {
+	return &ChangeRouter{
+		handlers: make(map[string]ChangeHandler),
+	}
+}
+
+// AddRoute adds the ChangeHandler of the params of the module
+func (rtr *ChangeRouter) AddRoute(module string, h ChangeHandler) *ChangeRouter {
+	if _, ok := rtr.handlers[module]; ok {
+		panic(fmt.Sprintf("param change route %s has already been initialized", module))
+	}
+	rtr.handlers[module] = h
+	return rtr
+}
+
+// Apply applies the change with the ChangeHandler of the module of its key,
+// the params of a module without a ChangeHandler can't be changed
+func (rtr *ChangeRouter) Apply(ctx sdk.Context, change Change) sdk.Error {
+	err := change.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	h, ok := rtr.handlers[change.Module()]
+	if !ok {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("the params of module %s can't be changed", change.Module()))
+	}
+	return h(ctx, change)
+}
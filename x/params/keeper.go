@@ -103,6 +103,19 @@ func (k Getter) GetRaw(ctx sdk.Context, key string) []byte {
 	return k.k.getRaw(ctx, key)
 }
 
+// IterateRaw iterates over the params whose key starts with the prefix in
+// order of key, stopping when process returns true
+func (k Getter) IterateRaw(ctx sdk.Context, prefix string, process func(key string, value []byte) (stop bool)) {
+	store := ctx.KVStore(k.k.key)
+	iter := sdk.KVStorePrefixIterator(store, []byte(prefix))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if process(string(iter.Key()), iter.Value()) {
+			return
+		}
+	}
+}
+
 // GetString is helper function for string params
 func (k Getter) GetString(ctx sdk.Context, key string) (res string, err error) {
 	store := ctx.KVStore(k.k.key)
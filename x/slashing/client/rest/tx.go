@@ -30,7 +30,7 @@ type UnrevokeBody struct {
 	ChainID          string `json:"chain_id"`
 	AccountNumber    int64  `json:"account_number"`
 	Sequence         int64  `json:"sequence"`
-	Gas              int64  `json:"gas"`
+	Gas              string `json:"gas"`
 	ValidatorAddr    string `json:"validator_addr"`
 }
 
@@ -70,7 +70,12 @@ func unrevokeRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, ctx context.Core
 			return
 		}
 
-		ctx = ctx.WithGas(m.Gas)
+		ctx, err = ctx.WithGasSetting(m.Gas)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(err.Error()))
+			return
+		}
 		ctx = ctx.WithChainID(m.ChainID)
 		ctx = ctx.WithAccountNumber(m.AccountNumber)
 		ctx = ctx.WithSequence(m.Sequence)
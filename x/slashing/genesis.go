@@ -0,0 +1,112 @@
+package slashing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - the slashing params and the signing infos of the
+// validators at genesis
+type GenesisState struct {
+	Params       Params               `json:"params"`
+	SigningInfos []GenesisSigningInfo `json:"signing_infos"`
+}
+
+// GenesisSigningInfo - the signing info of a validator and the indexes of
+// the signed blocks in its window
+type GenesisSigningInfo struct {
+	Address      sdk.ValAddress       `json:"address"`
+	SigningInfo  ValidatorSigningInfo `json:"signing_info"`
+	SignedBlocks []int64              `json:"signed_blocks"`
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis checks the params and that the signed blocks of the
+// signing infos match their counters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(data.SigningInfos))
+	for _, info := range data.SigningInfos {
+		if len(info.Address) == 0 {
+			return fmt.Errorf("signing info without a validator address")
+		}
+		if seen[string(info.Address)] {
+			return fmt.Errorf("duplicate signing info of validator %s", info.Address)
+		}
+		seen[string(info.Address)] = true
+
+		indexes := make(map[int64]bool, len(info.SignedBlocks))
+		for _, index := range info.SignedBlocks {
+			if index < 0 || indexes[index] {
+				return fmt.Errorf("invalid signed block index %d of validator %s", index, info.Address)
+			}
+			indexes[index] = true
+		}
+		if int64(len(info.SignedBlocks)) != info.SigningInfo.SignedBlocksCounter {
+			return fmt.Errorf("signed blocks counter %d of validator %s doesn't match its %d signed blocks",
+				info.SigningInfo.SignedBlocksCounter, info.Address, len(info.SignedBlocks))
+		}
+	}
+	return nil
+}
+
+// InitGenesis - set the params, the signing infos and their signed blocks
+func InitGenesis(ctx sdk.Context, k Keeper, data GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
+	k.SetParams(ctx, data.Params)
+	for _, info := range data.SigningInfos {
+		k.setValidatorSigningInfo(ctx, info.Address, info.SigningInfo)
+		for _, index := range info.SignedBlocks {
+			k.setValidatorSigningBitArray(ctx, info.Address, index, true)
+		}
+	}
+	return nil
+}
+
+// WriteGenesis - output the params, the signing infos and their signed
+// blocks
+func WriteGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	store := ctx.KVStore(k.storeKey)
+
+	var infos []GenesisSigningInfo
+	iter := sdk.KVStorePrefixIterator(store, ValidatorSigningInfoKey)
+	for ; iter.Valid(); iter.Next() {
+		info := GenesisSigningInfo{Address: sdk.ValAddress(iter.Key()[len(ValidatorSigningInfoKey):])}
+		k.cdc.MustUnmarshalBinary(iter.Value(), &info.SigningInfo)
+		infos = append(infos, info)
+	}
+	iter.Close()
+
+	for i, info := range infos {
+		prefix := GetValidatorSigningBitArrayPrefixKey(info.Address)
+		bitIter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; bitIter.Valid(); bitIter.Next() {
+			var signed bool
+			k.cdc.MustUnmarshalBinary(bitIter.Value(), &signed)
+			if signed {
+				index := int64(binary.LittleEndian.Uint64(bitIter.Key()[len(prefix):]))
+				infos[i].SignedBlocks = append(infos[i].SignedBlocks, index)
+			}
+		}
+		bitIter.Close()
+		sort.Slice(infos[i].SignedBlocks, func(a, b int) bool { return infos[i].SignedBlocks[a] < infos[i].SignedBlocks[b] })
+	}
+
+	return GenesisState{
+		Params:       k.GetParams(ctx),
+		SigningInfos: infos,
+	}
+}
@@ -0,0 +1,46 @@
+package slashing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, _, _, _, keeper := createTestInput(t)
+	params := DefaultParams()
+	params.SignedBlocksWindow = 1000
+	params.SlashFractionDowntime = sdk.NewRat(1, 50)
+	require.Nil(t, InitGenesis(ctx, keeper, GenesisState{Params: params}))
+
+	// the unsigned blocks of the window aren't exported
+	addr := sdk.ValAddress(addrs[0])
+	keeper.setValidatorSigningInfo(ctx, addr, NewValidatorSigningInfo(4, 3, 2, 2))
+	keeper.setValidatorSigningBitArray(ctx, addr, 0, true)
+	keeper.setValidatorSigningBitArray(ctx, addr, 1, false)
+	keeper.setValidatorSigningBitArray(ctx, addr, 300, true)
+	genesis := WriteGenesis(ctx, keeper)
+	require.Nil(t, ValidateGenesis(genesis))
+	require.Equal(t, int64(1000), genesis.Params.SignedBlocksWindow)
+	require.True(t, genesis.Params.SlashFractionDowntime.Equal(sdk.NewRat(1, 50)))
+	require.Len(t, genesis.SigningInfos, 1)
+	require.Equal(t, []int64{0, 300}, genesis.SigningInfos[0].SignedBlocks)
+
+	// the same state is exported on a new chain
+	ctx2, _, _, _, keeper2 := createTestInput(t)
+	require.Nil(t, InitGenesis(ctx2, keeper2, genesis))
+	require.Equal(t, keeper.cdc.MustMarshalJSON(genesis), keeper2.cdc.MustMarshalJSON(WriteGenesis(ctx2, keeper2)))
+	require.True(t, keeper2.getValidatorSigningBitArray(ctx2, addr, 300))
+	require.Equal(t, int64(1000), keeper2.SignedBlocksWindow(ctx2))
+
+	// the signed blocks must match the counter
+	genesis.SigningInfos[0].SigningInfo.SignedBlocksCounter = 3
+	require.NotNil(t, ValidateGenesis(genesis))
+
+	// the fractions must be between zero and one
+	genesis = DefaultGenesisState()
+	genesis.Params.SlashFractionDoubleSign = sdk.NewRat(2)
+	require.NotNil(t, ValidateGenesis(genesis))
+}
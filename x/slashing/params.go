@@ -1,6 +1,8 @@
 package slashing
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -15,6 +17,75 @@ const (
 	SlashFractionDowntimeKey    = "slashing/SlashFractionDowntime"
 )
 
+// Params of the slashing of the validators which double sign or miss too
+// many blocks
+type Params struct {
+	MaxEvidenceAge           int64   `json:"max_evidence_age"`
+	SignedBlocksWindow       int64   `json:"signed_blocks_window"`
+	MinSignedPerWindow       sdk.Rat `json:"min_signed_per_window"`
+	DoubleSignUnbondDuration int64   `json:"double_sign_unbond_duration"`
+	DowntimeUnbondDuration   int64   `json:"downtime_unbond_duration"`
+	SlashFractionDoubleSign  sdk.Rat `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime    sdk.Rat `json:"slash_fraction_downtime"`
+}
+
+// DefaultParams returns the default slashing params
+func DefaultParams() Params {
+	return Params{
+		MaxEvidenceAge:           defaultMaxEvidenceAge,
+		SignedBlocksWindow:       defaultSignedBlocksWindow,
+		MinSignedPerWindow:       defaultMinSignedPerWindow,
+		DoubleSignUnbondDuration: defaultDoubleSignUnbondDuration,
+		DowntimeUnbondDuration:   defaultDowntimeUnbondDuration,
+		SlashFractionDoubleSign:  defaultSlashFractionDoubleSign,
+		SlashFractionDowntime:    defaultSlashFractionDowntime,
+	}
+}
+
+// Validate checks that the window is positive, the durations non negative
+// and the fractions between zero and one
+func (p Params) Validate() error {
+	if p.SignedBlocksWindow <= 0 {
+		return fmt.Errorf("the signed blocks window must be positive: %d", p.SignedBlocksWindow)
+	}
+	if p.MaxEvidenceAge < 0 || p.DoubleSignUnbondDuration < 0 || p.DowntimeUnbondDuration < 0 {
+		return fmt.Errorf("slashing durations can't be negative")
+	}
+	for _, fraction := range []sdk.Rat{p.MinSignedPerWindow, p.SlashFractionDoubleSign, p.SlashFractionDowntime} {
+		if fraction.Rat == nil {
+			return fmt.Errorf("slashing fractions must be set")
+		}
+		if fraction.LT(sdk.ZeroRat()) || fraction.GT(sdk.OneRat()) {
+			return fmt.Errorf("slashing fractions must be between zero and one: %v", fraction.FloatString())
+		}
+	}
+	return nil
+}
+
+// GetParams returns the slashing params, the default ones if unset
+func (k Keeper) GetParams(ctx sdk.Context) Params {
+	return Params{
+		MaxEvidenceAge:           k.MaxEvidenceAge(ctx),
+		SignedBlocksWindow:       k.SignedBlocksWindow(ctx),
+		MinSignedPerWindow:       k.params.GetRatWithDefault(ctx, MinSignedPerWindowKey, defaultMinSignedPerWindow),
+		DoubleSignUnbondDuration: k.DoubleSignUnbondDuration(ctx),
+		DowntimeUnbondDuration:   k.DowntimeUnbondDuration(ctx),
+		SlashFractionDoubleSign:  k.SlashFractionDoubleSign(ctx),
+		SlashFractionDowntime:    k.SlashFractionDowntime(ctx),
+	}
+}
+
+// SetParams sets the slashing params
+func (k Keeper) SetParams(ctx sdk.Context, p Params) {
+	k.params.SetInt64(ctx, MaxEvidenceAgeKey, p.MaxEvidenceAge)
+	k.params.SetInt64(ctx, SignedBlocksWindowKey, p.SignedBlocksWindow)
+	k.params.SetRat(ctx, MinSignedPerWindowKey, p.MinSignedPerWindow)
+	k.params.SetInt64(ctx, DoubleSignUnbondDurationKey, p.DoubleSignUnbondDuration)
+	k.params.SetInt64(ctx, DowntimeUnbondDurationKey, p.DowntimeUnbondDuration)
+	k.params.SetRat(ctx, SlashFractionDoubleSignKey, p.SlashFractionDoubleSign)
+	k.params.SetRat(ctx, SlashFractionDowntimeKey, p.SlashFractionDowntime)
+}
+
 // MaxEvidenceAge - Max age for evidence - 21 days (3 weeks)
 // MaxEvidenceAge = 60 * 60 * 24 * 7 * 3
 func (k Keeper) MaxEvidenceAge(ctx sdk.Context) int64 {
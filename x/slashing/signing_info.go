@@ -71,14 +71,25 @@ func (i ValidatorSigningInfo) HumanReadableString() string {
 		i.StartHeight, i.IndexOffset, i.JailedUntil, i.SignedBlocksCounter)
 }
 
+// key prefixes of the signing infos and of their signed block bit arrays
+var (
+	ValidatorSigningInfoKey     = []byte{0x01}
+	ValidatorSigningBitArrayKey = []byte{0x02}
+)
+
 // Stored by *validator* address (not owner address)
 func GetValidatorSigningInfoKey(v sdk.ValAddress) []byte {
-	return append([]byte{0x01}, v.Bytes()...)
+	return append(ValidatorSigningInfoKey, v.Bytes()...)
+}
+
+// prefix of every signed block bit array entry of a validator
+func GetValidatorSigningBitArrayPrefixKey(v sdk.ValAddress) []byte {
+	return append(ValidatorSigningBitArrayKey, v.Bytes()...)
 }
 
 // Stored by *validator* address (not owner address)
 func GetValidatorSigningBitArrayKey(v sdk.ValAddress, i int64) []byte {
 	b := make([]byte, 8)
 	binary.LittleEndian.PutUint64(b, uint64(i))
-	return append([]byte{0x02}, append(v.Bytes(), b...)...)
+	return append(GetValidatorSigningBitArrayPrefixKey(v), b...)
 }
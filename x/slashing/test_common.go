@@ -63,7 +63,10 @@ func createTestInput(t *testing.T) (sdk.Context, bank.Keeper, stake.Keeper, para
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewTMLogger(os.Stdout))
 	cdc := createTestCodec()
 	accountMapper := auth.NewAccountMapper(cdc, keyAcc, auth.ProtoBaseAccount)
-	ck := bank.NewKeeper(accountMapper)
+	ck := bank.NewKeeper(accountMapper).WithModuleAccounts(map[string][]string{
+		stake.BondedPoolName:    {auth.Staking, auth.Burner},
+		stake.NotBondedPoolName: {auth.Staking, auth.Burner},
+	})
 	params := params.NewKeeper(cdc, keyParams)
 	sk := stake.NewKeeper(cdc, keyStake, ck, stake.DefaultCodespace)
 	genesis := stake.DefaultGenesisState()
@@ -79,7 +82,7 @@ func createTestInput(t *testing.T) (sdk.Context, bank.Keeper, stake.Keeper, para
 		})
 	}
 	require.Nil(t, err)
-	keeper := NewKeeper(cdc, keySlashing, sk, params.Getter(), DefaultCodespace)
+	keeper := NewKeeper(cdc, keySlashing, sk, params.Setter(), DefaultCodespace)
 	return ctx, ck, sk, params.Setter(), keeper
 }
 
@@ -99,11 +102,5 @@ func testAddr(addr string) sdk.AccAddress {
 }
 
 func newTestMsgCreateValidator(address sdk.AccAddress, pubKey crypto.PubKey, amt sdk.Int) stake.MsgCreateValidator {
-	return stake.MsgCreateValidator{
-		Description:   stake.Description{},
-		DelegatorAddr: address,
-		ValidatorAddr: address,
-		PubKey:        pubKey,
-		Delegation:    sdk.Coin{"steak", amt},
-	}
+	return stake.NewMsgCreateValidator(address, pubKey, sdk.Coin{"steak", amt}, stake.Description{})
 }
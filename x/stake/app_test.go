@@ -34,7 +34,10 @@ func getMockApp(t *testing.T) (*mock.App, Keeper) {
 	RegisterWire(mApp.Cdc)
 
 	keyStake := sdk.NewKVStoreKey("stake")
-	coinKeeper := bank.NewKeeper(mApp.AccountMapper)
+	coinKeeper := bank.NewKeeper(mApp.AccountMapper).WithModuleAccounts(map[string][]string{
+		BondedPoolName:    {auth.Staking, auth.Burner},
+		NotBondedPoolName: {auth.Staking, auth.Burner},
+	})
 	keeper := NewKeeper(mApp.Cdc, keyStake, coinKeeper, mApp.RegisterCodespace(DefaultCodespace))
 
 	mApp.Router().AddRoute("stake", NewHandler(keeper))
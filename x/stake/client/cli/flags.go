@@ -19,6 +19,10 @@ const (
 	FlagIdentity = "keybase-sig"
 	FlagWebsite  = "website"
 	FlagDetails  = "details"
+
+	FlagCommissionRate          = "commission-rate"
+	FlagCommissionMaxRate       = "commission-max-rate"
+	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 )
 
 // common flagsets to add to various functions
@@ -27,6 +31,7 @@ var (
 	fsAmount       = flag.NewFlagSet("", flag.ContinueOnError)
 	fsShares       = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescription  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCommission   = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator    = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDelegator    = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation = flag.NewFlagSet("", flag.ContinueOnError)
@@ -41,6 +46,9 @@ func init() {
 	fsDescription.String(FlagIdentity, "[do-not-modify]", "optional keybase signature")
 	fsDescription.String(FlagWebsite, "[do-not-modify]", "optional website")
 	fsDescription.String(FlagDetails, "[do-not-modify]", "optional details")
+	fsCommission.String(FlagCommissionRate, "0", "commission rate charged on the rewards of the delegators, e.g. 0.1")
+	fsCommission.String(FlagCommissionMaxRate, "0", "maximum commission rate which the validator can ever charge")
+	fsCommission.String(FlagCommissionMaxChangeRate, "0", "maximum change of the commission rate in a day (UTC time)")
 	fsValidator.String(FlagAddressValidator, "", "hex address of the validator")
 	fsDelegator.String(FlagAddressDelegator, "", "hex address of the delegator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "hex address of the source validator")
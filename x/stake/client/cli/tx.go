@@ -22,9 +22,12 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 		Use:   "create-validator",
 		Short: "create new validator initialized with a self-delegation to it",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
 
-			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			amount, err := ctx.ParseCoin(viper.GetString(FlagAmount))
 			if err != nil {
 				return err
 			}
@@ -51,7 +54,15 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 				Details:  viper.GetString(FlagDetails),
 			}
 
-			var msg sdk.Msg
+			var rates [3]sdk.Rat
+			for i, flag := range []string{FlagCommissionRate, FlagCommissionMaxRate, FlagCommissionMaxChangeRate} {
+				rates[i], err = getCommissionRate(flag)
+				if err != nil {
+					return err
+				}
+			}
+
+			var msg stake.MsgCreateValidator
 			if viper.GetString(FlagAddressDelegator) != "" {
 				delegatorAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressDelegator))
 				if err != nil {
@@ -61,6 +72,11 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 			} else {
 				msg = stake.NewMsgCreateValidator(validatorAddr, pk, amount, description)
 			}
+			msg = msg.WithCommission(rates[0], rates[1], rates[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
 
 			// build and sign the transaction, then broadcast to Tendermint
 			err = ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
@@ -74,6 +90,7 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsPk)
 	cmd.Flags().AddFlagSet(fsAmount)
 	cmd.Flags().AddFlagSet(fsDescription)
+	cmd.Flags().AddFlagSet(fsCommission)
 	cmd.Flags().AddFlagSet(fsValidator)
 	cmd.Flags().AddFlagSet(fsDelegator)
 	return cmd
@@ -97,6 +114,13 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 				Details:  viper.GetString(FlagDetails),
 			}
 			msg := stake.NewMsgEditValidator(validatorAddr, description)
+			if cmd.Flags().Changed(FlagCommissionRate) {
+				rate, err := getCommissionRate(FlagCommissionRate)
+				if err != nil {
+					return err
+				}
+				msg = msg.WithCommission(rate)
+			}
 
 			// build and sign the transaction, then broadcast to Tendermint
 			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
@@ -111,17 +135,32 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(fsDescription)
+	cmd.Flags().String(FlagCommissionRate, "", "new commission rate, within the max rate and the max change rate in a day of the validator")
 	cmd.Flags().AddFlagSet(fsValidator)
 	return cmd
 }
 
+// get the commission rate of the flag, a decimal between 0 and 1
+func getCommissionRate(flag string) (sdk.Rat, error) {
+	rate, err := sdk.NewRatFromDecimal(viper.GetString(flag), types.MaxBondDenominatorPrecision)
+	if err != nil {
+		return rate, errors.Errorf("invalid --%s: %s", flag, err)
+	}
+	return rate, nil
+}
+
 // delegate command
 func GetCmdDelegate(cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delegate",
 		Short: "delegate liquid tokens to an validator",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			amount, err := sdk.ParseCoin(viper.GetString(FlagAmount))
+			ctx, err := context.EnsureDenoms(context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc)), cdc)
+			if err != nil {
+				return err
+			}
+
+			amount, err := ctx.ParseCoin(viper.GetString(FlagAmount))
 			if err != nil {
 				return err
 			}
@@ -138,8 +177,6 @@ func GetCmdDelegate(cdc *wire.Codec) *cobra.Command {
 			msg := stake.NewMsgDelegate(delegatorAddr, validatorAddr, amount)
 
 			// build and sign the transaction, then broadcast to Tendermint
-			ctx := context.NewCoreContextFromViper().WithDecoder(authcmd.GetAccountDecoder(cdc))
-
 			err = ctx.EnsureSignBuildBroadcast(ctx.FromAddressName, []sdk.Msg{msg}, cdc)
 			if err != nil {
 				return err
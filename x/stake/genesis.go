@@ -7,12 +7,53 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 )
 
+// ValidateGenesis checks that the validators have tokens and delegator
+// shares and aren't duplicated, and that the delegations, unbonding
+// delegations and redelegations are valid
+func ValidateGenesis(data types.GenesisState) error {
+	validators := make(map[string]bool, len(data.Validators))
+	for _, validator := range data.Validators {
+		if validator.Tokens.IsZero() {
+			return errors.Errorf("genesis validator cannot have zero pool shares, validator: %v", validator)
+		}
+		if validator.DelegatorShares.IsZero() {
+			return errors.Errorf("genesis validator cannot have zero delegator shares, validator: %v", validator)
+		}
+		if validators[string(validator.Owner)] {
+			return errors.Errorf("duplicate genesis validator %s", validator.Owner)
+		}
+		validators[string(validator.Owner)] = true
+	}
+	for _, bond := range data.Bonds {
+		if !validators[string(bond.ValidatorAddr)] {
+			return errors.Errorf("delegation of %s to unknown validator %s", bond.DelegatorAddr, bond.ValidatorAddr)
+		}
+		if bond.Shares.LT(sdk.ZeroRat()) {
+			return errors.Errorf("delegation of %s to %s has negative shares", bond.DelegatorAddr, bond.ValidatorAddr)
+		}
+	}
+	for _, ubd := range data.UnbondingDelegations {
+		if ubd.Balance.Amount.Sign() < 0 || ubd.InitialBalance.Amount.Sign() < 0 {
+			return errors.Errorf("unbonding delegation of %s from %s has a negative balance", ubd.DelegatorAddr, ubd.ValidatorAddr)
+		}
+	}
+	for _, red := range data.Redelegations {
+		if red.Balance.Amount.Sign() < 0 || red.InitialBalance.Amount.Sign() < 0 {
+			return errors.Errorf("redelegation of %s from %s to %s has a negative balance", red.DelegatorAddr, red.ValidatorSrcAddr, red.ValidatorDstAddr)
+		}
+	}
+	return nil
+}
+
 // InitGenesis sets the pool and parameters for the provided keeper and
 // initializes the IntraTxCounter. For each validator in data, it sets that
 // validator in the keeper along with manually setting the indexes. In
-// addition, it also sets any delegations found in data. Finally, it updates
-// the bonded validators.
+// addition, it also sets any delegations, unbonding delegations and
+// redelegations found in data. Finally, it updates the bonded validators.
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error {
+	if err := ValidateGenesis(data); err != nil {
+		return err
+	}
 	keeper.SetPool(ctx, data.Pool)
 	keeper.SetNewParams(ctx, data.Params)
 	keeper.InitIntraTxCounter(ctx)
@@ -20,13 +61,6 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 	for i, validator := range data.Validators {
 		keeper.SetValidator(ctx, validator)
 
-		if validator.Tokens.IsZero() {
-			return errors.Errorf("genesis validator cannot have zero pool shares, validator: %v", validator)
-		}
-		if validator.DelegatorShares.IsZero() {
-			return errors.Errorf("genesis validator cannot have zero delegator shares, validator: %v", validator)
-		}
-
 		// Manually set indexes for the first time
 		keeper.SetValidatorByPubKeyIndex(ctx, validator)
 
@@ -41,25 +75,35 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) error
 	for _, bond := range data.Bonds {
 		keeper.SetDelegation(ctx, bond)
 	}
+	for _, ubd := range data.UnbondingDelegations {
+		keeper.SetUnbondingDelegation(ctx, ubd)
+	}
+	for _, red := range data.Redelegations {
+		keeper.SetRedelegation(ctx, red)
+	}
 
 	keeper.UpdateBondedValidatorsFull(ctx)
 	return nil
 }
 
 // WriteGenesis returns a GenesisState for a given context and keeper. The
-// GenesisState will contain the pool, params, validators, and bonds found in
-// the keeper.
+// GenesisState will contain the pool, params, validators, bonds, unbonding
+// delegations and redelegations found in the keeper.
 func WriteGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 	pool := keeper.GetPool(ctx)
 	params := keeper.GetParams(ctx)
 	validators := keeper.GetAllValidators(ctx)
 	bonds := keeper.GetAllDelegations(ctx)
+	ubds := keeper.GetAllUnbondingDelegations(ctx)
+	reds := keeper.GetAllRedelegations(ctx)
 
 	return types.GenesisState{
-		Pool:       pool,
-		Params:     params,
-		Validators: validators,
-		Bonds:      bonds,
+		Pool:                 pool,
+		Params:               params,
+		Validators:           validators,
+		Bonds:                bonds,
+		UnbondingDelegations: ubds,
+		Redelegations:        reds,
 	}
 }
 
@@ -46,3 +46,57 @@ func TestInitGenesis(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, sdk.Bonded, resVal.Status)
 }
+
+func TestExportImportGenesis(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+
+	pool := keeper.GetPool(ctx)
+	pool.LooseTokens = sdk.NewRat(2)
+	validators := []Validator{
+		NewValidator(keep.Addrs[0], keep.PKs[0], Description{Moniker: "hoop"}),
+		NewValidator(keep.Addrs[1], keep.PKs[1], Description{Moniker: "bloop"}),
+	}
+	for i := range validators {
+		validators[i].Tokens = sdk.OneRat()
+		validators[i].DelegatorShares = sdk.OneRat()
+	}
+	genesisState := types.NewGenesisState(pool, keeper.GetParams(ctx), validators, nil)
+	genesisState.UnbondingDelegations = []UnbondingDelegation{{
+		DelegatorAddr:  keep.Addrs[2],
+		ValidatorAddr:  keep.Addrs[0],
+		CreationHeight: 1,
+		MinTime:        100,
+		InitialBalance: sdk.NewCoin("steak", 5),
+		Balance:        sdk.NewCoin("steak", 5),
+	}}
+	genesisState.Redelegations = []Redelegation{{
+		DelegatorAddr:    keep.Addrs[2],
+		ValidatorSrcAddr: keep.Addrs[0],
+		ValidatorDstAddr: keep.Addrs[1],
+		CreationHeight:   1,
+		MinTime:          100,
+		InitialBalance:   sdk.NewCoin("steak", 5),
+		Balance:          sdk.NewCoin("steak", 5),
+		SharesSrc:        sdk.NewRat(5),
+		SharesDst:        sdk.NewRat(5),
+	}}
+	require.NoError(t, InitGenesis(ctx, keeper, genesisState))
+
+	// the unbonding delegations and redelegations are exported
+	exported := WriteGenesis(ctx, keeper)
+	require.Len(t, exported.Validators, 2)
+	require.Equal(t, genesisState.UnbondingDelegations, exported.UnbondingDelegations)
+	require.Len(t, exported.Redelegations, 1)
+	require.True(t, exported.Redelegations[0].Equal(genesisState.Redelegations[0]))
+
+	// and restored on a new chain
+	ctx2, _, keeper2 := keep.CreateTestInput(t, false, 1000)
+	require.NoError(t, InitGenesis(ctx2, keeper2, exported))
+	_, found := keeper2.GetUnbondingDelegation(ctx2, keep.Addrs[2], keep.Addrs[0])
+	require.True(t, found)
+	require.True(t, keeper2.HasReceivingRedelegation(ctx2, keep.Addrs[2], keep.Addrs[1]))
+
+	// the validators can't be duplicated
+	exported.Validators = append(exported.Validators, exported.Validators[0])
+	require.Error(t, ValidateGenesis(exported))
+}
@@ -32,21 +32,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
-// Called every block, process inflation, update validator set
+// Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) (ValidatorUpdates []abci.Validator) {
-	pool := k.GetPool(ctx)
-	params := k.GetParams(ctx)
-
-	// Process types.Validator Provisions
-	blockTime := ctx.BlockHeader().Time
-	if blockTime-pool.InflationLastTime >= 3600 {
-		pool.InflationLastTime = blockTime
-		pool = pool.ProcessProvisions(params)
-	}
-
-	// save the params
-	k.SetPool(ctx, pool)
-
 	// reset the intra-transaction counter
 	k.SetIntraTxCounter(ctx, 0)
 
@@ -77,12 +64,18 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	}
 
 	validator := NewValidator(msg.ValidatorAddr, msg.PubKey, msg.Description)
+	validator, err := validator.SetInitialCommission(msg.Commission, msg.CommissionMax,
+		msg.CommissionChangeRate, ctx.BlockHeader().Time)
+	if err != nil {
+		return err.Result()
+	}
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPubKeyIndex(ctx, validator)
+	k.AfterValidatorCreated(ctx, validator.Owner)
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
-	_, err := k.Delegate(ctx, msg.DelegatorAddr, msg.Delegation, validator, true)
+	_, err = k.Delegate(ctx, msg.DelegatorAddr, msg.Delegation, validator, true)
 	if err != nil {
 		return err.Result()
 	}
@@ -107,18 +100,29 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 
 	// replace all editable fields (clients should autofill existing values)
-	description, err := validator.Description.UpdateDescription(msg.Description)
-	if err != nil {
-		return err.Result()
+	if msg.Description != (types.Description{}) {
+		description, err := validator.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return err.Result()
+		}
+		validator.Description = description
+	}
+
+	// the commission can only change within the terms of the validator
+	if msg.Commission != nil {
+		var err sdk.Error
+		validator, err = validator.UpdateCommission(*msg.Commission, ctx.BlockHeader().Time)
+		if err != nil {
+			return err.Result()
+		}
 	}
-	validator.Description = description
 
 	k.UpdateValidator(ctx, validator)
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionEditValidator,
 		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
-		tags.Moniker, []byte(description.Moniker),
-		tags.Identity, []byte(description.Identity),
+		tags.Moniker, []byte(validator.Description.Moniker),
+		tags.Identity, []byte(validator.Description.Identity),
 	)
 	return sdk.Result{
 		Tags: tags,
@@ -28,13 +28,7 @@ func newTestMsgDelegate(delegatorAddr, validatorAddr sdk.AccAddress, amt int64)
 }
 
 func newTestMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr sdk.AccAddress, valPubKey crypto.PubKey, amt int64) MsgCreateValidator {
-	return MsgCreateValidator{
-		Description:   Description{},
-		DelegatorAddr: delegatorAddr,
-		ValidatorAddr: validatorAddr,
-		PubKey:        valPubKey,
-		Delegation:    sdk.Coin{"steak", sdk.NewInt(amt)},
-	}
+	return types.NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr, valPubKey, sdk.Coin{"steak", sdk.NewInt(amt)}, Description{})
 }
 
 // retrieve params which are instant
@@ -98,11 +92,10 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.True(t, keep.ValidatorByPowerIndexExists(ctx, keeper, power2))
 
 	// inflate a bunch
-	params := keeper.GetParams(ctx)
 	for i := 0; i < 200; i++ {
-		pool = pool.ProcessProvisions(params)
-		keeper.SetPool(ctx, pool)
+		keeper.InflateSupply(ctx, sdk.NewInt(10000))
 	}
+	pool = keeper.GetPool(ctx)
 
 	// now the new record power index should be the same as the original record
 	power3 := GetValidatorsByPowerIndexKey(validator, pool)
@@ -192,6 +185,50 @@ func TestDuplicatesMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	require.False(t, got.IsOK(), "%v", got)
 }
 
+func TestValidatorCommission(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	validatorAddr := keep.Addrs[0]
+
+	// the commission rate can't be more than the max rate
+	msgCreateValidator := newTestMsgCreateValidator(validatorAddr, keep.PKs[0], 10).
+		WithCommission(sdk.NewRat(3, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "%v", got)
+
+	msgCreateValidator = msgCreateValidator.WithCommission(sdk.NewRat(1, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(sdk.RatEq(t, sdk.NewRat(1, 10), validator.Commission))
+	require.True(sdk.RatEq(t, sdk.NewRat(1, 5), validator.CommissionMax))
+	require.True(sdk.RatEq(t, sdk.NewRat(1, 100), validator.CommissionChangeRate))
+	require.Equal(t, ctx.BlockHeader().Time, validator.CommissionChangeTime)
+
+	// the rate can't change by more than the max change rate in a day
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}).WithCommission(sdk.NewRat(12, 100))
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "%v", got)
+
+	msgEditValidator = NewMsgEditValidator(validatorAddr, Description{}).WithCommission(sdk.NewRat(11, 100))
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	got = handleMsgEditValidator(ctx, msgEditValidator.WithCommission(sdk.NewRat(105, 1000)), keeper)
+	require.False(t, got.IsOK(), "%v", got)
+
+	// but it can change again the next day
+	header := ctx.BlockHeader()
+	header.Time += 60 * 60 * 24
+	ctx = ctx.WithBlockHeader(header)
+	msgEditValidator = NewMsgEditValidator(validatorAddr, Description{}).WithCommission(sdk.NewRat(12, 100))
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+	validator, found = keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(sdk.RatEq(t, sdk.NewRat(12, 100), validator.Commission))
+	require.Equal(t, header.Time, validator.CommissionChangeTime)
+}
+
 func TestIncrementsMsgDelegate(t *testing.T) {
 	initBond := int64(1000)
 	ctx, accMapper, keeper := keep.CreateTestInput(t, false, initBond)
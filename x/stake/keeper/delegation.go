@@ -62,17 +62,32 @@ func (k Keeper) GetDelegations(ctx sdk.Context, delegator sdk.AccAddress,
 	return delegations[:i] // trim
 }
 
-// set the delegation
+// load all delegations to a particular validator
+func (k Keeper) GetValidatorDelegations(ctx sdk.Context, valAddr sdk.AccAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetDelegationsByValIndexKey(valAddr))
+	for ; iterator.Valid(); iterator.Next() {
+		key := GetDelegationKeyFromValIndexKey(iterator.Key())
+		delegation := types.MustUnmarshalDelegation(k.cdc, key, store.Get(key))
+		delegations = append(delegations, delegation)
+	}
+	iterator.Close()
+	return delegations
+}
+
+// set the delegation and associated index
 func (k Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDelegation(k.cdc, delegation)
 	store.Set(GetDelegationKey(delegation.DelegatorAddr, delegation.ValidatorAddr), b)
+	store.Set(GetDelegationByValIndexKey(delegation.DelegatorAddr, delegation.ValidatorAddr), []byte{})
 }
 
-// remove the delegation
+// remove the delegation and associated index
 func (k Keeper) RemoveDelegation(ctx sdk.Context, delegation types.Delegation) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(GetDelegationKey(delegation.DelegatorAddr, delegation.ValidatorAddr))
+	store.Delete(GetDelegationByValIndexKey(delegation.DelegatorAddr, delegation.ValidatorAddr))
 }
 
 //_____________________________________________________________________________________
@@ -110,6 +125,18 @@ func (k Keeper) GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sd
 	return ubds
 }
 
+// load all unbonding delegations
+func (k Keeper) GetAllUnbondingDelegations(ctx sdk.Context) (ubds []types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, UnbondingDelegationKey)
+	for ; iterator.Valid(); iterator.Next() {
+		ubd := types.MustUnmarshalUBD(k.cdc, iterator.Key(), iterator.Value())
+		ubds = append(ubds, ubd)
+	}
+	iterator.Close()
+	return ubds
+}
+
 // set the unbonding delegation and associated index
 func (k Keeper) SetUnbondingDelegation(ctx sdk.Context, ubd types.UnbondingDelegation) {
 	store := ctx.KVStore(k.storeKey)
@@ -162,6 +189,18 @@ func (k Keeper) GetRedelegationsFromValidator(ctx sdk.Context, valAddr sdk.AccAd
 	return reds
 }
 
+// load all redelegations
+func (k Keeper) GetAllRedelegations(ctx sdk.Context) (reds []types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, RedelegationKey)
+	for ; iterator.Valid(); iterator.Next() {
+		red := types.MustUnmarshalRED(k.cdc, iterator.Key(), iterator.Value())
+		reds = append(reds, red)
+	}
+	iterator.Close()
+	return reds
+}
+
 // has a redelegation
 func (k Keeper) HasReceivingRedelegation(ctx sdk.Context,
 	DelegatorAddr, ValidatorDstAddr sdk.AccAddress) bool {
@@ -216,12 +255,18 @@ func (k Keeper) Delegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, bondAmt
 
 	if subtractAccount {
 		// Account new shares, save
-		_, _, err = k.coinKeeper.SubtractCoins(ctx, delegation.DelegatorAddr, sdk.Coins{bondAmt})
+		_, err = k.coinKeeper.DelegateCoinsFromAccountToModule(ctx, delegation.DelegatorAddr, BondedPoolName, sdk.Coins{bondAmt})
 		if err != nil {
 			return
 		}
 	}
 
+	if found {
+		k.beforeDelegationSharesModified(ctx, delegatorAddr, validator.Owner)
+	} else {
+		k.beforeDelegationCreated(ctx, delegatorAddr, validator.Owner)
+	}
+
 	pool := k.GetPool(ctx)
 	validator, pool, newShares = validator.AddTokensFromDel(pool, bondAmt.Amount.Int64())
 	delegation.Shares = delegation.Shares.Add(newShares)
@@ -232,6 +277,7 @@ func (k Keeper) Delegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, bondAmt
 	k.SetPool(ctx, pool)
 	k.SetDelegation(ctx, delegation)
 	k.UpdateValidator(ctx, validator)
+	k.afterDelegationModified(ctx, delegatorAddr, validator.Owner)
 
 	return
 }
@@ -260,6 +306,8 @@ func (k Keeper) unbond(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddr
 		return
 	}
 
+	k.beforeDelegationSharesModified(ctx, delegatorAddr, validatorAddr)
+
 	// subtract shares from delegator
 	delegation.Shares = delegation.Shares.Sub(shares)
 
@@ -271,11 +319,13 @@ func (k Keeper) unbond(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddr
 		if bytes.Equal(delegation.DelegatorAddr, validator.Owner) && validator.Revoked == false {
 			validator.Revoked = true
 		}
+		k.beforeDelegationRemoved(ctx, delegatorAddr, validatorAddr)
 		k.RemoveDelegation(ctx, delegation)
 	} else {
 		// Update height
 		delegation.Height = ctx.BlockHeight()
 		k.SetDelegation(ctx, delegation)
+		k.afterDelegationModified(ctx, delegatorAddr, validatorAddr)
 	}
 
 	// remove the coins from the validator
@@ -303,10 +353,18 @@ func (k Keeper) BeginUnbonding(ctx sdk.Context, delegatorAddr, validatorAddr sdk
 		return err
 	}
 
-	// create the unbonding delegation
+	// create the unbonding delegation, its balance is held by the not bonded
+	// pool until it completes. The balance is truncated so the fraction of a
+	// token left stays in the bonded pool, which can't run short.
 	params := k.GetParams(ctx)
 	minTime := ctx.BlockHeader().Time + params.UnbondingTime
-	balance := sdk.Coin{params.BondDenom, returnAmount.RoundInt()}
+	balance := sdk.Coin{params.BondDenom, returnAmount.TruncateInt()}
+	if !balance.IsZero() {
+		_, err = k.coinKeeper.SendCoinsFromModuleToModule(ctx, BondedPoolName, NotBondedPoolName, sdk.Coins{balance})
+		if err != nil {
+			return err
+		}
+	}
 
 	ubd := types.UnbondingDelegation{
 		DelegatorAddr:  delegatorAddr,
@@ -333,9 +391,11 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delegatorAddr, validatorAddr
 		return types.ErrNotMature(k.Codespace(), "unbonding", "unit-time", ubd.MinTime, ctxTime)
 	}
 
-	_, _, err := k.coinKeeper.AddCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
-	if err != nil {
-		return err
+	if !ubd.Balance.IsZero() {
+		_, err := k.coinKeeper.UndelegateCoinsFromModuleToAccount(ctx, NotBondedPoolName, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
+		if err != nil {
+			return err
+		}
 	}
 	k.RemoveUnbondingDelegation(ctx, ubd)
 	return nil
@@ -355,8 +415,10 @@ func (k Keeper) BeginRedelegation(ctx sdk.Context, delegatorAddr, validatorSrcAd
 		return err
 	}
 
+	// the redelegated tokens stay in the bonded pool, truncated so the
+	// destination can't hold more tokens than were unbonded
 	params := k.GetParams(ctx)
-	returnCoin := sdk.Coin{params.BondDenom, returnAmount.RoundInt()}
+	returnCoin := sdk.Coin{params.BondDenom, returnAmount.TruncateInt()}
 	dstValidator, found := k.GetValidator(ctx, validatorDstAddr)
 	if !found {
 		return types.ErrBadRedelegationDst(k.Codespace())
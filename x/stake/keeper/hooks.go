@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AfterValidatorCreated calls the hooks after a validator is created
+func (k Keeper) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterValidatorCreated(ctx, valAddr)
+	}
+}
+
+func (k Keeper) beforeValidatorRemoved(ctx sdk.Context, valAddr sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeValidatorRemoved(ctx, valAddr)
+	}
+}
+
+func (k Keeper) beforeDelegationCreated(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeDelegationCreated(ctx, delAddr, valAddr)
+	}
+}
+
+func (k Keeper) beforeDelegationSharesModified(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+	}
+}
+
+func (k Keeper) beforeDelegationRemoved(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.BeforeDelegationRemoved(ctx, delAddr, valAddr)
+	}
+}
+
+func (k Keeper) afterDelegationModified(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterDelegationModified(ctx, delAddr, valAddr)
+	}
+}
@@ -8,11 +8,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/stake/types"
 )
 
+// names of the module accounts holding the coins of stake, which need the
+// staking and burner permissions. The bonded pool holds the tokens delegated
+// to the validators and the not bonded pool the balances of the unbonding
+// delegations until they complete.
+const (
+	BondedPoolName    = "bonded_tokens_pool"
+	NotBondedPoolName = "not_bonded_tokens_pool"
+)
+
 // keeper of the stake store
 type Keeper struct {
 	storeKey   sdk.StoreKey
 	cdc        *wire.Codec
 	coinKeeper bank.Keeper
+	hooks      sdk.StakingHooks
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -28,6 +38,13 @@ func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, codespace sdk.
 	return keeper
 }
 
+// WithHooks returns the Keeper calling the hooks on the changes of the
+// validators and of the delegations
+func (k Keeper) WithHooks(hooks sdk.StakingHooks) Keeper {
+	k.hooks = hooks
+	return k
+}
+
 //_________________________________________________________________________
 
 // return the codespace
@@ -94,6 +111,14 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
 	store.Set(PoolKey, b)
 }
 
+// InflateSupply adds the tokens minted outside of stake, e.g. by x/mint, to
+// the loose tokens of the pool
+func (k Keeper) InflateSupply(ctx sdk.Context, newTokens sdk.Int) {
+	pool := k.GetPool(ctx)
+	pool.LooseTokens = pool.LooseTokens.Add(sdk.NewRatFromInt(newTokens))
+	k.SetPool(ctx, pool)
+}
+
 //__________________________________________________________________________
 
 // get the current in-block validator operation counter
@@ -30,6 +30,7 @@ var (
 	RedelegationKey                  = []byte{0x0D} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x0E} // prefix for each key for an redelegation, by source validator owner
 	RedelegationByValDstIndexKey     = []byte{0x0F} // prefix for each key for an redelegation, by destination validator owner
+	DelegationByValIndexKey          = []byte{0x10} // prefix for each key for a delegation, by validator owner
 )
 
 const maxDigitsForAccount = 12 // ~220,000,000 atoms created at launch
@@ -113,6 +114,28 @@ func GetDelegationsKey(delegatorAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, delegatorAddr.Bytes()...)
 }
 
+// get the index-key for a delegation, stored by validator-index
+// VALUE: none (key rearrangement used)
+func GetDelegationByValIndexKey(delegatorAddr, validatorAddr sdk.AccAddress) []byte {
+	return append(GetDelegationsByValIndexKey(validatorAddr), delegatorAddr.Bytes()...)
+}
+
+// rearrange the ValIndexKey to get the DelegationKey
+func GetDelegationKeyFromValIndexKey(IndexKey []byte) []byte {
+	addrs := IndexKey[1:] // remove prefix bytes
+	if len(addrs) != 2*sdk.AddrLen {
+		panic("unexpected key length")
+	}
+	valAddr := addrs[:sdk.AddrLen]
+	delAddr := addrs[sdk.AddrLen:]
+	return GetDelegationKey(delAddr, valAddr)
+}
+
+// get the prefix keyspace for the indexes of delegations for a validator
+func GetDelegationsByValIndexKey(validatorAddr sdk.AccAddress) []byte {
+	return append(DelegationByValIndexKey, validatorAddr.Bytes()...)
+}
+
 //________________________________________________________________________________
 
 // get the key for an unbonding delegation by delegator and validator addr.
@@ -97,6 +97,7 @@ func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight in
 	validator, pool = validator.RemoveTokens(pool, tokensToBurn)
 	// burn tokens
 	pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
+	k.burnTokens(ctx, BondedPoolName, tokensToBurn.TruncateInt())
 	// update the pool
 	k.SetPool(ctx, pool)
 	// update the validator, possibly kicking it out
@@ -172,7 +173,7 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 	// Possible since the unbonding delegation may already
 	// have been slashed, and slash amounts are calculated
 	// according to stake held at time of infraction
-	unbondingSlashAmount := sdk.MinInt(slashAmount.RoundInt(), unbondingDelegation.Balance.Amount)
+	unbondingSlashAmount := sdk.MinInt(slashAmount.TruncateInt(), unbondingDelegation.Balance.Amount)
 
 	// Update unbonding delegation if necessary
 	if !unbondingSlashAmount.IsZero() {
@@ -181,8 +182,9 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		pool := k.GetPool(ctx)
 		// Burn loose tokens
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
-		pool.LooseTokens = pool.LooseTokens.Sub(slashAmount)
+		pool.LooseTokens = pool.LooseTokens.Sub(sdk.NewRatFromInt(unbondingSlashAmount))
 		k.SetPool(ctx, pool)
+		k.burnTokens(ctx, NotBondedPoolName, unbondingSlashAmount)
 	}
 
 	return
@@ -243,7 +245,23 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		pool := k.GetPool(ctx)
 		pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
 		k.SetPool(ctx, pool)
+		k.burnTokens(ctx, BondedPoolName, tokensToBurn.TruncateInt())
 	}
 
 	return slashAmount
 }
+
+// burn the tokens slashed from the module account of the pool, deducting
+// them from the supply of the bond denomination. The tokens leaving a pool are
+// truncated so the pools hold at least the tokens stake accounts for, failing
+// to burn them means they diverged.
+func (k Keeper) burnTokens(ctx sdk.Context, pool string, amount sdk.Int) {
+	if amount.IsZero() {
+		return
+	}
+	burned := sdk.Coins{{k.GetParams(ctx).BondDenom, amount}}
+	_, err := k.coinKeeper.BurnModuleCoins(ctx, pool, burned)
+	if err != nil {
+		panic(fmt.Sprintf("failed to burn the slashed tokens from %s: %v", pool, err))
+	}
+}
@@ -29,6 +29,7 @@ func setupHelper(t *testing.T, amt int64) (sdk.Context, Keeper, types.Params) {
 		keeper.SetValidatorByPubKeyIndex(ctx, validator)
 	}
 	pool = keeper.GetPool(ctx)
+	fundPool(t, ctx, keeper, BondedPoolName, amt*int64(numVals))
 
 	return ctx, keeper, params
 }
@@ -75,6 +76,7 @@ func TestSlashUnbondingDelegation(t *testing.T) {
 		Balance:        sdk.NewCoin(params.BondDenom, 10),
 	}
 	keeper.SetUnbondingDelegation(ctx, ubd)
+	fundPool(t, ctx, keeper, NotBondedPoolName, 10)
 
 	// unbonding started prior to the infraction height, stake didn't contribute
 	slashAmount := keeper.slashUnbondingDelegation(ctx, ubd, 1, fraction)
@@ -214,6 +216,7 @@ func TestSlashWithUnbondingDelegation(t *testing.T) {
 		Balance:        sdk.NewCoin(params.BondDenom, 4),
 	}
 	keeper.SetUnbondingDelegation(ctx, ubd)
+	fundPool(t, ctx, keeper, NotBondedPoolName, 4)
 
 	// slash validator for the first time
 	ctx = ctx.WithBlockHeight(12)
@@ -330,6 +333,7 @@ func TestSlashWithRedelegation(t *testing.T) {
 	pool := keeper.GetPool(ctx)
 	pool.BondedTokens = pool.BondedTokens.Add(sdk.NewRat(6))
 	keeper.SetPool(ctx, pool)
+	fundPool(t, ctx, keeper, BondedPoolName, 6)
 
 	// slash validator
 	ctx = ctx.WithBlockHeight(12)
@@ -459,6 +463,7 @@ func TestSlashBoth(t *testing.T) {
 		Balance:        sdk.NewCoin(params.BondDenom, 4),
 	}
 	keeper.SetUnbondingDelegation(ctx, ubdA)
+	fundPool(t, ctx, keeper, NotBondedPoolName, 4)
 
 	// slash validator
 	ctx = ctx.WithBlockHeight(12)
@@ -68,23 +68,12 @@ func MakeTestCodec() *wire.Codec {
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/stake/Account", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "test/stake/ModuleAccount", nil)
 	wire.RegisterCrypto(cdc)
 
 	return cdc
 }
 
-// default params without inflation
-func ParamsNoInflation() types.Params {
-	return types.Params{
-		InflationRateChange: sdk.ZeroRat(),
-		InflationMax:        sdk.ZeroRat(),
-		InflationMin:        sdk.ZeroRat(),
-		GoalBonded:          sdk.NewRat(67, 100),
-		MaxValidators:       100,
-		BondDenom:           "steak",
-	}
-}
-
 // hogpodge of all sorts of input required for testing
 func CreateTestInput(t *testing.T, isCheckTx bool, initCoins int64) (sdk.Context, auth.AccountMapper, Keeper) {
 
@@ -105,7 +94,10 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initCoins int64) (sdk.Context
 		keyAcc,                // target store
 		auth.ProtoBaseAccount, // prototype
 	)
-	ck := bank.NewKeeper(accountMapper)
+	ck := bank.NewKeeper(accountMapper).WithModuleAccounts(map[string][]string{
+		BondedPoolName:    {auth.Staking, auth.Burner},
+		NotBondedPoolName: {auth.Staking, auth.Burner},
+	})
 	keeper := NewKeeper(cdc, keyStake, ck, types.DefaultCodespace)
 	keeper.SetPool(ctx, types.InitialPool())
 	keeper.SetNewParams(ctx, types.DefaultParams())
@@ -125,6 +117,15 @@ func CreateTestInput(t *testing.T, isCheckTx bool, initCoins int64) (sdk.Context
 	return ctx, accountMapper, keeper
 }
 
+// fund the module account of the pool with tokens the tests add to the stake
+// state directly, so that slashing can burn them
+func fundPool(t *testing.T, ctx sdk.Context, keeper Keeper, pool string, amt int64) {
+	_, _, err := keeper.coinKeeper.AddCoins(ctx, auth.NewModuleAddress(pool), sdk.Coins{
+		{keeper.GetParams(ctx).BondDenom, sdk.NewInt(amt)},
+	})
+	require.Nil(t, err)
+}
+
 func NewPubKey(pk string) (res crypto.PubKey) {
 	pkBytes, err := hex.DecodeString(pk)
 	if err != nil {
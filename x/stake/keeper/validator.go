@@ -538,6 +538,15 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 		return
 	}
 
+	// the hooks still read the validator record and its delegations
+	k.beforeValidatorRemoved(ctx, address)
+
+	// the delegations left, e.g. after a slash of all the tokens, are worth
+	// nothing and mustn't carry over to a validator recreated at the address
+	for _, delegation := range k.GetValidatorDelegations(ctx, address) {
+		k.RemoveDelegation(ctx, delegation)
+	}
+
 	// delete the old validator record
 	store := ctx.KVStore(k.storeKey)
 	pool := k.GetPool(ctx)
@@ -103,6 +103,8 @@ func TestSlashToZeroPowerRemoved(t *testing.T) {
 	validator = keeper.UpdateValidator(ctx, validator)
 	require.Equal(t, int64(100), validator.Tokens.RoundInt64(), "\nvalidator %v\npool %v", validator, pool)
 
+	fundPool(t, ctx, keeper, BondedPoolName, 100)
+
 	// slash the validator by 100%
 	keeper.Slash(ctx, PKs[0], 0, 100, sdk.OneRat())
 	// validator should have been deleted
@@ -0,0 +1,58 @@
+package stake
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+
+	prometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Ratio of bonded tokens to the total token supply.
+	BondedRatio metrics.Gauge
+	// Number of validators, labeled by bond status.
+	Validators metrics.Gauge
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library.
+func PrometheusMetrics() *Metrics {
+	return &Metrics{
+		BondedRatio: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Subsystem: "stake",
+			Name:      "bonded_ratio",
+			Help:      "Ratio of bonded tokens to the total token supply.",
+		}, []string{}),
+		Validators: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Subsystem: "stake",
+			Name:      "validators",
+			Help:      "Number of validators.",
+		}, []string{"status"}),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		BondedRatio: discard.NewGauge(),
+		Validators:  discard.NewGauge(),
+	}
+}
+
+// Record sets the gauges from the current staking state
+func (m *Metrics) Record(ctx sdk.Context, k keeper.Keeper) {
+	ratio, _ := k.GetPool(ctx).BondedRatio().Float64()
+	m.BondedRatio.Set(ratio)
+
+	counts := map[sdk.BondStatus]float64{sdk.Bonded: 0, sdk.Unbonding: 0, sdk.Unbonded: 0}
+	for _, validator := range k.GetAllValidators(ctx) {
+		counts[validator.Status]++
+	}
+	for status, count := range counts {
+		m.Validators.With("status", sdk.BondStatusToString(status)).Set(count)
+	}
+}
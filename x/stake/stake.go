@@ -36,6 +36,8 @@ var (
 	GetValidatorsByPowerIndexKey = keeper.GetValidatorsByPowerIndexKey
 	GetTendermintUpdatesKey      = keeper.GetTendermintUpdatesKey
 	GetDelegationKey             = keeper.GetDelegationKey
+	GetDelegationByValIndexKey   = keeper.GetDelegationByValIndexKey
+	GetDelegationsByValIndexKey  = keeper.GetDelegationsByValIndexKey
 	GetDelegationsKey            = keeper.GetDelegationsKey
 	ParamKey                     = keeper.ParamKey
 	PoolKey                      = keeper.PoolKey
@@ -47,6 +49,7 @@ var (
 	ValidatorPowerCliffKey       = keeper.ValidatorPowerCliffKey
 	TendermintUpdatesKey         = keeper.TendermintUpdatesKey
 	DelegationKey                = keeper.DelegationKey
+	DelegationByValIndexKey      = keeper.DelegationByValIndexKey
 	IntraTxCounterKey            = keeper.IntraTxCounterKey
 	GetUBDKey                    = keeper.GetUBDKey
 	GetUBDByValIndexKey          = keeper.GetUBDByValIndexKey
@@ -78,6 +81,11 @@ var (
 	NewMsgCompleteRedelegate        = types.NewMsgCompleteRedelegate
 )
 
+const (
+	BondedPoolName    = keeper.BondedPoolName
+	NotBondedPoolName = keeper.NotBondedPoolName
+)
+
 const (
 	DefaultCodespace      = types.DefaultCodespace
 	CodeInvalidValidator  = types.CodeInvalidValidator
@@ -90,15 +98,19 @@ const (
 )
 
 var (
-	ErrNilValidatorAddr      = types.ErrNilValidatorAddr
-	ErrNoValidatorFound      = types.ErrNoValidatorFound
-	ErrValidatorOwnerExists  = types.ErrValidatorOwnerExists
-	ErrValidatorPubKeyExists = types.ErrValidatorPubKeyExists
-	ErrValidatorRevoked      = types.ErrValidatorRevoked
-	ErrBadRemoveValidator    = types.ErrBadRemoveValidator
-	ErrDescriptionLength     = types.ErrDescriptionLength
-	ErrCommissionNegative    = types.ErrCommissionNegative
-	ErrCommissionHuge        = types.ErrCommissionHuge
+	ErrNilValidatorAddr              = types.ErrNilValidatorAddr
+	ErrNoValidatorFound              = types.ErrNoValidatorFound
+	ErrValidatorOwnerExists          = types.ErrValidatorOwnerExists
+	ErrValidatorPubKeyExists         = types.ErrValidatorPubKeyExists
+	ErrValidatorRevoked              = types.ErrValidatorRevoked
+	ErrBadRemoveValidator            = types.ErrBadRemoveValidator
+	ErrDescriptionLength             = types.ErrDescriptionLength
+	ErrCommissionNegative            = types.ErrCommissionNegative
+	ErrCommissionHuge                = types.ErrCommissionHuge
+	ErrCommissionNil                 = types.ErrCommissionNil
+	ErrCommissionGTMaxRate           = types.ErrCommissionGTMaxRate
+	ErrCommissionChangeRateGTMaxRate = types.ErrCommissionChangeRateGTMaxRate
+	ErrCommissionGTMaxChangeRate     = types.ErrCommissionGTMaxChangeRate
 
 	ErrNilDelegatorAddr          = types.ErrNilDelegatorAddr
 	ErrBadDenom                  = types.ErrBadDenom
@@ -0,0 +1,73 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the commission change today is reset at midnight (UTC time)
+const secondsPerDay int64 = 60 * 60 * 24
+
+// ValidateCommission checks the commission terms of a new validator: the max
+// rate is at most 100%, the rate and the max change rate at most the max rate
+func ValidateCommission(rate, maxRate, maxChangeRate sdk.Rat) sdk.Error {
+	switch {
+	case rate.Rat == nil || maxRate.Rat == nil || maxChangeRate.Rat == nil:
+		return ErrCommissionNil(DefaultCodespace)
+	case rate.LT(sdk.ZeroRat()) || maxRate.LT(sdk.ZeroRat()) || maxChangeRate.LT(sdk.ZeroRat()):
+		return ErrCommissionNegative(DefaultCodespace)
+	case maxRate.GT(sdk.OneRat()):
+		return ErrCommissionHuge(DefaultCodespace)
+	case rate.GT(maxRate):
+		return ErrCommissionGTMaxRate(DefaultCodespace)
+	case maxChangeRate.GT(maxRate):
+		return ErrCommissionChangeRateGTMaxRate(DefaultCodespace)
+	}
+	return nil
+}
+
+// SetInitialCommission sets the commission terms of a new validator, which
+// can't be changed later but for the rate
+func (v Validator) SetInitialCommission(rate, maxRate, maxChangeRate sdk.Rat, blockTime int64) (Validator, sdk.Error) {
+	err := ValidateCommission(rate, maxRate, maxChangeRate)
+	if err != nil {
+		return v, err
+	}
+	v.Commission = rate
+	v.CommissionMax = maxRate
+	v.CommissionChangeRate = maxChangeRate
+	v.CommissionChangeToday = sdk.ZeroRat()
+	v.CommissionChangeTime = blockTime
+	return v, nil
+}
+
+// UpdateCommission changes the commission rate of the validator. The rate
+// can't be more than the max rate, and the changes of a day (UTC time) can't
+// add up to more than the max change rate.
+func (v Validator) UpdateCommission(rate sdk.Rat, blockTime int64) (Validator, sdk.Error) {
+	switch {
+	case rate.Rat == nil:
+		return v, ErrCommissionNil(DefaultCodespace)
+	case rate.LT(sdk.ZeroRat()):
+		return v, ErrCommissionNegative(DefaultCodespace)
+	case rate.GT(v.CommissionMax):
+		return v, ErrCommissionGTMaxRate(DefaultCodespace)
+	}
+
+	changeToday := v.CommissionChangeToday
+	if blockTime/secondsPerDay != v.CommissionChangeTime/secondsPerDay {
+		changeToday = sdk.ZeroRat()
+	}
+	change := rate.Sub(v.Commission)
+	if change.LT(sdk.ZeroRat()) {
+		change = sdk.ZeroRat().Sub(change)
+	}
+	changeToday = changeToday.Add(change)
+	if changeToday.GT(v.CommissionChangeRate) {
+		return v, ErrCommissionGTMaxChangeRate(DefaultCodespace)
+	}
+
+	v.Commission = rate
+	v.CommissionChangeToday = changeToday
+	v.CommissionChangeTime = blockTime
+	return v, nil
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestValidateCommission(t *testing.T) {
+	tests := []struct {
+		rate, maxRate, maxChangeRate sdk.Rat
+		expectPass                   bool
+	}{
+		{sdk.NewRat(1, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100), true},
+		{sdk.ZeroRat(), sdk.ZeroRat(), sdk.ZeroRat(), true},
+		{sdk.OneRat(), sdk.OneRat(), sdk.OneRat(), true},
+		{sdk.Rat{}, sdk.NewRat(1, 5), sdk.NewRat(1, 100), false},
+		{sdk.NewRat(-1, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100), false},
+		{sdk.NewRat(1, 10), sdk.NewRat(2), sdk.NewRat(1, 100), false},
+		{sdk.NewRat(3, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100), false},
+		{sdk.NewRat(1, 10), sdk.NewRat(1, 5), sdk.NewRat(3, 10), false},
+	}
+
+	for i, tc := range tests {
+		err := ValidateCommission(tc.rate, tc.maxRate, tc.maxChangeRate)
+		if tc.expectPass {
+			require.Nil(t, err, "test: %v", i)
+		} else {
+			require.NotNil(t, err, "test: %v", i)
+		}
+	}
+}
+
+func TestUpdateCommission(t *testing.T) {
+	day := secondsPerDay
+	validator, err := NewValidator(addr1, pk1, Description{}).
+		SetInitialCommission(sdk.NewRat(1, 10), sdk.NewRat(1, 2), sdk.NewRat(1, 10), 10*day)
+	require.Nil(t, err)
+
+	// above the max rate
+	_, err = validator.UpdateCommission(sdk.NewRat(6, 10), 10*day+1)
+	require.NotNil(t, err)
+
+	// changes of the same day add up
+	validator, err = validator.UpdateCommission(sdk.NewRat(15, 100), 10*day+1)
+	require.Nil(t, err)
+	require.True(sdk.RatEq(t, sdk.NewRat(15, 100), validator.Commission))
+	require.True(sdk.RatEq(t, sdk.NewRat(5, 100), validator.CommissionChangeToday))
+	require.Equal(t, 10*day+1, validator.CommissionChangeTime)
+
+	validator, err = validator.UpdateCommission(sdk.NewRat(1, 10), 10*day+2)
+	require.Nil(t, err)
+	require.True(sdk.RatEq(t, sdk.NewRat(1, 10), validator.CommissionChangeToday))
+
+	_, err = validator.UpdateCommission(sdk.NewRat(11, 100), 11*day-1)
+	require.NotNil(t, err)
+
+	// the change of the day is reset the next day
+	validator, err = validator.UpdateCommission(sdk.NewRat(2, 10), 11*day)
+	require.Nil(t, err)
+	require.True(sdk.RatEq(t, sdk.NewRat(2, 10), validator.Commission))
+	require.True(sdk.RatEq(t, sdk.NewRat(1, 10), validator.CommissionChangeToday))
+}
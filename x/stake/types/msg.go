@@ -28,21 +28,27 @@ var maximumBondingRationalDenominator = sdk.NewInt(int64(math.Pow10(MaxBondDenom
 // MsgCreateValidator - struct for unbonding transactions
 type MsgCreateValidator struct {
 	Description
-	DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-	ValidatorAddr sdk.AccAddress `json:"validator_address"`
-	PubKey        crypto.PubKey  `json:"pubkey"`
-	Delegation    sdk.Coin       `json:"delegation"`
+	DelegatorAddr        sdk.AccAddress `json:"delegator_address"`
+	ValidatorAddr        sdk.AccAddress `json:"validator_address"`
+	PubKey               crypto.PubKey  `json:"pubkey"`
+	Delegation           sdk.Coin       `json:"delegation"`
+	Commission           sdk.Rat        `json:"commission"`             // the initial commission rate
+	CommissionMax        sdk.Rat        `json:"commission_max"`         // maximum commission rate which the validator can ever charge
+	CommissionChangeRate sdk.Rat        `json:"commission_change_rate"` // maximum daily change of the commission rate
 }
 
 // Default way to create validator. Delegator address and validator address are the same
 func NewMsgCreateValidator(validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
 	selfDelegation sdk.Coin, description Description) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   description,
-		DelegatorAddr: validatorAddr,
-		ValidatorAddr: validatorAddr,
-		PubKey:        pubkey,
-		Delegation:    selfDelegation,
+		Description:          description,
+		DelegatorAddr:        validatorAddr,
+		ValidatorAddr:        validatorAddr,
+		PubKey:               pubkey,
+		Delegation:           selfDelegation,
+		Commission:           sdk.ZeroRat(),
+		CommissionMax:        sdk.ZeroRat(),
+		CommissionChangeRate: sdk.ZeroRat(),
 	}
 }
 
@@ -50,14 +56,26 @@ func NewMsgCreateValidator(validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
 func NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
 	delegation sdk.Coin, description Description) MsgCreateValidator {
 	return MsgCreateValidator{
-		Description:   description,
-		DelegatorAddr: delegatorAddr,
-		ValidatorAddr: validatorAddr,
-		PubKey:        pubkey,
-		Delegation:    delegation,
+		Description:          description,
+		DelegatorAddr:        delegatorAddr,
+		ValidatorAddr:        validatorAddr,
+		PubKey:               pubkey,
+		Delegation:           delegation,
+		Commission:           sdk.ZeroRat(),
+		CommissionMax:        sdk.ZeroRat(),
+		CommissionChangeRate: sdk.ZeroRat(),
 	}
 }
 
+// WithCommission returns the msg creating a validator with the commission
+// terms, the validators are created without commission by default
+func (msg MsgCreateValidator) WithCommission(rate, maxRate, maxChangeRate sdk.Rat) MsgCreateValidator {
+	msg.Commission = rate
+	msg.CommissionMax = maxRate
+	msg.CommissionChangeRate = maxChangeRate
+	return msg
+}
+
 //nolint
 func (msg MsgCreateValidator) Type() string { return MsgType }
 
@@ -76,15 +94,21 @@ func (msg MsgCreateValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgCreateValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		DelegatorAddr sdk.AccAddress `json:"delegator_address"`
-		ValidatorAddr sdk.AccAddress `json:"validator_address"`
-		PubKey        string         `json:"pubkey"`
-		Delegation    sdk.Coin       `json:"delegation"`
+		DelegatorAddr        sdk.AccAddress `json:"delegator_address"`
+		ValidatorAddr        sdk.AccAddress `json:"validator_address"`
+		PubKey               string         `json:"pubkey"`
+		Delegation           sdk.Coin       `json:"delegation"`
+		Commission           sdk.Rat        `json:"commission"`
+		CommissionMax        sdk.Rat        `json:"commission_max"`
+		CommissionChangeRate sdk.Rat        `json:"commission_change_rate"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
-		PubKey:        sdk.MustBech32ifyValPub(msg.PubKey),
-		Delegation:    msg.Delegation,
+		Description:          msg.Description,
+		ValidatorAddr:        msg.ValidatorAddr,
+		PubKey:               sdk.MustBech32ifyValPub(msg.PubKey),
+		Delegation:           msg.Delegation,
+		Commission:           msg.Commission,
+		CommissionMax:        msg.CommissionMax,
+		CommissionChangeRate: msg.CommissionChangeRate,
 	})
 	if err != nil {
 		panic(err)
@@ -107,7 +131,7 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if msg.Description == empty {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "description must be included")
 	}
-	return nil
+	return ValidateCommission(msg.Commission, msg.CommissionMax, msg.CommissionChangeRate)
 }
 
 //______________________________________________________________________
@@ -116,6 +140,7 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 type MsgEditValidator struct {
 	Description
 	ValidatorAddr sdk.AccAddress `json:"address"`
+	Commission    *sdk.Rat       `json:"commission"` // the new commission rate, unchanged if nil
 }
 
 func NewMsgEditValidator(validatorAddr sdk.AccAddress, description Description) MsgEditValidator {
@@ -125,6 +150,13 @@ func NewMsgEditValidator(validatorAddr sdk.AccAddress, description Description)
 	}
 }
 
+// WithCommission returns the msg changing the commission rate of the
+// validator as well
+func (msg MsgEditValidator) WithCommission(rate sdk.Rat) MsgEditValidator {
+	msg.Commission = &rate
+	return msg
+}
+
 //nolint
 func (msg MsgEditValidator) Type() string { return MsgType }
 func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
@@ -136,9 +168,11 @@ func (msg MsgEditValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
 		ValidatorAddr sdk.AccAddress `json:"address"`
+		Commission    *sdk.Rat       `json:"commission"`
 	}{
 		Description:   msg.Description,
 		ValidatorAddr: msg.ValidatorAddr,
+		Commission:    msg.Commission,
 	})
 	if err != nil {
 		panic(err)
@@ -152,9 +186,17 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil validator address")
 	}
 	empty := Description{}
-	if msg.Description == empty {
+	if msg.Description == empty && msg.Commission == nil {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "transaction must include some information to modify")
 	}
+	if msg.Commission != nil {
+		if msg.Commission.Rat == nil || msg.Commission.LT(sdk.ZeroRat()) {
+			return ErrCommissionNegative(DefaultCodespace)
+		}
+		if msg.Commission.GT(sdk.OneRat()) {
+			return ErrCommissionHuge(DefaultCodespace)
+		}
+	}
 	return nil
 }
 
@@ -69,6 +69,29 @@ func TestMsgEditValidator(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for the commission of MsgCreateValidator and MsgEditValidator
+func TestMsgValidatorCommission(t *testing.T) {
+	description := NewDescription("a", "b", "c", "d")
+
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, description).
+		WithCommission(sdk.NewRat(1, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100))
+	require.Nil(t, msg.ValidateBasic())
+	msg = msg.WithCommission(sdk.NewRat(3, 10), sdk.NewRat(1, 5), sdk.NewRat(1, 100))
+	require.NotNil(t, msg.ValidateBasic(), "rate above the max rate")
+	msg = msg.WithCommission(sdk.NewRat(1, 10), sdk.NewRat(1, 5), sdk.NewRat(3, 10))
+	require.NotNil(t, msg.ValidateBasic(), "max change rate above the max rate")
+	msg = msg.WithCommission(sdk.NewRat(1, 10), sdk.NewRat(2), sdk.NewRat(1, 100))
+	require.NotNil(t, msg.ValidateBasic(), "max rate above one")
+
+	// the description may be left empty when only the commission is edited
+	msgEdit := NewMsgEditValidator(addr1, Description{}).WithCommission(sdk.NewRat(1, 10))
+	require.Nil(t, msgEdit.ValidateBasic())
+	msgEdit = NewMsgEditValidator(addr1, Description{}).WithCommission(sdk.NewRat(-1, 10))
+	require.NotNil(t, msgEdit.ValidateBasic(), "negative rate")
+	msgEdit = NewMsgEditValidator(addr1, Description{}).WithCommission(sdk.NewRat(2))
+	require.NotNil(t, msgEdit.ValidateBasic(), "rate above one")
+}
+
 // test ValidateBasic and GetSigners for MsgCreateValidatorOnBehalfOf
 func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	tests := []struct {
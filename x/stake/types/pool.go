@@ -9,15 +9,10 @@ import (
 
 // Pool - dynamic parameters of the current state
 type Pool struct {
-	LooseTokens       sdk.Rat `json:"loose_tokens"`        // tokens which are not bonded in a validator
-	BondedTokens      sdk.Rat `json:"bonded_tokens"`       // reserve of bonded tokens
-	InflationLastTime int64   `json:"inflation_last_time"` // block which the last inflation was processed // TODO make time
-	Inflation         sdk.Rat `json:"inflation"`           // current annual inflation rate
+	LooseTokens  sdk.Rat `json:"loose_tokens"`  // tokens which are not bonded in a validator
+	BondedTokens sdk.Rat `json:"bonded_tokens"` // reserve of bonded tokens
 
 	DateLastCommissionReset int64 `json:"date_last_commission_reset"` // unix timestamp for last commission accounting reset (daily)
-
-	// Fee Related
-	PrevBondedShares sdk.Rat `json:"prev_bonded_shares"` // last recorded bonded shares - for fee calculations
 }
 
 // nolint
@@ -32,10 +27,7 @@ func InitialPool() Pool {
 	return Pool{
 		LooseTokens:             sdk.ZeroRat(),
 		BondedTokens:            sdk.ZeroRat(),
-		InflationLastTime:       0,
-		Inflation:               sdk.NewRat(7, 100),
 		DateLastCommissionReset: 0,
-		PrevBondedShares:        sdk.ZeroRat(),
 	}
 }
 
@@ -76,44 +68,3 @@ func (p Pool) bondedTokensToLoose(bondedTokens sdk.Rat) Pool {
 	}
 	return p
 }
-
-//_______________________________________________________________________
-// Inflation
-
-const precision = 100000000000     // increased to this precision for accuracy
-var hrsPerYrRat = sdk.NewRat(8766) // as defined by a julian year of 365.25 days
-
-// process provisions for an hour period
-func (p Pool) ProcessProvisions(params Params) Pool {
-	p.Inflation = p.NextInflation(params)
-	provisions := p.Inflation.Mul(p.TokenSupply()).Quo(hrsPerYrRat)
-
-	// TODO add to the fees provisions
-	p.LooseTokens = p.LooseTokens.Add(provisions)
-	return p
-}
-
-// get the next inflation rate for the hour
-func (p Pool) NextInflation(params Params) (inflation sdk.Rat) {
-
-	// The target annual inflation rate is recalculated for each previsions cycle. The
-	// inflation is also subject to a rate change (positive or negative) depending on
-	// the distance from the desired ratio (67%). The maximum rate change possible is
-	// defined to be 13% per year, however the annual inflation is capped as between
-	// 7% and 20%.
-
-	// (1 - bondedRatio/GoalBonded) * InflationRateChange
-	inflationRateChangePerYear := sdk.OneRat().Sub(p.BondedRatio().Quo(params.GoalBonded)).Mul(params.InflationRateChange)
-	inflationRateChange := inflationRateChangePerYear.Quo(hrsPerYrRat)
-
-	// increase the new annual inflation for this next cycle
-	inflation = p.Inflation.Add(inflationRateChange)
-	if inflation.GT(params.InflationMax) {
-		inflation = params.InflationMax
-	}
-	if inflation.LT(params.InflationMin) {
-		inflation = params.InflationMin
-	}
-
-	return inflation.Round(precision)
-}
@@ -1,12 +1,16 @@
 package types
 
 import (
+	"math/rand"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
+// changing the int in NewSource will allow you to test different, deterministic, sets of operations
+var r = rand.New(rand.NewSource(6595))
+
 func TestPoolEqual(t *testing.T) {
 	p1 := InitialPool()
 	p2 := InitialPool()
@@ -34,15 +34,12 @@ type Validator struct {
 	Description        Description `json:"description"`           // description terms for the validator
 	BondHeight         int64       `json:"bond_height"`           // earliest height as a bonded validator
 	BondIntraTxCounter int16       `json:"bond_intra_tx_counter"` // block-local tx index of validator change
-	ProposerRewardPool sdk.Coins   `json:"proposer_reward_pool"`  // XXX reward pool collected from being the proposer
 
-	Commission            sdk.Rat `json:"commission"`              // XXX the commission rate of fees charged to any delegators
-	CommissionMax         sdk.Rat `json:"commission_max"`          // XXX maximum commission rate which this validator can ever charge
-	CommissionChangeRate  sdk.Rat `json:"commission_change_rate"`  // XXX maximum daily increase of the validator commission
-	CommissionChangeToday sdk.Rat `json:"commission_change_today"` // XXX commission rate change today, reset each day (UTC time)
-
-	// fee related
-	LastBondedTokens sdk.Rat `json:"prev_bonded_tokens"` // Previous bonded tokens held
+	Commission            sdk.Rat `json:"commission"`              // the commission rate of the rewards charged to the delegators
+	CommissionMax         sdk.Rat `json:"commission_max"`          // maximum commission rate which this validator can ever charge
+	CommissionChangeRate  sdk.Rat `json:"commission_change_rate"`  // maximum daily change of the validator commission
+	CommissionChangeToday sdk.Rat `json:"commission_change_today"` // commission rate change today, reset each day (UTC time)
+	CommissionChangeTime  int64   `json:"commission_change_time"`  // unix time of the last commission change
 }
 
 // NewValidator - initialize a new validator
@@ -57,12 +54,11 @@ func NewValidator(owner sdk.AccAddress, pubKey crypto.PubKey, description Descri
 		Description:           description,
 		BondHeight:            int64(0),
 		BondIntraTxCounter:    int16(0),
-		ProposerRewardPool:    sdk.Coins{},
 		Commission:            sdk.ZeroRat(),
 		CommissionMax:         sdk.ZeroRat(),
 		CommissionChangeRate:  sdk.ZeroRat(),
 		CommissionChangeToday: sdk.ZeroRat(),
-		LastBondedTokens:      sdk.ZeroRat(),
+		CommissionChangeTime:  int64(0),
 	}
 }
 
@@ -76,12 +72,11 @@ type validatorValue struct {
 	Description           Description
 	BondHeight            int64
 	BondIntraTxCounter    int16
-	ProposerRewardPool    sdk.Coins
 	Commission            sdk.Rat
 	CommissionMax         sdk.Rat
 	CommissionChangeRate  sdk.Rat
 	CommissionChangeToday sdk.Rat
-	LastBondedTokens      sdk.Rat
+	CommissionChangeTime  int64
 }
 
 // return the redelegation without fields contained within the key for the store
@@ -95,12 +90,11 @@ func MustMarshalValidator(cdc *wire.Codec, validator Validator) []byte {
 		Description:           validator.Description,
 		BondHeight:            validator.BondHeight,
 		BondIntraTxCounter:    validator.BondIntraTxCounter,
-		ProposerRewardPool:    validator.ProposerRewardPool,
 		Commission:            validator.Commission,
 		CommissionMax:         validator.CommissionMax,
 		CommissionChangeRate:  validator.CommissionChangeRate,
 		CommissionChangeToday: validator.CommissionChangeToday,
-		LastBondedTokens:      validator.LastBondedTokens,
+		CommissionChangeTime:  validator.CommissionChangeTime,
 	}
 	return cdc.MustMarshalBinary(val)
 }
@@ -138,12 +132,11 @@ func UnmarshalValidator(cdc *wire.Codec, ownerAddr, value []byte) (validator Val
 		Description:           storeValue.Description,
 		BondHeight:            storeValue.BondHeight,
 		BondIntraTxCounter:    storeValue.BondIntraTxCounter,
-		ProposerRewardPool:    storeValue.ProposerRewardPool,
 		Commission:            storeValue.Commission,
 		CommissionMax:         storeValue.CommissionMax,
 		CommissionChangeRate:  storeValue.CommissionChangeRate,
 		CommissionChangeToday: storeValue.CommissionChangeToday,
-		LastBondedTokens:      storeValue.LastBondedTokens,
+		CommissionChangeTime:  storeValue.CommissionChangeTime,
 	}, nil
 }
 
@@ -165,12 +158,11 @@ func (v Validator) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Delegator Shares: %s\n", v.DelegatorShares.FloatString())
 	resp += fmt.Sprintf("Description: %s\n", v.Description)
 	resp += fmt.Sprintf("Bond Height: %d\n", v.BondHeight)
-	resp += fmt.Sprintf("Proposer Reward Pool: %s\n", v.ProposerRewardPool.String())
 	resp += fmt.Sprintf("Commission: %s\n", v.Commission.String())
 	resp += fmt.Sprintf("Max Commission Rate: %s\n", v.CommissionMax.String())
 	resp += fmt.Sprintf("Commission Change Rate: %s\n", v.CommissionChangeRate.String())
 	resp += fmt.Sprintf("Commission Change Today: %s\n", v.CommissionChangeToday.String())
-	resp += fmt.Sprintf("Previous Bonded Tokens: %s\n", v.LastBondedTokens.String())
+	resp += fmt.Sprintf("Commission Change Time (unix): %d\n", v.CommissionChangeTime)
 
 	return resp, nil
 }
@@ -190,15 +182,12 @@ type BechValidator struct {
 	Description        Description `json:"description"`           // description terms for the validator
 	BondHeight         int64       `json:"bond_height"`           // earliest height as a bonded validator
 	BondIntraTxCounter int16       `json:"bond_intra_tx_counter"` // block-local tx index of validator change
-	ProposerRewardPool sdk.Coins   `json:"proposer_reward_pool"`  // XXX reward pool collected from being the proposer
-
-	Commission            sdk.Rat `json:"commission"`              // XXX the commission rate of fees charged to any delegators
-	CommissionMax         sdk.Rat `json:"commission_max"`          // XXX maximum commission rate which this validator can ever charge
-	CommissionChangeRate  sdk.Rat `json:"commission_change_rate"`  // XXX maximum daily increase of the validator commission
-	CommissionChangeToday sdk.Rat `json:"commission_change_today"` // XXX commission rate change today, reset each day (UTC time)
 
-	// fee related
-	LastBondedTokens sdk.Rat `json:"prev_bonded_shares"` // last bonded token amount
+	Commission            sdk.Rat `json:"commission"`              // the commission rate of the rewards charged to the delegators
+	CommissionMax         sdk.Rat `json:"commission_max"`          // maximum commission rate which this validator can ever charge
+	CommissionChangeRate  sdk.Rat `json:"commission_change_rate"`  // maximum daily change of the validator commission
+	CommissionChangeToday sdk.Rat `json:"commission_change_today"` // commission rate change today, reset each day (UTC time)
+	CommissionChangeTime  int64   `json:"commission_change_time"`  // unix time of the last commission change
 }
 
 // get the bech validator from the the regular validator
@@ -220,14 +209,12 @@ func (v Validator) Bech32Validator() (BechValidator, error) {
 		Description:        v.Description,
 		BondHeight:         v.BondHeight,
 		BondIntraTxCounter: v.BondIntraTxCounter,
-		ProposerRewardPool: v.ProposerRewardPool,
 
 		Commission:            v.Commission,
 		CommissionMax:         v.CommissionMax,
 		CommissionChangeRate:  v.CommissionChangeRate,
 		CommissionChangeToday: v.CommissionChangeToday,
-
-		LastBondedTokens: v.LastBondedTokens,
+		CommissionChangeTime:  v.CommissionChangeTime,
 	}, nil
 }
 
@@ -242,12 +229,11 @@ func (v Validator) Equal(c2 Validator) bool {
 		v.Tokens.Equal(c2.Tokens) &&
 		v.DelegatorShares.Equal(c2.DelegatorShares) &&
 		v.Description == c2.Description &&
-		v.ProposerRewardPool.IsEqual(c2.ProposerRewardPool) &&
 		v.Commission.Equal(c2.Commission) &&
 		v.CommissionMax.Equal(c2.CommissionMax) &&
 		v.CommissionChangeRate.Equal(c2.CommissionChangeRate) &&
 		v.CommissionChangeToday.Equal(c2.CommissionChangeToday) &&
-		v.LastBondedTokens.Equal(c2.LastBondedTokens)
+		v.CommissionChangeTime == c2.CommissionChangeTime
 }
 
 // Description - description fields for a validator
@@ -171,10 +171,8 @@ func TestRemoveDelShares(t *testing.T) {
 		DelegatorShares: delShares,
 	}
 	pool := Pool{
-		BondedTokens:      sdk.NewRat(248305),
-		LooseTokens:       sdk.NewRat(232147),
-		InflationLastTime: 0,
-		Inflation:         sdk.NewRat(7, 100),
+		BondedTokens: sdk.NewRat(248305),
+		LooseTokens:  sdk.NewRat(232147),
 	}
 	shares := sdk.NewRat(29)
 	_, newPool, tokens := validator.RemoveDelShares(pool, shares)
@@ -219,10 +217,8 @@ func TestPossibleOverflow(t *testing.T) {
 		DelegatorShares: delShares,
 	}
 	pool := Pool{
-		LooseTokens:       sdk.NewRat(100),
-		BondedTokens:      poolTokens,
-		InflationLastTime: 0,
-		Inflation:         sdk.NewRat(7, 100),
+		LooseTokens:  sdk.NewRat(100),
+		BondedTokens: poolTokens,
 	}
 	tokens := int64(71)
 	msg := fmt.Sprintf("validator %#v", validator)